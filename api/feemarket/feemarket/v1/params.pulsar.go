@@ -9,26 +9,157 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_31_list)(nil)
+
+type _Params_31_list struct {
+	list *[]*MsgFeeMultiplier
+}
+
+func (x *_Params_31_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_31_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_31_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeMultiplier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_31_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgFeeMultiplier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_31_list) AppendMutable() protoreflect.Value {
+	v := new(MsgFeeMultiplier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_31_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_31_list) NewElement() protoreflect.Value {
+	v := new(MsgFeeMultiplier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_31_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_34_list)(nil)
+
+type _Params_34_list struct {
+	list *[]*FeeExemption
+}
+
+func (x *_Params_34_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_34_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_34_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeExemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_34_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeExemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_34_list) AppendMutable() protoreflect.Value {
+	v := new(FeeExemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_34_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_34_list) NewElement() protoreflect.Value {
+	v := new(FeeExemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_34_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_alpha                 protoreflect.FieldDescriptor
-	fd_Params_beta                  protoreflect.FieldDescriptor
-	fd_Params_gamma                 protoreflect.FieldDescriptor
-	fd_Params_delta                 protoreflect.FieldDescriptor
-	fd_Params_min_base_gas_price    protoreflect.FieldDescriptor
-	fd_Params_min_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_block_utilization protoreflect.FieldDescriptor
-	fd_Params_window                protoreflect.FieldDescriptor
-	fd_Params_fee_denom             protoreflect.FieldDescriptor
-	fd_Params_enabled               protoreflect.FieldDescriptor
-	fd_Params_distribute_fees       protoreflect.FieldDescriptor
-	fd_Params_send_tip_to_proposer  protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_alpha                         protoreflect.FieldDescriptor
+	fd_Params_beta                          protoreflect.FieldDescriptor
+	fd_Params_gamma                         protoreflect.FieldDescriptor
+	fd_Params_delta                         protoreflect.FieldDescriptor
+	fd_Params_min_base_gas_price            protoreflect.FieldDescriptor
+	fd_Params_min_learning_rate             protoreflect.FieldDescriptor
+	fd_Params_max_learning_rate             protoreflect.FieldDescriptor
+	fd_Params_max_block_utilization         protoreflect.FieldDescriptor
+	fd_Params_window                        protoreflect.FieldDescriptor
+	fd_Params_fee_denom                     protoreflect.FieldDescriptor
+	fd_Params_enabled                       protoreflect.FieldDescriptor
+	fd_Params_distribute_fees               protoreflect.FieldDescriptor
+	fd_Params_send_tip_to_proposer          protoreflect.FieldDescriptor
+	fd_Params_pricing_model                 protoreflect.FieldDescriptor
+	fd_Params_utilization_mode              protoreflect.FieldDescriptor
+	fd_Params_target_utilization_ratio      protoreflect.FieldDescriptor
+	fd_Params_max_base_gas_price            protoreflect.FieldDescriptor
+	fd_Params_max_base_gas_price_increase   protoreflect.FieldDescriptor
+	fd_Params_max_base_gas_price_decrease   protoreflect.FieldDescriptor
+	fd_Params_excess_gas_update_fraction    protoreflect.FieldDescriptor
+	fd_Params_pid_proportional_gain         protoreflect.FieldDescriptor
+	fd_Params_pid_integral_gain             protoreflect.FieldDescriptor
+	fd_Params_pid_derivative_gain           protoreflect.FieldDescriptor
+	fd_Params_pid_integral_limit            protoreflect.FieldDescriptor
+	fd_Params_target_block_time             protoreflect.FieldDescriptor
+	fd_Params_downtime_threshold            protoreflect.FieldDescriptor
+	fd_Params_max_block_bytes               protoreflect.FieldDescriptor
+	fd_Params_target_block_bytes            protoreflect.FieldDescriptor
+	fd_Params_min_base_byte_price           protoreflect.FieldDescriptor
+	fd_Params_byte_learning_rate            protoreflect.FieldDescriptor
+	fd_Params_msg_fee_multipliers           protoreflect.FieldDescriptor
+	fd_Params_guardian                      protoreflect.FieldDescriptor
+	fd_Params_max_freeze_blocks             protoreflect.FieldDescriptor
+	fd_Params_fee_exemptions                protoreflect.FieldDescriptor
+	fd_Params_max_block_exempt_gas          protoreflect.FieldDescriptor
+	fd_Params_burn_fees                     protoreflect.FieldDescriptor
+	fd_Params_fee_split                     protoreflect.FieldDescriptor
+	fd_Params_tip_split                     protoreflect.FieldDescriptor
+	fd_Params_distribute_tips_to_delegators protoreflect.FieldDescriptor
+	fd_Params_unused_gas_policy             protoreflect.FieldDescriptor
+	fd_Params_unused_gas_penalty            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -47,6 +178,34 @@ func init() {
 	fd_Params_enabled = md_Params.Fields().ByName("enabled")
 	fd_Params_distribute_fees = md_Params.Fields().ByName("distribute_fees")
 	fd_Params_send_tip_to_proposer = md_Params.Fields().ByName("send_tip_to_proposer")
+	fd_Params_pricing_model = md_Params.Fields().ByName("pricing_model")
+	fd_Params_utilization_mode = md_Params.Fields().ByName("utilization_mode")
+	fd_Params_target_utilization_ratio = md_Params.Fields().ByName("target_utilization_ratio")
+	fd_Params_max_base_gas_price = md_Params.Fields().ByName("max_base_gas_price")
+	fd_Params_max_base_gas_price_increase = md_Params.Fields().ByName("max_base_gas_price_increase")
+	fd_Params_max_base_gas_price_decrease = md_Params.Fields().ByName("max_base_gas_price_decrease")
+	fd_Params_excess_gas_update_fraction = md_Params.Fields().ByName("excess_gas_update_fraction")
+	fd_Params_pid_proportional_gain = md_Params.Fields().ByName("pid_proportional_gain")
+	fd_Params_pid_integral_gain = md_Params.Fields().ByName("pid_integral_gain")
+	fd_Params_pid_derivative_gain = md_Params.Fields().ByName("pid_derivative_gain")
+	fd_Params_pid_integral_limit = md_Params.Fields().ByName("pid_integral_limit")
+	fd_Params_target_block_time = md_Params.Fields().ByName("target_block_time")
+	fd_Params_downtime_threshold = md_Params.Fields().ByName("downtime_threshold")
+	fd_Params_max_block_bytes = md_Params.Fields().ByName("max_block_bytes")
+	fd_Params_target_block_bytes = md_Params.Fields().ByName("target_block_bytes")
+	fd_Params_min_base_byte_price = md_Params.Fields().ByName("min_base_byte_price")
+	fd_Params_byte_learning_rate = md_Params.Fields().ByName("byte_learning_rate")
+	fd_Params_msg_fee_multipliers = md_Params.Fields().ByName("msg_fee_multipliers")
+	fd_Params_guardian = md_Params.Fields().ByName("guardian")
+	fd_Params_max_freeze_blocks = md_Params.Fields().ByName("max_freeze_blocks")
+	fd_Params_fee_exemptions = md_Params.Fields().ByName("fee_exemptions")
+	fd_Params_max_block_exempt_gas = md_Params.Fields().ByName("max_block_exempt_gas")
+	fd_Params_burn_fees = md_Params.Fields().ByName("burn_fees")
+	fd_Params_fee_split = md_Params.Fields().ByName("fee_split")
+	fd_Params_tip_split = md_Params.Fields().ByName("tip_split")
+	fd_Params_distribute_tips_to_delegators = md_Params.Fields().ByName("distribute_tips_to_delegators")
+	fd_Params_unused_gas_policy = md_Params.Fields().ByName("unused_gas_policy")
+	fd_Params_unused_gas_penalty = md_Params.Fields().ByName("unused_gas_penalty")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -192,6 +351,174 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PricingModel != "" {
+		value := protoreflect.ValueOfString(x.PricingModel)
+		if !f(fd_Params_pricing_model, value) {
+			return
+		}
+	}
+	if x.UtilizationMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.UtilizationMode))
+		if !f(fd_Params_utilization_mode, value) {
+			return
+		}
+	}
+	if x.TargetUtilizationRatio != "" {
+		value := protoreflect.ValueOfString(x.TargetUtilizationRatio)
+		if !f(fd_Params_target_utilization_ratio, value) {
+			return
+		}
+	}
+	if x.MaxBaseGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseGasPrice)
+		if !f(fd_Params_max_base_gas_price, value) {
+			return
+		}
+	}
+	if x.MaxBaseGasPriceIncrease != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseGasPriceIncrease)
+		if !f(fd_Params_max_base_gas_price_increase, value) {
+			return
+		}
+	}
+	if x.MaxBaseGasPriceDecrease != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseGasPriceDecrease)
+		if !f(fd_Params_max_base_gas_price_decrease, value) {
+			return
+		}
+	}
+	if x.ExcessGasUpdateFraction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExcessGasUpdateFraction)
+		if !f(fd_Params_excess_gas_update_fraction, value) {
+			return
+		}
+	}
+	if x.PidProportionalGain != "" {
+		value := protoreflect.ValueOfString(x.PidProportionalGain)
+		if !f(fd_Params_pid_proportional_gain, value) {
+			return
+		}
+	}
+	if x.PidIntegralGain != "" {
+		value := protoreflect.ValueOfString(x.PidIntegralGain)
+		if !f(fd_Params_pid_integral_gain, value) {
+			return
+		}
+	}
+	if x.PidDerivativeGain != "" {
+		value := protoreflect.ValueOfString(x.PidDerivativeGain)
+		if !f(fd_Params_pid_derivative_gain, value) {
+			return
+		}
+	}
+	if x.PidIntegralLimit != "" {
+		value := protoreflect.ValueOfString(x.PidIntegralLimit)
+		if !f(fd_Params_pid_integral_limit, value) {
+			return
+		}
+	}
+	if x.TargetBlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.TargetBlockTime.ProtoReflect())
+		if !f(fd_Params_target_block_time, value) {
+			return
+		}
+	}
+	if x.DowntimeThreshold != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeThreshold.ProtoReflect())
+		if !f(fd_Params_downtime_threshold, value) {
+			return
+		}
+	}
+	if x.MaxBlockBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlockBytes)
+		if !f(fd_Params_max_block_bytes, value) {
+			return
+		}
+	}
+	if x.TargetBlockBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetBlockBytes)
+		if !f(fd_Params_target_block_bytes, value) {
+			return
+		}
+	}
+	if x.MinBaseBytePrice != "" {
+		value := protoreflect.ValueOfString(x.MinBaseBytePrice)
+		if !f(fd_Params_min_base_byte_price, value) {
+			return
+		}
+	}
+	if x.ByteLearningRate != "" {
+		value := protoreflect.ValueOfString(x.ByteLearningRate)
+		if !f(fd_Params_byte_learning_rate, value) {
+			return
+		}
+	}
+	if len(x.MsgFeeMultipliers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_31_list{list: &x.MsgFeeMultipliers})
+		if !f(fd_Params_msg_fee_multipliers, value) {
+			return
+		}
+	}
+	if x.Guardian != "" {
+		value := protoreflect.ValueOfString(x.Guardian)
+		if !f(fd_Params_guardian, value) {
+			return
+		}
+	}
+	if x.MaxFreezeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxFreezeBlocks)
+		if !f(fd_Params_max_freeze_blocks, value) {
+			return
+		}
+	}
+	if len(x.FeeExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_Params_34_list{list: &x.FeeExemptions})
+		if !f(fd_Params_fee_exemptions, value) {
+			return
+		}
+	}
+	if x.MaxBlockExemptGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlockExemptGas)
+		if !f(fd_Params_max_block_exempt_gas, value) {
+			return
+		}
+	}
+	if x.BurnFees != false {
+		value := protoreflect.ValueOfBool(x.BurnFees)
+		if !f(fd_Params_burn_fees, value) {
+			return
+		}
+	}
+	if x.FeeSplit != nil {
+		value := protoreflect.ValueOfMessage(x.FeeSplit.ProtoReflect())
+		if !f(fd_Params_fee_split, value) {
+			return
+		}
+	}
+	if x.TipSplit != nil {
+		value := protoreflect.ValueOfMessage(x.TipSplit.ProtoReflect())
+		if !f(fd_Params_tip_split, value) {
+			return
+		}
+	}
+	if x.DistributeTipsToDelegators != false {
+		value := protoreflect.ValueOfBool(x.DistributeTipsToDelegators)
+		if !f(fd_Params_distribute_tips_to_delegators, value) {
+			return
+		}
+	}
+	if x.UnusedGasPolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.UnusedGasPolicy))
+		if !f(fd_Params_unused_gas_policy, value) {
+			return
+		}
+	}
+	if x.UnusedGasPenalty != "" {
+		value := protoreflect.ValueOfString(x.UnusedGasPenalty)
+		if !f(fd_Params_unused_gas_penalty, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -233,6 +560,62 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DistributeFees != false
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		return x.SendTipToProposer != false
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		return x.PricingModel != ""
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		return x.UtilizationMode != 0
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		return x.TargetUtilizationRatio != ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		return x.MaxBaseGasPrice != ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		return x.MaxBaseGasPriceIncrease != ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		return x.MaxBaseGasPriceDecrease != ""
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		return x.ExcessGasUpdateFraction != uint64(0)
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		return x.PidProportionalGain != ""
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		return x.PidIntegralGain != ""
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		return x.PidDerivativeGain != ""
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		return x.PidIntegralLimit != ""
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		return x.TargetBlockTime != nil
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		return x.DowntimeThreshold != nil
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		return x.MaxBlockBytes != uint64(0)
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		return x.TargetBlockBytes != uint64(0)
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		return x.MinBaseBytePrice != ""
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		return x.ByteLearningRate != ""
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		return len(x.MsgFeeMultipliers) != 0
+	case "feemarket.feemarket.v1.Params.guardian":
+		return x.Guardian != ""
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		return x.MaxFreezeBlocks != uint64(0)
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		return len(x.FeeExemptions) != 0
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		return x.MaxBlockExemptGas != uint64(0)
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		return x.BurnFees != false
+	case "feemarket.feemarket.v1.Params.fee_split":
+		return x.FeeSplit != nil
+	case "feemarket.feemarket.v1.Params.tip_split":
+		return x.TipSplit != nil
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		return x.DistributeTipsToDelegators != false
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		return x.UnusedGasPolicy != 0
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		return x.UnusedGasPenalty != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -275,6 +658,62 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DistributeFees = false
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		x.SendTipToProposer = false
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		x.PricingModel = ""
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		x.UtilizationMode = 0
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		x.TargetUtilizationRatio = ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		x.MaxBaseGasPrice = ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		x.MaxBaseGasPriceIncrease = ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		x.MaxBaseGasPriceDecrease = ""
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		x.ExcessGasUpdateFraction = uint64(0)
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		x.PidProportionalGain = ""
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		x.PidIntegralGain = ""
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		x.PidDerivativeGain = ""
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		x.PidIntegralLimit = ""
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		x.TargetBlockTime = nil
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		x.DowntimeThreshold = nil
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		x.MaxBlockBytes = uint64(0)
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		x.TargetBlockBytes = uint64(0)
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		x.MinBaseBytePrice = ""
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		x.ByteLearningRate = ""
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		x.MsgFeeMultipliers = nil
+	case "feemarket.feemarket.v1.Params.guardian":
+		x.Guardian = ""
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		x.MaxFreezeBlocks = uint64(0)
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		x.FeeExemptions = nil
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		x.MaxBlockExemptGas = uint64(0)
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		x.BurnFees = false
+	case "feemarket.feemarket.v1.Params.fee_split":
+		x.FeeSplit = nil
+	case "feemarket.feemarket.v1.Params.tip_split":
+		x.TipSplit = nil
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		x.DistributeTipsToDelegators = false
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		x.UnusedGasPolicy = 0
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		x.UnusedGasPenalty = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -330,6 +769,96 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		value := x.SendTipToProposer
 		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		value := x.PricingModel
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		value := x.UtilizationMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		value := x.TargetUtilizationRatio
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		value := x.MaxBaseGasPrice
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		value := x.MaxBaseGasPriceIncrease
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		value := x.MaxBaseGasPriceDecrease
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		value := x.ExcessGasUpdateFraction
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		value := x.PidProportionalGain
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		value := x.PidIntegralGain
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		value := x.PidDerivativeGain
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		value := x.PidIntegralLimit
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		value := x.TargetBlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		value := x.DowntimeThreshold
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		value := x.MaxBlockBytes
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		value := x.TargetBlockBytes
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		value := x.MinBaseBytePrice
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		value := x.ByteLearningRate
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		if len(x.MsgFeeMultipliers) == 0 {
+			return protoreflect.ValueOfList(&_Params_31_list{})
+		}
+		listValue := &_Params_31_list{list: &x.MsgFeeMultipliers}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.Params.guardian":
+		value := x.Guardian
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		value := x.MaxFreezeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		if len(x.FeeExemptions) == 0 {
+			return protoreflect.ValueOfList(&_Params_34_list{})
+		}
+		listValue := &_Params_34_list{list: &x.FeeExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		value := x.MaxBlockExemptGas
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		value := x.BurnFees
+		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.fee_split":
+		value := x.FeeSplit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.tip_split":
+		value := x.TipSplit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		value := x.DistributeTipsToDelegators
+		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		value := x.UnusedGasPolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		value := x.UnusedGasPenalty
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -376,6 +905,66 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DistributeFees = value.Bool()
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		x.SendTipToProposer = value.Bool()
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		x.PricingModel = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		x.UtilizationMode = (UtilizationMode)(value.Enum())
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		x.TargetUtilizationRatio = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		x.MaxBaseGasPrice = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		x.MaxBaseGasPriceIncrease = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		x.MaxBaseGasPriceDecrease = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		x.ExcessGasUpdateFraction = value.Uint()
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		x.PidProportionalGain = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		x.PidIntegralGain = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		x.PidDerivativeGain = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		x.PidIntegralLimit = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		x.TargetBlockTime = value.Message().Interface().(*durationpb.Duration)
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		x.DowntimeThreshold = value.Message().Interface().(*durationpb.Duration)
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		x.MaxBlockBytes = value.Uint()
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		x.TargetBlockBytes = value.Uint()
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		x.MinBaseBytePrice = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		x.ByteLearningRate = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		lv := value.List()
+		clv := lv.(*_Params_31_list)
+		x.MsgFeeMultipliers = *clv.list
+	case "feemarket.feemarket.v1.Params.guardian":
+		x.Guardian = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		x.MaxFreezeBlocks = value.Uint()
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		lv := value.List()
+		clv := lv.(*_Params_34_list)
+		x.FeeExemptions = *clv.list
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		x.MaxBlockExemptGas = value.Uint()
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		x.BurnFees = value.Bool()
+	case "feemarket.feemarket.v1.Params.fee_split":
+		x.FeeSplit = value.Message().Interface().(*FeeSplit)
+	case "feemarket.feemarket.v1.Params.tip_split":
+		x.TipSplit = value.Message().Interface().(*TipSplit)
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		x.DistributeTipsToDelegators = value.Bool()
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		x.UnusedGasPolicy = (UnusedGasPolicy)(value.Enum())
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		x.UnusedGasPenalty = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -396,6 +985,38 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		if x.TargetBlockTime == nil {
+			x.TargetBlockTime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TargetBlockTime.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		if x.DowntimeThreshold == nil {
+			x.DowntimeThreshold = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeThreshold.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		if x.MsgFeeMultipliers == nil {
+			x.MsgFeeMultipliers = []*MsgFeeMultiplier{}
+		}
+		value := &_Params_31_list{list: &x.MsgFeeMultipliers}
+		return protoreflect.ValueOfList(value)
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		if x.FeeExemptions == nil {
+			x.FeeExemptions = []*FeeExemption{}
+		}
+		value := &_Params_34_list{list: &x.FeeExemptions}
+		return protoreflect.ValueOfList(value)
+	case "feemarket.feemarket.v1.Params.fee_split":
+		if x.FeeSplit == nil {
+			x.FeeSplit = new(FeeSplit)
+		}
+		return protoreflect.ValueOfMessage(x.FeeSplit.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.tip_split":
+		if x.TipSplit == nil {
+			x.TipSplit = new(TipSplit)
+		}
+		return protoreflect.ValueOfMessage(x.TipSplit.ProtoReflect())
 	case "feemarket.feemarket.v1.Params.alpha":
 		panic(fmt.Errorf("field alpha of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.beta":
@@ -422,6 +1043,50 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field distribute_fees of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		panic(fmt.Errorf("field send_tip_to_proposer of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		panic(fmt.Errorf("field pricing_model of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		panic(fmt.Errorf("field utilization_mode of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		panic(fmt.Errorf("field target_utilization_ratio of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		panic(fmt.Errorf("field max_base_gas_price of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		panic(fmt.Errorf("field max_base_gas_price_increase of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		panic(fmt.Errorf("field max_base_gas_price_decrease of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		panic(fmt.Errorf("field excess_gas_update_fraction of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		panic(fmt.Errorf("field pid_proportional_gain of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		panic(fmt.Errorf("field pid_integral_gain of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		panic(fmt.Errorf("field pid_derivative_gain of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		panic(fmt.Errorf("field pid_integral_limit of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		panic(fmt.Errorf("field max_block_bytes of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		panic(fmt.Errorf("field target_block_bytes of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		panic(fmt.Errorf("field min_base_byte_price of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		panic(fmt.Errorf("field byte_learning_rate of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.guardian":
+		panic(fmt.Errorf("field guardian of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		panic(fmt.Errorf("field max_freeze_blocks of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		panic(fmt.Errorf("field max_block_exempt_gas of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		panic(fmt.Errorf("field burn_fees of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		panic(fmt.Errorf("field distribute_tips_to_delegators of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		panic(fmt.Errorf("field unused_gas_policy of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		panic(fmt.Errorf("field unused_gas_penalty of message feemarket.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -461,6 +1126,68 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "feemarket.feemarket.v1.Params.send_tip_to_proposer":
 		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.pricing_model":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.utilization_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "feemarket.feemarket.v1.Params.target_utilization_ratio":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_increase":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_base_gas_price_decrease":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.excess_gas_update_fraction":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.pid_proportional_gain":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.pid_integral_gain":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.pid_derivative_gain":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.pid_integral_limit":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.target_block_time":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.downtime_threshold":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.max_block_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.target_block_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.min_base_byte_price":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.byte_learning_rate":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.msg_fee_multipliers":
+		list := []*MsgFeeMultiplier{}
+		return protoreflect.ValueOfList(&_Params_31_list{list: &list})
+	case "feemarket.feemarket.v1.Params.guardian":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_freeze_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.fee_exemptions":
+		list := []*FeeExemption{}
+		return protoreflect.ValueOfList(&_Params_34_list{list: &list})
+	case "feemarket.feemarket.v1.Params.max_block_exempt_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.burn_fees":
+		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.fee_split":
+		m := new(FeeSplit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.tip_split":
+		m := new(TipSplit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.Params.distribute_tips_to_delegators":
+		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.unused_gas_policy":
+		return protoreflect.ValueOfEnum(0)
+	case "feemarket.feemarket.v1.Params.unused_gas_penalty":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -577,6 +1304,113 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.SendTipToProposer {
 			n += 2
 		}
+		l = len(x.PricingModel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UtilizationMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.UtilizationMode))
+		}
+		l = len(x.TargetUtilizationRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseGasPrice)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseGasPriceIncrease)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseGasPriceDecrease)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExcessGasUpdateFraction != 0 {
+			n += 2 + runtime.Sov(uint64(x.ExcessGasUpdateFraction))
+		}
+		l = len(x.PidProportionalGain)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PidIntegralGain)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PidDerivativeGain)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PidIntegralLimit)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetBlockTime != nil {
+			l = options.Size(x.TargetBlockTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DowntimeThreshold != nil {
+			l = options.Size(x.DowntimeThreshold)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxBlockBytes != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxBlockBytes))
+		}
+		if x.TargetBlockBytes != 0 {
+			n += 2 + runtime.Sov(uint64(x.TargetBlockBytes))
+		}
+		l = len(x.MinBaseBytePrice)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByteLearningRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgFeeMultipliers) > 0 {
+			for _, e := range x.MsgFeeMultipliers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Guardian)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxFreezeBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxFreezeBlocks))
+		}
+		if len(x.FeeExemptions) > 0 {
+			for _, e := range x.FeeExemptions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxBlockExemptGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxBlockExemptGas))
+		}
+		if x.BurnFees {
+			n += 3
+		}
+		if x.FeeSplit != nil {
+			l = options.Size(x.FeeSplit)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.TipSplit != nil {
+			l = options.Size(x.TipSplit)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DistributeTipsToDelegators {
+			n += 3
+		}
+		if x.UnusedGasPolicy != 0 {
+			n += 2 + runtime.Sov(uint64(x.UnusedGasPolicy))
+		}
+		l = len(x.UnusedGasPenalty)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -606,55 +1440,341 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SendTipToProposer {
+		if len(x.UnusedGasPenalty) > 0 {
+			i -= len(x.UnusedGasPenalty)
+			copy(dAtA[i:], x.UnusedGasPenalty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnusedGasPenalty)))
 			i--
-			if x.SendTipToProposer {
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.UnusedGasPolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnusedGasPolicy))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.DistributeTipsToDelegators {
+			i--
+			if x.DistributeTipsToDelegators {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x68
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb8
 		}
-		if x.DistributeFees {
+		if x.TipSplit != nil {
+			encoded, err := options.Marshal(x.TipSplit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			if x.DistributeFees {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.FeeSplit != nil {
+			encoded, err := options.Marshal(x.FeeSplit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x60
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
-		if x.Enabled {
+		if x.BurnFees {
 			i--
-			if x.Enabled {
+			if x.BurnFees {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x58
-		}
-		if len(x.FeeDenom) > 0 {
-			i -= len(x.FeeDenom)
-			copy(dAtA[i:], x.FeeDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeDenom)))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xa0
 		}
-		if x.Window != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+		if x.MaxBlockExemptGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockExemptGas))
 			i--
-			dAtA[i] = 0x48
-		}
-		if x.MaxBlockUtilization != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockUtilization))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x98
 		}
-		if len(x.MaxLearningRate) > 0 {
-			i -= len(x.MaxLearningRate)
+		if len(x.FeeExemptions) > 0 {
+			for iNdEx := len(x.FeeExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeExemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.MaxFreezeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFreezeBlocks))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.Guardian) > 0 {
+			i -= len(x.Guardian)
+			copy(dAtA[i:], x.Guardian)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Guardian)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.MsgFeeMultipliers) > 0 {
+			for iNdEx := len(x.MsgFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgFeeMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xfa
+			}
+		}
+		if len(x.ByteLearningRate) > 0 {
+			i -= len(x.ByteLearningRate)
+			copy(dAtA[i:], x.ByteLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByteLearningRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.MinBaseBytePrice) > 0 {
+			i -= len(x.MinBaseBytePrice)
+			copy(dAtA[i:], x.MinBaseBytePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseBytePrice)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.TargetBlockBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetBlockBytes))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if x.MaxBlockBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockBytes))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
+		}
+		if x.DowntimeThreshold != nil {
+			encoded, err := options.Marshal(x.DowntimeThreshold)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if x.TargetBlockTime != nil {
+			encoded, err := options.Marshal(x.TargetBlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.PidIntegralLimit) > 0 {
+			i -= len(x.PidIntegralLimit)
+			copy(dAtA[i:], x.PidIntegralLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PidIntegralLimit)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.PidDerivativeGain) > 0 {
+			i -= len(x.PidDerivativeGain)
+			copy(dAtA[i:], x.PidDerivativeGain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PidDerivativeGain)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.PidIntegralGain) > 0 {
+			i -= len(x.PidIntegralGain)
+			copy(dAtA[i:], x.PidIntegralGain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PidIntegralGain)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.PidProportionalGain) > 0 {
+			i -= len(x.PidProportionalGain)
+			copy(dAtA[i:], x.PidProportionalGain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PidProportionalGain)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.ExcessGasUpdateFraction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExcessGasUpdateFraction))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.MaxBaseGasPriceDecrease) > 0 {
+			i -= len(x.MaxBaseGasPriceDecrease)
+			copy(dAtA[i:], x.MaxBaseGasPriceDecrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseGasPriceDecrease)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.MaxBaseGasPriceIncrease) > 0 {
+			i -= len(x.MaxBaseGasPriceIncrease)
+			copy(dAtA[i:], x.MaxBaseGasPriceIncrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseGasPriceIncrease)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.MaxBaseGasPrice) > 0 {
+			i -= len(x.MaxBaseGasPrice)
+			copy(dAtA[i:], x.MaxBaseGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseGasPrice)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.TargetUtilizationRatio) > 0 {
+			i -= len(x.TargetUtilizationRatio)
+			copy(dAtA[i:], x.TargetUtilizationRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetUtilizationRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.UtilizationMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UtilizationMode))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.PricingModel) > 0 {
+			i -= len(x.PricingModel)
+			copy(dAtA[i:], x.PricingModel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PricingModel)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.SendTipToProposer {
+			i--
+			if x.SendTipToProposer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.DistributeFees {
+			i--
+			if x.DistributeFees {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.FeeDenom) > 0 {
+			i -= len(x.FeeDenom)
+			copy(dAtA[i:], x.FeeDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeDenom)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxBlockUtilization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockUtilization))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.MaxLearningRate) > 0 {
+			i -= len(x.MaxLearningRate)
 			copy(dAtA[i:], x.MaxLearningRate)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxLearningRate)))
 			i--
@@ -1105,218 +2225,4944 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.SendTipToProposer = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricingModel", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PricingModel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UtilizationMode", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: feemarket/feemarket/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params contains the required set of parameters for the EIP1559 fee market
-// plugin implementation.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Alpha is the amount we additively increase the learning rate
-	// when it is above or below the target +/- threshold.
-	//
-	// Must be > 0.
-	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
-	// Beta is the amount we multiplicatively decrease the learning rate
-	// when it is within the target +/- threshold.
-	//
-	// Must be [0, 1].
-	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
-	// Gamma is the threshold for the learning rate. If the learning rate is
-	// above or below the target +/- threshold, we additively increase the
-	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
-	// learning rate by Beta.
-	//
-	// Must be [0, 0.5].
-	Gamma string `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
-	// Delta is the amount we additively increase/decrease the gas price when the
-	// net block utilization difference in the window is above/below the target
-	// utilization.
-	Delta string `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
-	// MinBaseGasPrice determines the initial gas price of the module and the
-	// global minimum for the network.
-	MinBaseGasPrice string `protobuf:"bytes,5,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
-	// MinLearningRate is the lower bound for the learning rate.
-	MinLearningRate string `protobuf:"bytes,6,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
-	// MaxLearningRate is the upper bound for the learning rate.
-	MaxLearningRate string `protobuf:"bytes,7,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
-	// MaxBlockUtilization is the maximum block utilization.
-	MaxBlockUtilization uint64 `protobuf:"varint,8,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
-	// Window defines the window size for calculating an adaptive learning rate
-	// over a moving window of blocks.
-	Window uint64 `protobuf:"varint,9,opt,name=window,proto3" json:"window,omitempty"`
-	// FeeDenom is the denom that will be used for all fee payments.
-	FeeDenom string `protobuf:"bytes,10,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
-	// Enabled is a boolean that determines whether the EIP1559 fee market is
-	// enabled.
-	Enabled bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// DistributeFees is a boolean that determines whether the fees are burned or
-	// distributed to all stakers.
-	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
-	// SendTipToProposer is a boolean that determines whether the tip is sent to a
-	// proposer or to a module account.
-	SendTipToProposer bool `protobuf:"varint,13,opt,name=send_tip_to_proposer,json=sendTipToProposer,proto3" json:"send_tip_to_proposer,omitempty"`
-}
-
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Params) GetAlpha() string {
-	if x != nil {
-		return x.Alpha
-	}
-	return ""
+				x.UtilizationMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UtilizationMode |= UtilizationMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtilizationRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetUtilizationRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPriceIncrease", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseGasPriceIncrease = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPriceDecrease", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseGasPriceDecrease = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcessGasUpdateFraction", wireType)
+				}
+				x.ExcessGasUpdateFraction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExcessGasUpdateFraction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PidProportionalGain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PidProportionalGain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PidIntegralGain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PidIntegralGain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PidDerivativeGain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PidDerivativeGain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PidIntegralLimit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PidIntegralLimit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TargetBlockTime == nil {
+					x.TargetBlockTime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetBlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeThreshold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeThreshold == nil {
+					x.DowntimeThreshold = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeThreshold); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockBytes", wireType)
+				}
+				x.MaxBlockBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlockBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockBytes", wireType)
+				}
+				x.TargetBlockBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetBlockBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseBytePrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseBytePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByteLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ByteLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgFeeMultipliers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgFeeMultipliers = append(x.MsgFeeMultipliers, &MsgFeeMultiplier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgFeeMultipliers[len(x.MsgFeeMultipliers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Guardian = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 33:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFreezeBlocks", wireType)
+				}
+				x.MaxFreezeBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFreezeBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeExemptions = append(x.FeeExemptions, &FeeExemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeExemptions[len(x.FeeExemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockExemptGas", wireType)
+				}
+				x.MaxBlockExemptGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlockExemptGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 36:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFees", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnFees = bool(v != 0)
+			case 37:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeSplit == nil {
+					x.FeeSplit = &FeeSplit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 38:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipSplit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TipSplit == nil {
+					x.TipSplit = &TipSplit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TipSplit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 39:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributeTipsToDelegators", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DistributeTipsToDelegators = bool(v != 0)
+			case 40:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnusedGasPolicy", wireType)
+				}
+				x.UnusedGasPolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnusedGasPolicy |= UnusedGasPolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 41:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnusedGasPenalty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnusedGasPenalty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFeeMultiplier              protoreflect.MessageDescriptor
+	fd_MsgFeeMultiplier_msg_type_url protoreflect.FieldDescriptor
+	fd_MsgFeeMultiplier_multiplier   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_MsgFeeMultiplier = File_feemarket_feemarket_v1_params_proto.Messages().ByName("MsgFeeMultiplier")
+	fd_MsgFeeMultiplier_msg_type_url = md_MsgFeeMultiplier.Fields().ByName("msg_type_url")
+	fd_MsgFeeMultiplier_multiplier = md_MsgFeeMultiplier.Fields().ByName("multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFeeMultiplier)(nil)
+
+type fastReflection_MsgFeeMultiplier MsgFeeMultiplier
+
+func (x *MsgFeeMultiplier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFeeMultiplier)(x)
+}
+
+func (x *MsgFeeMultiplier) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFeeMultiplier_messageType fastReflection_MsgFeeMultiplier_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFeeMultiplier_messageType{}
+
+type fastReflection_MsgFeeMultiplier_messageType struct{}
+
+func (x fastReflection_MsgFeeMultiplier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFeeMultiplier)(nil)
+}
+func (x fastReflection_MsgFeeMultiplier_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFeeMultiplier)
+}
+func (x fastReflection_MsgFeeMultiplier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFeeMultiplier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFeeMultiplier) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFeeMultiplier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFeeMultiplier) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFeeMultiplier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFeeMultiplier) New() protoreflect.Message {
+	return new(fastReflection_MsgFeeMultiplier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFeeMultiplier) Interface() protoreflect.ProtoMessage {
+	return (*MsgFeeMultiplier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFeeMultiplier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgFeeMultiplier_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_MsgFeeMultiplier_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFeeMultiplier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		return x.Multiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeMultiplier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		x.Multiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFeeMultiplier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeMultiplier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		x.Multiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeMultiplier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message feemarket.feemarket.v1.MsgFeeMultiplier is not mutable"))
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		panic(fmt.Errorf("field multiplier of message feemarket.feemarket.v1.MsgFeeMultiplier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFeeMultiplier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.MsgFeeMultiplier.multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgFeeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgFeeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFeeMultiplier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.MsgFeeMultiplier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFeeMultiplier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFeeMultiplier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFeeMultiplier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFeeMultiplier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFeeMultiplier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFeeMultiplier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFeeMultiplier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFeeMultiplier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeExemption              protoreflect.MessageDescriptor
+	fd_FeeExemption_msg_type_url protoreflect.FieldDescriptor
+	fd_FeeExemption_address      protoreflect.FieldDescriptor
+	fd_FeeExemption_max_tx_gas   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_FeeExemption = File_feemarket_feemarket_v1_params_proto.Messages().ByName("FeeExemption")
+	fd_FeeExemption_msg_type_url = md_FeeExemption.Fields().ByName("msg_type_url")
+	fd_FeeExemption_address = md_FeeExemption.Fields().ByName("address")
+	fd_FeeExemption_max_tx_gas = md_FeeExemption.Fields().ByName("max_tx_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeExemption)(nil)
+
+type fastReflection_FeeExemption FeeExemption
+
+func (x *FeeExemption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeExemption)(x)
+}
+
+func (x *FeeExemption) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeExemption_messageType fastReflection_FeeExemption_messageType
+var _ protoreflect.MessageType = fastReflection_FeeExemption_messageType{}
+
+type fastReflection_FeeExemption_messageType struct{}
+
+func (x fastReflection_FeeExemption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeExemption)(nil)
+}
+func (x fastReflection_FeeExemption_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeExemption)
+}
+func (x fastReflection_FeeExemption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeExemption
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeExemption) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeExemption
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeExemption) Type() protoreflect.MessageType {
+	return _fastReflection_FeeExemption_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeExemption) New() protoreflect.Message {
+	return new(fastReflection_FeeExemption)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeExemption) Interface() protoreflect.ProtoMessage {
+	return (*FeeExemption)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeExemption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_FeeExemption_msg_type_url, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_FeeExemption_address, value) {
+			return
+		}
+	}
+	if x.MaxTxGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxGas)
+		if !f(fd_FeeExemption_max_tx_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeExemption) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		return x.Address != ""
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		return x.MaxTxGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeExemption) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		x.Address = ""
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		x.MaxTxGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeExemption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		value := x.MaxTxGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeExemption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		x.Address = value.Interface().(string)
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		x.MaxTxGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeExemption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message feemarket.feemarket.v1.FeeExemption is not mutable"))
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		panic(fmt.Errorf("field address of message feemarket.feemarket.v1.FeeExemption is not mutable"))
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		panic(fmt.Errorf("field max_tx_gas of message feemarket.feemarket.v1.FeeExemption is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeExemption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeExemption.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.FeeExemption.address":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.FeeExemption.max_tx_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeExemption"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeExemption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.FeeExemption", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeExemption) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeExemption) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeExemption) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeExemption) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeExemption)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeExemption)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxTxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeExemption)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeExemption: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+				}
+				x.MaxTxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTxGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeSplit                    protoreflect.MessageDescriptor
+	fd_FeeSplit_burn_bps           protoreflect.FieldDescriptor
+	fd_FeeSplit_community_pool_bps protoreflect.FieldDescriptor
+	fd_FeeSplit_recipient_bps      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_FeeSplit = File_feemarket_feemarket_v1_params_proto.Messages().ByName("FeeSplit")
+	fd_FeeSplit_burn_bps = md_FeeSplit.Fields().ByName("burn_bps")
+	fd_FeeSplit_community_pool_bps = md_FeeSplit.Fields().ByName("community_pool_bps")
+	fd_FeeSplit_recipient_bps = md_FeeSplit.Fields().ByName("recipient_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSplit)(nil)
+
+type fastReflection_FeeSplit FeeSplit
+
+func (x *FeeSplit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(x)
+}
+
+func (x *FeeSplit) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSplit_messageType fastReflection_FeeSplit_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSplit_messageType{}
+
+type fastReflection_FeeSplit_messageType struct{}
+
+func (x fastReflection_FeeSplit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(nil)
+}
+func (x fastReflection_FeeSplit_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+func (x fastReflection_FeeSplit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSplit) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSplit) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSplit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSplit) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSplit) Interface() protoreflect.ProtoMessage {
+	return (*FeeSplit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSplit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BurnBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BurnBps)
+		if !f(fd_FeeSplit_burn_bps, value) {
+			return
+		}
+	}
+	if x.CommunityPoolBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CommunityPoolBps)
+		if !f(fd_FeeSplit_community_pool_bps, value) {
+			return
+		}
+	}
+	if x.RecipientBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RecipientBps)
+		if !f(fd_FeeSplit_recipient_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSplit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		return x.BurnBps != uint32(0)
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		return x.CommunityPoolBps != uint32(0)
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		return x.RecipientBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		x.BurnBps = uint32(0)
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		x.CommunityPoolBps = uint32(0)
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		x.RecipientBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSplit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		value := x.BurnBps
+		return protoreflect.ValueOfUint32(value)
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		value := x.CommunityPoolBps
+		return protoreflect.ValueOfUint32(value)
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		value := x.RecipientBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		x.BurnBps = uint32(value.Uint())
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		x.CommunityPoolBps = uint32(value.Uint())
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		x.RecipientBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		panic(fmt.Errorf("field burn_bps of message feemarket.feemarket.v1.FeeSplit is not mutable"))
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		panic(fmt.Errorf("field community_pool_bps of message feemarket.feemarket.v1.FeeSplit is not mutable"))
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		panic(fmt.Errorf("field recipient_bps of message feemarket.feemarket.v1.FeeSplit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSplit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeSplit.burn_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "feemarket.feemarket.v1.FeeSplit.community_pool_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "feemarket.feemarket.v1.FeeSplit.recipient_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSplit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.FeeSplit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSplit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSplit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSplit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BurnBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.BurnBps))
+		}
+		if x.CommunityPoolBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommunityPoolBps))
+		}
+		if x.RecipientBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecipientBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RecipientBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecipientBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CommunityPoolBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommunityPoolBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BurnBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BurnBps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+				}
+				x.BurnBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BurnBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+				}
+				x.CommunityPoolBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommunityPoolBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientBps", wireType)
+				}
+				x.RecipientBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecipientBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TipSplit               protoreflect.MessageDescriptor
+	fd_TipSplit_proposer_bps  protoreflect.FieldDescriptor
+	fd_TipSplit_recipient_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_TipSplit = File_feemarket_feemarket_v1_params_proto.Messages().ByName("TipSplit")
+	fd_TipSplit_proposer_bps = md_TipSplit.Fields().ByName("proposer_bps")
+	fd_TipSplit_recipient_bps = md_TipSplit.Fields().ByName("recipient_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_TipSplit)(nil)
+
+type fastReflection_TipSplit TipSplit
+
+func (x *TipSplit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TipSplit)(x)
+}
+
+func (x *TipSplit) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TipSplit_messageType fastReflection_TipSplit_messageType
+var _ protoreflect.MessageType = fastReflection_TipSplit_messageType{}
+
+type fastReflection_TipSplit_messageType struct{}
+
+func (x fastReflection_TipSplit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TipSplit)(nil)
+}
+func (x fastReflection_TipSplit_messageType) New() protoreflect.Message {
+	return new(fastReflection_TipSplit)
+}
+func (x fastReflection_TipSplit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TipSplit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TipSplit) Descriptor() protoreflect.MessageDescriptor {
+	return md_TipSplit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TipSplit) Type() protoreflect.MessageType {
+	return _fastReflection_TipSplit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TipSplit) New() protoreflect.Message {
+	return new(fastReflection_TipSplit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TipSplit) Interface() protoreflect.ProtoMessage {
+	return (*TipSplit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TipSplit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposerBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProposerBps)
+		if !f(fd_TipSplit_proposer_bps, value) {
+			return
+		}
+	}
+	if x.RecipientBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RecipientBps)
+		if !f(fd_TipSplit_recipient_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TipSplit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		return x.ProposerBps != uint32(0)
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		return x.RecipientBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipSplit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		x.ProposerBps = uint32(0)
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		x.RecipientBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TipSplit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		value := x.ProposerBps
+		return protoreflect.ValueOfUint32(value)
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		value := x.RecipientBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipSplit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		x.ProposerBps = uint32(value.Uint())
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		x.RecipientBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipSplit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		panic(fmt.Errorf("field proposer_bps of message feemarket.feemarket.v1.TipSplit is not mutable"))
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		panic(fmt.Errorf("field recipient_bps of message feemarket.feemarket.v1.TipSplit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TipSplit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.TipSplit.proposer_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "feemarket.feemarket.v1.TipSplit.recipient_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.TipSplit"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.TipSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TipSplit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.TipSplit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TipSplit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TipSplit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TipSplit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TipSplit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TipSplit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposerBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposerBps))
+		}
+		if x.RecipientBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecipientBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TipSplit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RecipientBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecipientBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ProposerBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposerBps))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TipSplit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TipSplit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerBps", wireType)
+				}
+				x.ProposerBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposerBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientBps", wireType)
+				}
+				x.RecipientBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecipientBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledParams             protoreflect.MessageDescriptor
+	fd_ScheduledParams_height      protoreflect.FieldDescriptor
+	fd_ScheduledParams_params      protoreflect.FieldDescriptor
+	fd_ScheduledParams_reset_state protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_ScheduledParams = File_feemarket_feemarket_v1_params_proto.Messages().ByName("ScheduledParams")
+	fd_ScheduledParams_height = md_ScheduledParams.Fields().ByName("height")
+	fd_ScheduledParams_params = md_ScheduledParams.Fields().ByName("params")
+	fd_ScheduledParams_reset_state = md_ScheduledParams.Fields().ByName("reset_state")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledParams)(nil)
+
+type fastReflection_ScheduledParams ScheduledParams
+
+func (x *ScheduledParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledParams)(x)
+}
+
+func (x *ScheduledParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledParams_messageType fastReflection_ScheduledParams_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledParams_messageType{}
+
+type fastReflection_ScheduledParams_messageType struct{}
+
+func (x fastReflection_ScheduledParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledParams)(nil)
+}
+func (x fastReflection_ScheduledParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParams)
+}
+func (x fastReflection_ScheduledParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledParams) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledParams) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledParams) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledParams_height, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ScheduledParams_params, value) {
+			return
+		}
+	}
+	if x.ResetState != false {
+		value := protoreflect.ValueOfBool(x.ResetState)
+		if !f(fd_ScheduledParams_reset_state, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		return x.Height != int64(0)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		return x.Params != nil
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		return x.ResetState != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		x.Height = int64(0)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		x.Params = nil
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		x.ResetState = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		value := x.ResetState
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		x.Height = value.Int()
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		x.ResetState = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		panic(fmt.Errorf("field height of message feemarket.feemarket.v1.ScheduledParams is not mutable"))
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		panic(fmt.Errorf("field reset_state of message feemarket.feemarket.v1.ScheduledParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.ScheduledParams.reset_state":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.ScheduledParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ResetState {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ResetState {
+			i--
+			if x.ResetState {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResetState", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ResetState = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParamsRamp               protoreflect.MessageDescriptor
+	fd_ParamsRamp_start_params  protoreflect.FieldDescriptor
+	fd_ParamsRamp_target_params protoreflect.FieldDescriptor
+	fd_ParamsRamp_start_height  protoreflect.FieldDescriptor
+	fd_ParamsRamp_blocks        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_ParamsRamp = File_feemarket_feemarket_v1_params_proto.Messages().ByName("ParamsRamp")
+	fd_ParamsRamp_start_params = md_ParamsRamp.Fields().ByName("start_params")
+	fd_ParamsRamp_target_params = md_ParamsRamp.Fields().ByName("target_params")
+	fd_ParamsRamp_start_height = md_ParamsRamp.Fields().ByName("start_height")
+	fd_ParamsRamp_blocks = md_ParamsRamp.Fields().ByName("blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamsRamp)(nil)
+
+type fastReflection_ParamsRamp ParamsRamp
+
+func (x *ParamsRamp) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsRamp)(x)
+}
+
+func (x *ParamsRamp) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamsRamp_messageType fastReflection_ParamsRamp_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsRamp_messageType{}
+
+type fastReflection_ParamsRamp_messageType struct{}
+
+func (x fastReflection_ParamsRamp_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsRamp)(nil)
+}
+func (x fastReflection_ParamsRamp_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsRamp)
+}
+func (x fastReflection_ParamsRamp_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRamp
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamsRamp) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRamp
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamsRamp) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsRamp_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamsRamp) New() protoreflect.Message {
+	return new(fastReflection_ParamsRamp)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamsRamp) Interface() protoreflect.ProtoMessage {
+	return (*ParamsRamp)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamsRamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartParams != nil {
+		value := protoreflect.ValueOfMessage(x.StartParams.ProtoReflect())
+		if !f(fd_ParamsRamp_start_params, value) {
+			return
+		}
+	}
+	if x.TargetParams != nil {
+		value := protoreflect.ValueOfMessage(x.TargetParams.ProtoReflect())
+		if !f(fd_ParamsRamp_target_params, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_ParamsRamp_start_height, value) {
+			return
+		}
+	}
+	if x.Blocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Blocks)
+		if !f(fd_ParamsRamp_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamsRamp) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		return x.StartParams != nil
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		return x.TargetParams != nil
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		return x.StartHeight != int64(0)
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		return x.Blocks != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRamp) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		x.StartParams = nil
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		x.TargetParams = nil
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		x.StartHeight = int64(0)
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		x.Blocks = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamsRamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		value := x.StartParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		value := x.TargetParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		value := x.Blocks
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		x.StartParams = value.Message().Interface().(*Params)
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		x.TargetParams = value.Message().Interface().(*Params)
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		x.StartHeight = value.Int()
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		x.Blocks = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		if x.StartParams == nil {
+			x.StartParams = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.StartParams.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		if x.TargetParams == nil {
+			x.TargetParams = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.TargetParams.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		panic(fmt.Errorf("field start_height of message feemarket.feemarket.v1.ParamsRamp is not mutable"))
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		panic(fmt.Errorf("field blocks of message feemarket.feemarket.v1.ParamsRamp is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamsRamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ParamsRamp.start_params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.target_params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.ParamsRamp.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "feemarket.feemarket.v1.ParamsRamp.blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ParamsRamp"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ParamsRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamsRamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.ParamsRamp", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamsRamp) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRamp) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamsRamp) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamsRamp) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamsRamp)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartParams != nil {
+			l = options.Size(x.StartParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetParams != nil {
+			l = options.Size(x.TargetParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.Blocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Blocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamsRamp)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Blocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Blocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TargetParams != nil {
+			encoded, err := options.Marshal(x.TargetParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartParams != nil {
+			encoded, err := options.Marshal(x.StartParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamsRamp)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRamp: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartParams == nil {
+					x.StartParams = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TargetParams == nil {
+					x.TargetParams = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				}
+				x.Blocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Blocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: feemarket/feemarket/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UtilizationMode defines how the block utilization that drives the base gas
+// price adjustment is derived from the utilization window.
+type UtilizationMode int32
+
+const (
+	// UTILIZATION_MODE_UNSPECIFIED defaults to the utilization of the current
+	// block.
+	UtilizationMode_UTILIZATION_MODE_UNSPECIFIED UtilizationMode = 0
+	// UTILIZATION_MODE_CURRENT_BLOCK uses the utilization of the current block.
+	UtilizationMode_UTILIZATION_MODE_CURRENT_BLOCK UtilizationMode = 1
+	// UTILIZATION_MODE_WINDOW_AVERAGE uses the plain average utilization of all
+	// blocks in the window.
+	UtilizationMode_UTILIZATION_MODE_WINDOW_AVERAGE UtilizationMode = 2
+	// UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE uses a linearly weighted average
+	// utilization of all blocks in the window, where more recent blocks are
+	// weighted more heavily.
+	UtilizationMode_UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE UtilizationMode = 3
+)
+
+// Enum value maps for UtilizationMode.
+var (
+	UtilizationMode_name = map[int32]string{
+		0: "UTILIZATION_MODE_UNSPECIFIED",
+		1: "UTILIZATION_MODE_CURRENT_BLOCK",
+		2: "UTILIZATION_MODE_WINDOW_AVERAGE",
+		3: "UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE",
+	}
+	UtilizationMode_value = map[string]int32{
+		"UTILIZATION_MODE_UNSPECIFIED":             0,
+		"UTILIZATION_MODE_CURRENT_BLOCK":           1,
+		"UTILIZATION_MODE_WINDOW_AVERAGE":          2,
+		"UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE": 3,
+	}
+)
+
+func (x UtilizationMode) Enum() *UtilizationMode {
+	p := new(UtilizationMode)
+	*p = x
+	return p
+}
+
+func (x UtilizationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UtilizationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_feemarket_feemarket_v1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (UtilizationMode) Type() protoreflect.EnumType {
+	return &file_feemarket_feemarket_v1_params_proto_enumTypes[0]
+}
+
+func (x UtilizationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UtilizationMode.Descriptor instead.
+func (UtilizationMode) EnumDescriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+// UnusedGasPolicy defines how the fee paid for the gas limit of a transaction
+// in excess of the gas it consumed is handled.
+type UnusedGasPolicy int32
+
+const (
+	// UNUSED_GAS_POLICY_UNSPECIFIED defaults to paying out the fee for unused gas
+	// as a tip.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_UNSPECIFIED UnusedGasPolicy = 0
+	// UNUSED_GAS_POLICY_TIP pays out the fee for unused gas as a tip.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_TIP UnusedGasPolicy = 1
+	// UNUSED_GAS_POLICY_REFUND refunds the fee for unused gas to the fee granter
+	// or, if there is none, to the fee payer.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND UnusedGasPolicy = 2
+	// UNUSED_GAS_POLICY_PENALTY charges UnusedGasPenalty of the fee for unused
+	// gas as a fee and refunds the rest.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY UnusedGasPolicy = 3
+)
+
+// Enum value maps for UnusedGasPolicy.
+var (
+	UnusedGasPolicy_name = map[int32]string{
+		0: "UNUSED_GAS_POLICY_UNSPECIFIED",
+		1: "UNUSED_GAS_POLICY_TIP",
+		2: "UNUSED_GAS_POLICY_REFUND",
+		3: "UNUSED_GAS_POLICY_PENALTY",
+	}
+	UnusedGasPolicy_value = map[string]int32{
+		"UNUSED_GAS_POLICY_UNSPECIFIED": 0,
+		"UNUSED_GAS_POLICY_TIP":         1,
+		"UNUSED_GAS_POLICY_REFUND":      2,
+		"UNUSED_GAS_POLICY_PENALTY":     3,
+	}
+)
+
+func (x UnusedGasPolicy) Enum() *UnusedGasPolicy {
+	p := new(UnusedGasPolicy)
+	*p = x
+	return p
+}
+
+func (x UnusedGasPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnusedGasPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_feemarket_feemarket_v1_params_proto_enumTypes[1].Descriptor()
+}
+
+func (UnusedGasPolicy) Type() protoreflect.EnumType {
+	return &file_feemarket_feemarket_v1_params_proto_enumTypes[1]
+}
+
+func (x UnusedGasPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnusedGasPolicy.Descriptor instead.
+func (UnusedGasPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+// Params contains the required set of parameters for the EIP1559 fee market
+// plugin implementation.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Alpha is the amount we additively increase the learning rate
+	// when it is above or below the target +/- threshold.
+	//
+	// Must be > 0.
+	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// Beta is the amount we multiplicatively decrease the learning rate
+	// when it is within the target +/- threshold.
+	//
+	// Must be [0, 1].
+	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	// Gamma is the threshold for the learning rate. If the learning rate is
+	// above or below the target +/- threshold, we additively increase the
+	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
+	// learning rate by Beta. The thresholds are scaled on either side of the
+	// TargetUtilizationRatio.
+	//
+	// Must be [0, 0.5].
+	Gamma string `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// Delta is the amount we additively increase/decrease the gas price when the
+	// net block utilization difference in the window is above/below the target
+	// utilization.
+	Delta string `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// MinBaseGasPrice determines the initial gas price of the module and the
+	// global minimum for the network.
+	MinBaseGasPrice string `protobuf:"bytes,5,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
+	// MinLearningRate is the lower bound for the learning rate.
+	MinLearningRate string `protobuf:"bytes,6,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
+	// MaxLearningRate is the upper bound for the learning rate.
+	MaxLearningRate string `protobuf:"bytes,7,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
+	// MaxBlockUtilization is the maximum block utilization.
+	MaxBlockUtilization uint64 `protobuf:"varint,8,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
+	// Window defines the window size for calculating an adaptive learning rate
+	// over a moving window of blocks.
+	Window uint64 `protobuf:"varint,9,opt,name=window,proto3" json:"window,omitempty"`
+	// FeeDenom is the denom that will be used for all fee payments.
+	FeeDenom string `protobuf:"bytes,10,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// Enabled is a boolean that determines whether the EIP1559 fee market is
+	// enabled.
+	Enabled bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// DistributeFees is a boolean that determines whether the fees are burned or
+	// distributed to all stakers.
+	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
+	// SendTipToProposer is a boolean that determines whether the tip is sent to
+	// the operator account of the block proposer or to a module account.
+	SendTipToProposer bool `protobuf:"varint,13,opt,name=send_tip_to_proposer,json=sendTipToProposer,proto3" json:"send_tip_to_proposer,omitempty"`
+	// PricingModel is the name of the pricing model that is used to update the
+	// base gas price at the end of every block. The built-in models are
+	// "eip1559", "aimd", "exponential" and "pid". Chains may register additional
+	// models with the keeper. If unset, the AIMD model is used.
+	PricingModel string `protobuf:"bytes,14,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	// UtilizationMode determines which block utilization is used to adjust the
+	// base gas price. By default, only the utilization of the current block is
+	// considered.
+	UtilizationMode UtilizationMode `protobuf:"varint,15,opt,name=utilization_mode,json=utilizationMode,proto3,enum=feemarket.feemarket.v1.UtilizationMode" json:"utilization_mode,omitempty"`
+	// TargetUtilizationRatio is the fraction of MaxBlockUtilization that the fee
+	// market targets. The base gas price increases when blocks are fuller than
+	// the target and decreases otherwise.
+	//
+	// Must be (0, 1).
+	TargetUtilizationRatio string `protobuf:"bytes,16,opt,name=target_utilization_ratio,json=targetUtilizationRatio,proto3" json:"target_utilization_ratio,omitempty"`
+	// MaxBaseGasPrice is the upper bound for the base gas price. A value of zero
+	// disables the upper bound.
+	//
+	// Must be zero or >= MinBaseGasPrice.
+	MaxBaseGasPrice string `protobuf:"bytes,17,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3" json:"max_base_gas_price,omitempty"`
+	// MaxBaseGasPriceIncrease is the maximum fraction by which the base gas price
+	// can increase in a single block, e.g. 0.1 for 10%. A value of zero disables
+	// the limit.
+	//
+	// Must be between [0, 100].
+	MaxBaseGasPriceIncrease string `protobuf:"bytes,18,opt,name=max_base_gas_price_increase,json=maxBaseGasPriceIncrease,proto3" json:"max_base_gas_price_increase,omitempty"`
+	// MaxBaseGasPriceDecrease is the maximum fraction by which the base gas price
+	// can decrease in a single block, e.g. 0.1 for 10%. A value of zero disables
+	// the limit.
+	//
+	// Must be [0, 1].
+	MaxBaseGasPriceDecrease string `protobuf:"bytes,19,opt,name=max_base_gas_price_decrease,json=maxBaseGasPriceDecrease,proto3" json:"max_base_gas_price_decrease,omitempty"`
+	// ExcessGasUpdateFraction controls how quickly the base gas price responds to
+	// accumulated excess gas in the exponential pricing model. A block that
+	// consumes x gas above the target multiplies the base gas price by
+	// e^(x / ExcessGasUpdateFraction).
+	//
+	// Must be > 0 when the exponential pricing model is selected.
+	ExcessGasUpdateFraction uint64 `protobuf:"varint,20,opt,name=excess_gas_update_fraction,json=excessGasUpdateFraction,proto3" json:"excess_gas_update_fraction,omitempty"`
+	// PidProportionalGain is the gain applied to the utilization error of the
+	// current block in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidProportionalGain string `protobuf:"bytes,21,opt,name=pid_proportional_gain,json=pidProportionalGain,proto3" json:"pid_proportional_gain,omitempty"`
+	// PidIntegralGain is the gain applied to the sum of the utilization errors
+	// across the window in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidIntegralGain string `protobuf:"bytes,22,opt,name=pid_integral_gain,json=pidIntegralGain,proto3" json:"pid_integral_gain,omitempty"`
+	// PidDerivativeGain is the gain applied to the change in utilization error
+	// between the previous and the current block in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidDerivativeGain string `protobuf:"bytes,23,opt,name=pid_derivative_gain,json=pidDerivativeGain,proto3" json:"pid_derivative_gain,omitempty"`
+	// PidIntegralLimit bounds the absolute value of the integral term in the pid
+	// pricing model to prevent integral windup.
+	//
+	// Must be >= 0.
+	PidIntegralLimit string `protobuf:"bytes,24,opt,name=pid_integral_limit,json=pidIntegralLimit,proto3" json:"pid_integral_limit,omitempty"`
+	// TargetBlockTime is the expected time between blocks. If set, the change in
+	// base gas price computed by the pricing model is scaled by the time elapsed
+	// since the previous block relative to the target block time. A value of zero
+	// disables the scaling.
+	TargetBlockTime *durationpb.Duration `protobuf:"bytes,25,opt,name=target_block_time,json=targetBlockTime,proto3" json:"target_block_time,omitempty"`
+	// DowntimeThreshold is the time between blocks after which the chain is
+	// considered to have been down. The difference between the base gas price and
+	// MinBaseGasPrice is halved for every DowntimeThreshold that elapsed since the
+	// previous block. A value of zero disables the decay.
+	DowntimeThreshold *durationpb.Duration `protobuf:"bytes,26,opt,name=downtime_threshold,json=downtimeThreshold,proto3" json:"downtime_threshold,omitempty"`
+	// MaxBlockBytes is the maximum number of tx bytes in a block for the byte
+	// dimension of the fee market. A value of zero disables the byte dimension,
+	// in which case transactions only pay for gas.
+	MaxBlockBytes uint64 `protobuf:"varint,27,opt,name=max_block_bytes,json=maxBlockBytes,proto3" json:"max_block_bytes,omitempty"`
+	// TargetBlockBytes is the target number of tx bytes in a block. The base byte
+	// price increases when blocks contain more bytes than the target, and
+	// decreases otherwise.
+	//
+	// Must be (0, MaxBlockBytes] when the byte dimension is enabled.
+	TargetBlockBytes uint64 `protobuf:"varint,28,opt,name=target_block_bytes,json=targetBlockBytes,proto3" json:"target_block_bytes,omitempty"`
+	// MinBaseBytePrice is the minimum base byte price. This is denominated in fee
+	// per tx byte in the FeeDenom.
+	//
+	// Must be > 0 when the byte dimension is enabled.
+	MinBaseBytePrice string `protobuf:"bytes,29,opt,name=min_base_byte_price,json=minBaseBytePrice,proto3" json:"min_base_byte_price,omitempty"`
+	// ByteLearningRate is the learning rate used to adjust the base byte price.
+	//
+	// Must be >= 0.
+	ByteLearningRate string `protobuf:"bytes,30,opt,name=byte_learning_rate,json=byteLearningRate,proto3" json:"byte_learning_rate,omitempty"`
+	// MsgFeeMultipliers scale the required fee of transactions that contain the
+	// given message types. For transactions with multiple messages, the highest
+	// multiplier is used. Message types without a multiplier use a multiplier of
+	// one.
+	MsgFeeMultipliers []*MsgFeeMultiplier `protobuf:"bytes,31,rep,name=msg_fee_multipliers,json=msgFeeMultipliers,proto3" json:"msg_fee_multipliers,omitempty"`
+	// Guardian is the address that can freeze the base gas price in an
+	// emergency, without a governance proposal. An empty address disables the
+	// guardian.
+	Guardian string `protobuf:"bytes,32,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// MaxFreezeBlocks is the maximum number of blocks for which the guardian can
+	// freeze the base gas price.
+	//
+	// Must be > 0 when Guardian is set.
+	MaxFreezeBlocks uint64 `protobuf:"varint,33,opt,name=max_freeze_blocks,json=maxFreezeBlocks,proto3" json:"max_freeze_blocks,omitempty"`
+	// FeeExemptions exempt transactions from the fee requirement by message type
+	// and fee payer. A transaction is exempt if every message in it is covered
+	// by an exemption, its gas limit does not exceed the gas cap of the covering
+	// exemptions and it fits in the remaining exempt gas budget of the block.
+	// The gas of exempt transactions still counts towards the block utilization.
+	FeeExemptions []*FeeExemption `protobuf:"bytes,34,rep,name=fee_exemptions,json=feeExemptions,proto3" json:"fee_exemptions,omitempty"`
+	// MaxBlockExemptGas is the maximum amount of gas that fee exempt
+	// transactions can consume in a block.
+	//
+	// Must be > 0 when FeeExemptions are set.
+	MaxBlockExemptGas uint64 `protobuf:"varint,35,opt,name=max_block_exempt_gas,json=maxBlockExemptGas,proto3" json:"max_block_exempt_gas,omitempty"`
+	// BurnFees is a boolean that determines whether the base fee portion of the
+	// fees is burned. If both DistributeFees and BurnFees are false, the fees
+	// are kept in the fee collector account.
+	//
+	// Must be false when DistributeFees is set.
+	BurnFees bool `protobuf:"varint,36,opt,name=burn_fees,json=burnFees,proto3" json:"burn_fees,omitempty"`
+	// FeeSplit splits the base fee portion of the fees between burning, the
+	// community pool and the fee recipient module. Must be unset when
+	// DistributeFees or BurnFees is set.
+	FeeSplit *FeeSplit `protobuf:"bytes,37,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// TipSplit splits the tips between the block proposer and the fee recipient
+	// module. If set, it takes precedence over SendTipToProposer.
+	TipSplit *TipSplit `protobuf:"bytes,38,opt,name=tip_split,json=tipSplit,proto3" json:"tip_split,omitempty"`
+	// DistributeTipsToDelegators is a boolean that determines whether the tips
+	// of the block proposer are allocated to its validator through the
+	// distribution module, so that they are shared with its delegators, instead
+	// of being sent to the operator account of the validator.
+	DistributeTipsToDelegators bool `protobuf:"varint,39,opt,name=distribute_tips_to_delegators,json=distributeTipsToDelegators,proto3" json:"distribute_tips_to_delegators,omitempty"`
+	// UnusedGasPolicy determines how the fee paid for gas that was provided but
+	// not consumed by a transaction is handled. By default, it is paid out as a
+	// tip.
+	UnusedGasPolicy UnusedGasPolicy `protobuf:"varint,40,opt,name=unused_gas_policy,json=unusedGasPolicy,proto3,enum=feemarket.feemarket.v1.UnusedGasPolicy" json:"unused_gas_policy,omitempty"`
+	// UnusedGasPenalty is the fraction of the fee paid for unused gas that is
+	// charged as a fee when the unused gas policy is
+	// UNUSED_GAS_POLICY_PENALTY. The rest is refunded.
+	//
+	// Must be between [0, 1].
+	UnusedGasPenalty string `protobuf:"bytes,41,opt,name=unused_gas_penalty,json=unusedGasPenalty,proto3" json:"unused_gas_penalty,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetAlpha() string {
+	if x != nil {
+		return x.Alpha
+	}
+	return ""
+}
+
+func (x *Params) GetBeta() string {
+	if x != nil {
+		return x.Beta
+	}
+	return ""
+}
+
+func (x *Params) GetGamma() string {
+	if x != nil {
+		return x.Gamma
+	}
+	return ""
+}
+
+func (x *Params) GetDelta() string {
+	if x != nil {
+		return x.Delta
+	}
+	return ""
+}
+
+func (x *Params) GetMinBaseGasPrice() string {
+	if x != nil {
+		return x.MinBaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMinLearningRate() string {
+	if x != nil {
+		return x.MinLearningRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxLearningRate() string {
+	if x != nil {
+		return x.MaxLearningRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBlockUtilization() uint64 {
+	if x != nil {
+		return x.MaxBlockUtilization
+	}
+	return 0
+}
+
+func (x *Params) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Params) GetFeeDenom() string {
+	if x != nil {
+		return x.FeeDenom
+	}
+	return ""
+}
+
+func (x *Params) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Params) GetDistributeFees() bool {
+	if x != nil {
+		return x.DistributeFees
+	}
+	return false
+}
+
+func (x *Params) GetSendTipToProposer() bool {
+	if x != nil {
+		return x.SendTipToProposer
+	}
+	return false
+}
+
+func (x *Params) GetPricingModel() string {
+	if x != nil {
+		return x.PricingModel
+	}
+	return ""
+}
+
+func (x *Params) GetUtilizationMode() UtilizationMode {
+	if x != nil {
+		return x.UtilizationMode
+	}
+	return UtilizationMode_UTILIZATION_MODE_UNSPECIFIED
+}
+
+func (x *Params) GetTargetUtilizationRatio() string {
+	if x != nil {
+		return x.TargetUtilizationRatio
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseGasPrice() string {
+	if x != nil {
+		return x.MaxBaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseGasPriceIncrease() string {
+	if x != nil {
+		return x.MaxBaseGasPriceIncrease
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseGasPriceDecrease() string {
+	if x != nil {
+		return x.MaxBaseGasPriceDecrease
+	}
+	return ""
+}
+
+func (x *Params) GetExcessGasUpdateFraction() uint64 {
+	if x != nil {
+		return x.ExcessGasUpdateFraction
+	}
+	return 0
+}
+
+func (x *Params) GetPidProportionalGain() string {
+	if x != nil {
+		return x.PidProportionalGain
+	}
+	return ""
+}
+
+func (x *Params) GetPidIntegralGain() string {
+	if x != nil {
+		return x.PidIntegralGain
+	}
+	return ""
+}
+
+func (x *Params) GetPidDerivativeGain() string {
+	if x != nil {
+		return x.PidDerivativeGain
+	}
+	return ""
+}
+
+func (x *Params) GetPidIntegralLimit() string {
+	if x != nil {
+		return x.PidIntegralLimit
+	}
+	return ""
+}
+
+func (x *Params) GetTargetBlockTime() *durationpb.Duration {
+	if x != nil {
+		return x.TargetBlockTime
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeThreshold
+	}
+	return nil
+}
+
+func (x *Params) GetMaxBlockBytes() uint64 {
+	if x != nil {
+		return x.MaxBlockBytes
+	}
+	return 0
+}
+
+func (x *Params) GetTargetBlockBytes() uint64 {
+	if x != nil {
+		return x.TargetBlockBytes
+	}
+	return 0
+}
+
+func (x *Params) GetMinBaseBytePrice() string {
+	if x != nil {
+		return x.MinBaseBytePrice
+	}
+	return ""
+}
+
+func (x *Params) GetByteLearningRate() string {
+	if x != nil {
+		return x.ByteLearningRate
+	}
+	return ""
+}
+
+func (x *Params) GetMsgFeeMultipliers() []*MsgFeeMultiplier {
+	if x != nil {
+		return x.MsgFeeMultipliers
+	}
+	return nil
+}
+
+func (x *Params) GetGuardian() string {
+	if x != nil {
+		return x.Guardian
+	}
+	return ""
+}
+
+func (x *Params) GetMaxFreezeBlocks() uint64 {
+	if x != nil {
+		return x.MaxFreezeBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetFeeExemptions() []*FeeExemption {
+	if x != nil {
+		return x.FeeExemptions
+	}
+	return nil
+}
+
+func (x *Params) GetMaxBlockExemptGas() uint64 {
+	if x != nil {
+		return x.MaxBlockExemptGas
+	}
+	return 0
+}
+
+func (x *Params) GetBurnFees() bool {
+	if x != nil {
+		return x.BurnFees
+	}
+	return false
+}
+
+func (x *Params) GetFeeSplit() *FeeSplit {
+	if x != nil {
+		return x.FeeSplit
+	}
+	return nil
+}
+
+func (x *Params) GetTipSplit() *TipSplit {
+	if x != nil {
+		return x.TipSplit
+	}
+	return nil
+}
+
+func (x *Params) GetDistributeTipsToDelegators() bool {
+	if x != nil {
+		return x.DistributeTipsToDelegators
+	}
+	return false
 }
 
-func (x *Params) GetBeta() string {
+func (x *Params) GetUnusedGasPolicy() UnusedGasPolicy {
 	if x != nil {
-		return x.Beta
+		return x.UnusedGasPolicy
 	}
-	return ""
+	return UnusedGasPolicy_UNUSED_GAS_POLICY_UNSPECIFIED
 }
 
-func (x *Params) GetGamma() string {
+func (x *Params) GetUnusedGasPenalty() string {
 	if x != nil {
-		return x.Gamma
+		return x.UnusedGasPenalty
 	}
 	return ""
 }
 
-func (x *Params) GetDelta() string {
+// MsgFeeMultiplier scales the required fee of transactions containing messages
+// of the given type.
+type MsgFeeMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MsgTypeUrl is the type URL of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is the factor by which the required fee is scaled.
+	//
+	// Must be > 0.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *MsgFeeMultiplier) Reset() {
+	*x = MsgFeeMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFeeMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFeeMultiplier) ProtoMessage() {}
+
+// Deprecated: Use MsgFeeMultiplier.ProtoReflect.Descriptor instead.
+func (*MsgFeeMultiplier) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgFeeMultiplier) GetMsgTypeUrl() string {
 	if x != nil {
-		return x.Delta
+		return x.MsgTypeUrl
 	}
 	return ""
 }
 
-func (x *Params) GetMinBaseGasPrice() string {
+func (x *MsgFeeMultiplier) GetMultiplier() string {
 	if x != nil {
-		return x.MinBaseGasPrice
+		return x.Multiplier
 	}
 	return ""
 }
 
-func (x *Params) GetMinLearningRate() string {
+// FeeExemption exempts messages of the given type in transactions paid by the
+// given fee payer from the fee requirement. At least one of the message type
+// and the address must be set. An empty field matches any value.
+type FeeExemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MsgTypeUrl is the type URL of the message, e.g.
+	// /ibc.core.client.v1.MsgUpdateClient.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Address is the fee payer of the transaction, which is its first signer
+	// unless the fee payer is set explicitly.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// MaxTxGas is the maximum gas limit of an exempt transaction.
+	//
+	// Must be > 0.
+	MaxTxGas uint64 `protobuf:"varint,3,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
+}
+
+func (x *FeeExemption) Reset() {
+	*x = FeeExemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeExemption) ProtoMessage() {}
+
+// Deprecated: Use FeeExemption.ProtoReflect.Descriptor instead.
+func (*FeeExemption) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeExemption) GetMsgTypeUrl() string {
 	if x != nil {
-		return x.MinLearningRate
+		return x.MsgTypeUrl
 	}
 	return ""
 }
 
-func (x *Params) GetMaxLearningRate() string {
+func (x *FeeExemption) GetAddress() string {
 	if x != nil {
-		return x.MaxLearningRate
+		return x.Address
 	}
 	return ""
 }
 
-func (x *Params) GetMaxBlockUtilization() uint64 {
+func (x *FeeExemption) GetMaxTxGas() uint64 {
 	if x != nil {
-		return x.MaxBlockUtilization
+		return x.MaxTxGas
 	}
 	return 0
 }
 
-func (x *Params) GetWindow() uint64 {
+// FeeSplit defines the shares of the base fee, in basis points, that are
+// burned, sent to the community pool and sent to the fee recipient module.
+// Rounding remainders are sent to the fee recipient module.
+//
+// The shares must add up to 10000.
+type FeeSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BurnBps is the share of the base fee that is burned.
+	BurnBps uint32 `protobuf:"varint,1,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+	// CommunityPoolBps is the share of the base fee that is sent to the
+	// community pool.
+	CommunityPoolBps uint32 `protobuf:"varint,2,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	// RecipientBps is the share of the base fee that is sent to the fee
+	// recipient module.
+	RecipientBps uint32 `protobuf:"varint,3,opt,name=recipient_bps,json=recipientBps,proto3" json:"recipient_bps,omitempty"`
+}
+
+func (x *FeeSplit) Reset() {
+	*x = FeeSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSplit) ProtoMessage() {}
+
+// Deprecated: Use FeeSplit.ProtoReflect.Descriptor instead.
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeSplit) GetBurnBps() uint32 {
 	if x != nil {
-		return x.Window
+		return x.BurnBps
 	}
 	return 0
 }
 
-func (x *Params) GetFeeDenom() string {
+func (x *FeeSplit) GetCommunityPoolBps() uint32 {
 	if x != nil {
-		return x.FeeDenom
+		return x.CommunityPoolBps
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetEnabled() bool {
+func (x *FeeSplit) GetRecipientBps() uint32 {
 	if x != nil {
-		return x.Enabled
+		return x.RecipientBps
 	}
-	return false
+	return 0
 }
 
-func (x *Params) GetDistributeFees() bool {
+// TipSplit defines the shares of the tip, in basis points, that are sent to
+// the block proposer and to the fee recipient module. Rounding remainders are
+// sent to the fee recipient module.
+//
+// The shares must add up to 10000.
+type TipSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ProposerBps is the share of the tip that is sent to the block proposer.
+	ProposerBps uint32 `protobuf:"varint,1,opt,name=proposer_bps,json=proposerBps,proto3" json:"proposer_bps,omitempty"`
+	// RecipientBps is the share of the tip that is sent to the fee recipient
+	// module.
+	RecipientBps uint32 `protobuf:"varint,2,opt,name=recipient_bps,json=recipientBps,proto3" json:"recipient_bps,omitempty"`
+}
+
+func (x *TipSplit) Reset() {
+	*x = TipSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TipSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipSplit) ProtoMessage() {}
+
+// Deprecated: Use TipSplit.ProtoReflect.Descriptor instead.
+func (*TipSplit) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TipSplit) GetProposerBps() uint32 {
 	if x != nil {
-		return x.DistributeFees
+		return x.ProposerBps
 	}
-	return false
+	return 0
 }
 
-func (x *Params) GetSendTipToProposer() bool {
+func (x *TipSplit) GetRecipientBps() uint32 {
 	if x != nil {
-		return x.SendTipToProposer
+		return x.RecipientBps
+	}
+	return 0
+}
+
+// ScheduledParams is a parameter set that takes effect at the given block
+// height.
+type ScheduledParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Height is the block height at which the params are applied, before the fee
+	// market is updated in EndBlock.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Params are the new parameters for the feemarket module.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// ResetState reinitializes the fee market state from the new parameters
+	// once they are applied.
+	ResetState bool `protobuf:"varint,3,opt,name=reset_state,json=resetState,proto3" json:"reset_state,omitempty"`
+}
+
+func (x *ScheduledParams) Reset() {
+	*x = ScheduledParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledParams) ProtoMessage() {}
+
+// Deprecated: Use ScheduledParams.ProtoReflect.Descriptor instead.
+func (*ScheduledParams) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScheduledParams) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduledParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ScheduledParams) GetResetState() bool {
+	if x != nil {
+		return x.ResetState
 	}
 	return false
 }
 
+// ParamsRamp is a gradual transition from one parameter set to another. The
+// numeric parameters that are set in both parameter sets are interpolated
+// linearly over the given number of blocks. All other parameters take their
+// target value from the start of the ramp.
+type ParamsRamp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StartParams are the parameters at the start of the ramp.
+	StartParams *Params `protobuf:"bytes,1,opt,name=start_params,json=startParams,proto3" json:"start_params,omitempty"`
+	// TargetParams are the parameters at the end of the ramp.
+	TargetParams *Params `protobuf:"bytes,2,opt,name=target_params,json=targetParams,proto3" json:"target_params,omitempty"`
+	// StartHeight is the block height at which the ramp started.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Blocks is the number of blocks over which the parameters are
+	// interpolated.
+	Blocks uint64 `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *ParamsRamp) Reset() {
+	*x = ParamsRamp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsRamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsRamp) ProtoMessage() {}
+
+// Deprecated: Use ParamsRamp.ProtoReflect.Descriptor instead.
+func (*ParamsRamp) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ParamsRamp) GetStartParams() *Params {
+	if x != nil {
+		return x.StartParams
+	}
+	return nil
+}
+
+func (x *ParamsRamp) GetTargetParams() *Params {
+	if x != nil {
+		return x.TargetParams
+	}
+	return nil
+}
+
+func (x *ParamsRamp) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ParamsRamp) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
 var File_feemarket_feemarket_v1_params_proto protoreflect.FileDescriptor
 
 var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
@@ -1326,8 +7172,10 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5,
-	0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac,
+	0x17, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
@@ -1377,21 +7225,230 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
 	0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x0d, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x70, 0x54, 0x6f, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x42, 0xd8, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66,
-	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa,
-	0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x52, 0x0a, 0x10, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f,
+	0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x6b, 0x0a, 0x18, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x16, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x74, 0x69, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x5e, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x1b,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x1a, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x47, 0x61, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x15, 0x70,
+	0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x67, 0x61, 0x69, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x70,
+	0x69, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x47, 0x61,
+	0x69, 0x6e, 0x12, 0x5d, 0x0a, 0x11, 0x70, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x6c, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0f, 0x70, 0x69, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x47, 0x61, 0x69,
+	0x6e, 0x12, 0x61, 0x0a, 0x13, 0x70, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x11, 0x70, 0x69, 0x64, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x69, 0x6e, 0x12, 0x5f, 0x0a, 0x12, 0x70, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x10, 0x70, 0x69, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x60, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x10, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x10, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x6d, 0x73, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x6d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x45, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x47, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x72,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x75,
+	0x72, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x70, 0x5f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x08, 0x74, 0x69, 0x70, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x1d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x69, 0x70, 0x73, 0x54, 0x6f, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x75, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x47, 0x61, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x75, 0x6e, 0x75,
+	0x73, 0x65, 0x64, 0x47, 0x61, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5f, 0x0a, 0x12,
+	0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x75, 0x6e, 0x75,
+	0x73, 0x65, 0x64, 0x47, 0x61, 0x73, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x87, 0x01,
+	0x0a, 0x10, 0x4d, 0x73, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x51, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x45,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x47, 0x61, 0x73, 0x22, 0x78, 0x0a, 0x08,
+	0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e,
+	0x42, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x70,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x42, 0x70, 0x73, 0x22, 0x52, 0x0a, 0x08, 0x54, 0x69, 0x70, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x42, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x70, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x49, 0x0a,
+	0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2a, 0xaa, 0x01, 0x0a, 0x0f, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x54, 0x49, 0x4c, 0x49,
+	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x54, 0x49,
+	0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x55,
+	0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x23, 0x0a,
+	0x1f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45,
+	0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x03,
+	0x2a, 0x8c, 0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x47, 0x61, 0x73, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47,
+	0x41, 0x53, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x55, 0x53, 0x45,
+	0x44, 0x5f, 0x47, 0x41, 0x53, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x54, 0x49, 0x50,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x41, 0x53,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x02,
+	0x12, 0x1d, 0x0a, 0x19, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x41, 0x53, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x10, 0x03, 0x42,
+	0xd8, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x18, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1406,16 +7463,37 @@ func file_feemarket_feemarket_v1_params_proto_rawDescGZIP() []byte {
 	return file_feemarket_feemarket_v1_params_proto_rawDescData
 }
 
-var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_feemarket_feemarket_v1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_feemarket_feemarket_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: feemarket.feemarket.v1.Params
+	(UtilizationMode)(0),        // 0: feemarket.feemarket.v1.UtilizationMode
+	(UnusedGasPolicy)(0),        // 1: feemarket.feemarket.v1.UnusedGasPolicy
+	(*Params)(nil),              // 2: feemarket.feemarket.v1.Params
+	(*MsgFeeMultiplier)(nil),    // 3: feemarket.feemarket.v1.MsgFeeMultiplier
+	(*FeeExemption)(nil),        // 4: feemarket.feemarket.v1.FeeExemption
+	(*FeeSplit)(nil),            // 5: feemarket.feemarket.v1.FeeSplit
+	(*TipSplit)(nil),            // 6: feemarket.feemarket.v1.TipSplit
+	(*ScheduledParams)(nil),     // 7: feemarket.feemarket.v1.ScheduledParams
+	(*ParamsRamp)(nil),          // 8: feemarket.feemarket.v1.ParamsRamp
+	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
 }
 var file_feemarket_feemarket_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: feemarket.feemarket.v1.Params.utilization_mode:type_name -> feemarket.feemarket.v1.UtilizationMode
+	9,  // 1: feemarket.feemarket.v1.Params.target_block_time:type_name -> google.protobuf.Duration
+	9,  // 2: feemarket.feemarket.v1.Params.downtime_threshold:type_name -> google.protobuf.Duration
+	3,  // 3: feemarket.feemarket.v1.Params.msg_fee_multipliers:type_name -> feemarket.feemarket.v1.MsgFeeMultiplier
+	4,  // 4: feemarket.feemarket.v1.Params.fee_exemptions:type_name -> feemarket.feemarket.v1.FeeExemption
+	5,  // 5: feemarket.feemarket.v1.Params.fee_split:type_name -> feemarket.feemarket.v1.FeeSplit
+	6,  // 6: feemarket.feemarket.v1.Params.tip_split:type_name -> feemarket.feemarket.v1.TipSplit
+	1,  // 7: feemarket.feemarket.v1.Params.unused_gas_policy:type_name -> feemarket.feemarket.v1.UnusedGasPolicy
+	2,  // 8: feemarket.feemarket.v1.ScheduledParams.params:type_name -> feemarket.feemarket.v1.Params
+	2,  // 9: feemarket.feemarket.v1.ParamsRamp.start_params:type_name -> feemarket.feemarket.v1.Params
+	2,  // 10: feemarket.feemarket.v1.ParamsRamp.target_params:type_name -> feemarket.feemarket.v1.Params
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_feemarket_feemarket_v1_params_proto_init() }
@@ -1436,19 +7514,92 @@ func file_feemarket_feemarket_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFeeMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeExemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamsRamp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feemarket_feemarket_v1_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_feemarket_feemarket_v1_params_proto_goTypes,
 		DependencyIndexes: file_feemarket_feemarket_v1_params_proto_depIdxs,
+		EnumInfos:         file_feemarket_feemarket_v1_params_proto_enumTypes,
 		MessageInfos:      file_feemarket_feemarket_v1_params_proto_msgTypes,
 	}.Build()
 	File_feemarket_feemarket_v1_params_proto = out.File
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName    = "/feemarket.feemarket.v1.Query/Params"
-	Query_State_FullMethodName     = "/feemarket.feemarket.v1.Query/State"
-	Query_GasPrice_FullMethodName  = "/feemarket.feemarket.v1.Query/GasPrice"
-	Query_GasPrices_FullMethodName = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_Params_FullMethodName           = "/feemarket.feemarket.v1.Query/Params"
+	Query_State_FullMethodName            = "/feemarket.feemarket.v1.Query/State"
+	Query_GasPrice_FullMethodName         = "/feemarket.feemarket.v1.Query/GasPrice"
+	Query_GasPrices_FullMethodName        = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_FeeMultipliers_FullMethodName   = "/feemarket.feemarket.v1.Query/FeeMultipliers"
+	Query_ScheduledParams_FullMethodName  = "/feemarket.feemarket.v1.Query/ScheduledParams"
+	Query_BurnedFees_FullMethodName       = "/feemarket.feemarket.v1.Query/BurnedFees"
+	Query_DenomConversions_FullMethodName = "/feemarket.feemarket.v1.Query/DenomConversions"
+	Query_DenomConversion_FullMethodName  = "/feemarket.feemarket.v1.Query/DenomConversion"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +46,17 @@ type QueryClient interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// FeeMultipliers returns the active per message type fee multipliers.
+	FeeMultipliers(ctx context.Context, in *FeeMultipliersRequest, opts ...grpc.CallOption) (*FeeMultipliersResponse, error)
+	// ScheduledParams returns the pending parameter changes, ordered by height.
+	ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error)
+	// BurnedFees returns the total amount of fees burned by the module.
+	BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error)
+	// DenomConversions returns the denoms accepted for fees in addition to the
+	// fee denom along with their conversion rates.
+	DenomConversions(ctx context.Context, in *DenomConversionsRequest, opts ...grpc.CallOption) (*DenomConversionsResponse, error)
+	// DenomConversion returns the conversion rate of a denom accepted for fees.
+	DenomConversion(ctx context.Context, in *DenomConversionRequest, opts ...grpc.CallOption) (*DenomConversionResponse, error)
 }
 
 type queryClient struct {
@@ -91,6 +107,56 @@ func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) FeeMultipliers(ctx context.Context, in *FeeMultipliersRequest, opts ...grpc.CallOption) (*FeeMultipliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeeMultipliersResponse)
+	err := c.cc.Invoke(ctx, Query_FeeMultipliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BurnedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BurnedFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomConversions(ctx context.Context, in *DenomConversionsRequest, opts ...grpc.CallOption) (*DenomConversionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenomConversionsResponse)
+	err := c.cc.Invoke(ctx, Query_DenomConversions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomConversion(ctx context.Context, in *DenomConversionRequest, opts ...grpc.CallOption) (*DenomConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenomConversionResponse)
+	err := c.cc.Invoke(ctx, Query_DenomConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -107,6 +173,17 @@ type QueryServer interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// FeeMultipliers returns the active per message type fee multipliers.
+	FeeMultipliers(context.Context, *FeeMultipliersRequest) (*FeeMultipliersResponse, error)
+	// ScheduledParams returns the pending parameter changes, ordered by height.
+	ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error)
+	// BurnedFees returns the total amount of fees burned by the module.
+	BurnedFees(context.Context, *BurnedFeesRequest) (*BurnedFeesResponse, error)
+	// DenomConversions returns the denoms accepted for fees in addition to the
+	// fee denom along with their conversion rates.
+	DenomConversions(context.Context, *DenomConversionsRequest) (*DenomConversionsResponse, error)
+	// DenomConversion returns the conversion rate of a denom accepted for fees.
+	DenomConversion(context.Context, *DenomConversionRequest) (*DenomConversionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -129,6 +206,21 @@ func (UnimplementedQueryServer) GasPrice(context.Context, *GasPriceRequest) (*Ga
 func (UnimplementedQueryServer) GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (UnimplementedQueryServer) FeeMultipliers(context.Context, *FeeMultipliersRequest) (*FeeMultipliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMultipliers not implemented")
+}
+func (UnimplementedQueryServer) ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParams not implemented")
+}
+func (UnimplementedQueryServer) BurnedFees(context.Context, *BurnedFeesRequest) (*BurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (UnimplementedQueryServer) DenomConversions(context.Context, *DenomConversionsRequest) (*DenomConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomConversions not implemented")
+}
+func (UnimplementedQueryServer) DenomConversion(context.Context, *DenomConversionRequest) (*DenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomConversion not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -222,6 +314,96 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeMultipliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeMultipliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeMultipliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeMultipliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeMultipliers(ctx, req.(*FeeMultipliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParams(ctx, req.(*ScheduledParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*BurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomConversions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomConversions(ctx, req.(*DenomConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomConversion(ctx, req.(*DenomConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -245,6 +427,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "FeeMultipliers",
+			Handler:    _Query_FeeMultipliers_Handler,
+		},
+		{
+			MethodName: "ScheduledParams",
+			Handler:    _Query_ScheduledParams_Handler,
+		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "DenomConversions",
+			Handler:    _Query_DenomConversions_Handler,
+		},
+		{
+			MethodName: "DenomConversion",
+			Handler:    _Query_DenomConversion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/query.proto",
@@ -19,7 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Params_FullMethodName = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_Params_FullMethodName                = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_UpdateParamsPartial_FullMethodName   = "/feemarket.feemarket.v1.Msg/UpdateParamsPartial"
+	Msg_ScheduleParams_FullMethodName        = "/feemarket.feemarket.v1.Msg/ScheduleParams"
+	Msg_CancelScheduledParams_FullMethodName = "/feemarket.feemarket.v1.Msg/CancelScheduledParams"
+	Msg_RampParams_FullMethodName            = "/feemarket.feemarket.v1.Msg/RampParams"
+	Msg_FreezeBaseGasPrice_FullMethodName    = "/feemarket.feemarket.v1.Msg/FreezeBaseGasPrice"
+	Msg_ExtendFeeFreeze_FullMethodName       = "/feemarket.feemarket.v1.Msg/ExtendFeeFreeze"
+	Msg_LiftFeeFreeze_FullMethodName         = "/feemarket.feemarket.v1.Msg/LiftFeeFreeze"
+	Msg_SetDenomConversion_FullMethodName    = "/feemarket.feemarket.v1.Msg/SetDenomConversion"
+	Msg_RemoveDenomConversion_FullMethodName = "/feemarket.feemarket.v1.Msg/RemoveDenomConversion"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +40,34 @@ const (
 type MsgClient interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling new feemarket module
+	// parameters that take effect at a future block height.
+	ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a pending parameter
+	// change.
+	CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error)
+	// RampParams defines a method for gradually transitioning the feemarket
+	// module parameters to a target parameter set over a number of blocks.
+	RampParams(ctx context.Context, in *MsgRampParams, opts ...grpc.CallOption) (*MsgRampParamsResponse, error)
+	// FreezeBaseGasPrice defines a method for freezing the base gas price at a
+	// chosen value for a number of blocks. It can be executed by the guardian or
+	// the authority.
+	FreezeBaseGasPrice(ctx context.Context, in *MsgFreezeBaseGasPrice, opts ...grpc.CallOption) (*MsgFreezeBaseGasPriceResponse, error)
+	// ExtendFeeFreeze defines a method for extending the freeze of the base gas
+	// price in effect.
+	ExtendFeeFreeze(ctx context.Context, in *MsgExtendFeeFreeze, opts ...grpc.CallOption) (*MsgExtendFeeFreezeResponse, error)
+	// LiftFeeFreeze defines a method for lifting the freeze of the base gas
+	// price in effect.
+	LiftFeeFreeze(ctx context.Context, in *MsgLiftFeeFreeze, opts ...grpc.CallOption) (*MsgLiftFeeFreezeResponse, error)
+	// SetDenomConversion defines a method for accepting a denom for fees at a
+	// conversion rate, or for updating the rate of an accepted denom.
+	SetDenomConversion(ctx context.Context, in *MsgSetDenomConversion, opts ...grpc.CallOption) (*MsgSetDenomConversionResponse, error)
+	// RemoveDenomConversion defines a method for no longer accepting a denom
+	// for fees.
+	RemoveDenomConversion(ctx context.Context, in *MsgRemoveDenomConversion, opts ...grpc.CallOption) (*MsgRemoveDenomConversionResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +88,96 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsPartialResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParamsPartial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RampParams(ctx context.Context, in *MsgRampParams, opts ...grpc.CallOption) (*MsgRampParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRampParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_RampParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeBaseGasPrice(ctx context.Context, in *MsgFreezeBaseGasPrice, opts ...grpc.CallOption) (*MsgFreezeBaseGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFreezeBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeBaseGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendFeeFreeze(ctx context.Context, in *MsgExtendFeeFreeze, opts ...grpc.CallOption) (*MsgExtendFeeFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExtendFeeFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendFeeFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiftFeeFreeze(ctx context.Context, in *MsgLiftFeeFreeze, opts ...grpc.CallOption) (*MsgLiftFeeFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgLiftFeeFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_LiftFeeFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomConversion(ctx context.Context, in *MsgSetDenomConversion, opts ...grpc.CallOption) (*MsgSetDenomConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomConversionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomConversion(ctx context.Context, in *MsgRemoveDenomConversion, opts ...grpc.CallOption) (*MsgRemoveDenomConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveDenomConversionResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenomConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -60,6 +187,34 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 type MsgServer interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling new feemarket module
+	// parameters that take effect at a future block height.
+	ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a pending parameter
+	// change.
+	CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error)
+	// RampParams defines a method for gradually transitioning the feemarket
+	// module parameters to a target parameter set over a number of blocks.
+	RampParams(context.Context, *MsgRampParams) (*MsgRampParamsResponse, error)
+	// FreezeBaseGasPrice defines a method for freezing the base gas price at a
+	// chosen value for a number of blocks. It can be executed by the guardian or
+	// the authority.
+	FreezeBaseGasPrice(context.Context, *MsgFreezeBaseGasPrice) (*MsgFreezeBaseGasPriceResponse, error)
+	// ExtendFeeFreeze defines a method for extending the freeze of the base gas
+	// price in effect.
+	ExtendFeeFreeze(context.Context, *MsgExtendFeeFreeze) (*MsgExtendFeeFreezeResponse, error)
+	// LiftFeeFreeze defines a method for lifting the freeze of the base gas
+	// price in effect.
+	LiftFeeFreeze(context.Context, *MsgLiftFeeFreeze) (*MsgLiftFeeFreezeResponse, error)
+	// SetDenomConversion defines a method for accepting a denom for fees at a
+	// conversion rate, or for updating the rate of an accepted denom.
+	SetDenomConversion(context.Context, *MsgSetDenomConversion) (*MsgSetDenomConversionResponse, error)
+	// RemoveDenomConversion defines a method for no longer accepting a denom
+	// for fees.
+	RemoveDenomConversion(context.Context, *MsgRemoveDenomConversion) (*MsgRemoveDenomConversionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +228,33 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) Params(context.Context, *MsgParams) (*MsgParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedMsgServer) UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsPartial not implemented")
+}
+func (UnimplementedMsgServer) ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParams not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledParams not implemented")
+}
+func (UnimplementedMsgServer) RampParams(context.Context, *MsgRampParams) (*MsgRampParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RampParams not implemented")
+}
+func (UnimplementedMsgServer) FreezeBaseGasPrice(context.Context, *MsgFreezeBaseGasPrice) (*MsgFreezeBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeBaseGasPrice not implemented")
+}
+func (UnimplementedMsgServer) ExtendFeeFreeze(context.Context, *MsgExtendFeeFreeze) (*MsgExtendFeeFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendFeeFreeze not implemented")
+}
+func (UnimplementedMsgServer) LiftFeeFreeze(context.Context, *MsgLiftFeeFreeze) (*MsgLiftFeeFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftFeeFreeze not implemented")
+}
+func (UnimplementedMsgServer) SetDenomConversion(context.Context, *MsgSetDenomConversion) (*MsgSetDenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomConversion not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenomConversion(context.Context, *MsgRemoveDenomConversion) (*MsgRemoveDenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomConversion not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -112,6 +294,168 @@ func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParamsPartial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, req.(*MsgUpdateParamsPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParams(ctx, req.(*MsgScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledParams(ctx, req.(*MsgCancelScheduledParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RampParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRampParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RampParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RampParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RampParams(ctx, req.(*MsgRampParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeBaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeBaseGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeBaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeBaseGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeBaseGasPrice(ctx, req.(*MsgFreezeBaseGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendFeeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendFeeFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendFeeFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendFeeFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendFeeFreeze(ctx, req.(*MsgExtendFeeFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiftFeeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftFeeFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftFeeFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LiftFeeFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftFeeFreeze(ctx, req.(*MsgLiftFeeFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomConversion(ctx, req.(*MsgSetDenomConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenomConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomConversion(ctx, req.(*MsgRemoveDenomConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +467,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Msg_Params_Handler,
 		},
+		{
+			MethodName: "UpdateParamsPartial",
+			Handler:    _Msg_UpdateParamsPartial_Handler,
+		},
+		{
+			MethodName: "ScheduleParams",
+			Handler:    _Msg_ScheduleParams_Handler,
+		},
+		{
+			MethodName: "CancelScheduledParams",
+			Handler:    _Msg_CancelScheduledParams_Handler,
+		},
+		{
+			MethodName: "RampParams",
+			Handler:    _Msg_RampParams_Handler,
+		},
+		{
+			MethodName: "FreezeBaseGasPrice",
+			Handler:    _Msg_FreezeBaseGasPrice_Handler,
+		},
+		{
+			MethodName: "ExtendFeeFreeze",
+			Handler:    _Msg_ExtendFeeFreeze_Handler,
+		},
+		{
+			MethodName: "LiftFeeFreeze",
+			Handler:    _Msg_LiftFeeFreeze_Handler,
+		},
+		{
+			MethodName: "SetDenomConversion",
+			Handler:    _Msg_SetDenomConversion_Handler,
+		},
+		{
+			MethodName: "RemoveDenomConversion",
+			Handler:    _Msg_RemoveDenomConversion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/tx.proto",
@@ -515,6 +515,8 @@ func NewSimApp(
 		FeegrantKeeper:  app.FeeGrantKeeper,
 		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		SignModeHandler: app.txConfig.SignModeHandler(),
+		// accept the dynamic fee extension option of the fee market
+		ExtensionOptionChecker: feemarkettypes.IsDynamicFeeExtensionOption,
 	}
 
 	anteOptions := AnteHandlerOptions{
@@ -529,9 +531,11 @@ func NewSimApp(
 	}
 
 	postHandlerOptions := PostHandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		FeeMarketKeeper: app.FeeMarketKeeper,
+		AccountKeeper:      app.AccountKeeper,
+		BankKeeper:         app.BankKeeper,
+		FeeMarketKeeper:    app.FeeMarketKeeper,
+		DistributionKeeper: app.DistrKeeper,
+		StakingKeeper:      app.StakingKeeper,
 	}
 	postHandler, err := NewPostHandler(postHandlerOptions)
 	if err != nil {
@@ -10,9 +10,11 @@ import (
 
 // PostHandlerOptions are the options required for constructing a FeeMarket PostHandler.
 type PostHandlerOptions struct {
-	AccountKeeper   feemarketpost.AccountKeeper
-	BankKeeper      feemarketpost.BankKeeper
-	FeeMarketKeeper feemarketpost.FeeMarketKeeper
+	AccountKeeper      feemarketpost.AccountKeeper
+	BankKeeper         feemarketpost.BankKeeper
+	FeeMarketKeeper    feemarketpost.FeeMarketKeeper
+	DistributionKeeper feemarketpost.DistributionKeeper
+	StakingKeeper      feemarketpost.StakingKeeper
 }
 
 // NewPostHandler returns a PostHandler chain with the fee deduct decorator.
@@ -34,6 +36,8 @@ func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 			options.AccountKeeper,
 			options.BankKeeper,
 			options.FeeMarketKeeper,
+			options.DistributionKeeper,
+			options.StakingKeeper,
 		),
 	}
 
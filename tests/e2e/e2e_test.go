@@ -51,18 +51,19 @@ var (
 		{
 			Key: "app_state.feemarket.params",
 			Value: feemarkettypes.Params{
-				Alpha:               feemarkettypes.DefaultAlpha,
-				Beta:                feemarkettypes.DefaultBeta,
-				Gamma:               feemarkettypes.DefaultAIMDGamma,
-				Delta:               feemarkettypes.DefaultDelta,
-				MinBaseGasPrice:     minBaseGasPrice,
-				MinLearningRate:     feemarkettypes.DefaultMinLearningRate,
-				MaxLearningRate:     feemarkettypes.DefaultMaxLearningRate,
-				MaxBlockUtilization: feemarkettypes.DefaultMaxBlockUtilization,
-				Window:              feemarkettypes.DefaultWindow,
-				FeeDenom:            feemarkettypes.DefaultFeeDenom,
-				Enabled:             true,
-				DistributeFees:      false,
+				Alpha:                  feemarkettypes.DefaultAlpha,
+				Beta:                   feemarkettypes.DefaultBeta,
+				Gamma:                  feemarkettypes.DefaultAIMDGamma,
+				Delta:                  feemarkettypes.DefaultDelta,
+				MinBaseGasPrice:        minBaseGasPrice,
+				MinLearningRate:        feemarkettypes.DefaultMinLearningRate,
+				MaxLearningRate:        feemarkettypes.DefaultMaxLearningRate,
+				MaxBlockUtilization:    feemarkettypes.DefaultMaxBlockUtilization,
+				TargetUtilizationRatio: feemarkettypes.DefaultTargetUtilizationRatio,
+				Window:                 feemarkettypes.DefaultWindow,
+				FeeDenom:               feemarkettypes.DefaultFeeDenom,
+				Enabled:                true,
+				DistributeFees:         false,
 			},
 		},
 		{
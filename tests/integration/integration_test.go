@@ -81,16 +81,27 @@ func (s *IntegrationTestSuite) TestParams() {
 
 	s.Run("set and get custom params", func() {
 		params := types.Params{
-			Alpha:               math.LegacyMustNewDecFromStr("0.1"),
-			Beta:                math.LegacyMustNewDecFromStr("0.1"),
-			Gamma:               math.LegacyMustNewDecFromStr("0.1"),
-			Delta:               math.LegacyMustNewDecFromStr("0.1"),
-			MinBaseGasPrice:     math.LegacyNewDec(10),
-			MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxBlockUtilization: 10,
-			Window:              1,
-			Enabled:             true,
+			Alpha:                   math.LegacyMustNewDecFromStr("0.1"),
+			Beta:                    math.LegacyMustNewDecFromStr("0.1"),
+			Gamma:                   math.LegacyMustNewDecFromStr("0.1"),
+			Delta:                   math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseGasPrice:         math.LegacyNewDec(10),
+			MaxBaseGasPrice:         math.LegacyNewDec(100),
+			MaxBaseGasPriceIncrease: math.LegacyMustNewDecFromStr("0.1"),
+			MaxBaseGasPriceDecrease: math.LegacyMustNewDecFromStr("0.1"),
+			PidProportionalGain:     math.LegacyMustNewDecFromStr("0.1"),
+			PidIntegralGain:         math.LegacyMustNewDecFromStr("0.1"),
+			PidDerivativeGain:       math.LegacyMustNewDecFromStr("0.1"),
+			PidIntegralLimit:        math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseBytePrice:        math.LegacyMustNewDecFromStr("0.1"),
+			ByteLearningRate:        math.LegacyMustNewDecFromStr("0.1"),
+			UnusedGasPenalty:        math.LegacyMustNewDecFromStr("0.1"),
+			MinLearningRate:         math.LegacyMustNewDecFromStr("0.1"),
+			MaxLearningRate:         math.LegacyMustNewDecFromStr("0.1"),
+			MaxBlockUtilization:     10,
+			TargetUtilizationRatio:  math.LegacyMustNewDecFromStr("0.5"),
+			Window:                  1,
+			Enabled:                 true,
 		}
 
 		err := s.TestKeepers.FeeMarketKeeper.SetParams(s.ctx, params)
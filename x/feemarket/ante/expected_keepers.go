@@ -45,6 +45,7 @@ type BankKeeper interface {
 type FeeMarketKeeper interface {
 	GetState(ctx sdk.Context) (feemarkettypes.State, error)
 	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	GetMinBytePrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
 	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
 	SetState(ctx sdk.Context, state feemarkettypes.State) error
 	SetParams(ctx sdk.Context, params feemarkettypes.Params) error
@@ -99,14 +99,38 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return next(ctx, tx, simulate)
 	}
 
+	// fee exempt txs skip the fee requirement, their gas is still counted towards the
+	// block utilization by the post handler
+	if len(params.FeeExemptions) > 0 {
+		state, err := dfd.feemarketKeeper.GetState(ctx)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to get fee market state")
+		}
+
+		feePayer := sdk.AccAddress(feeTx.FeePayer()).String()
+		if params.IsTxFeeExempt(state, feePayer, tx.GetMsgs(), feeTx.GetGas()) {
+			ctx.Logger().Info("fee exempt ante handle",
+				"fee payer", feePayer,
+				"gas limit", feeTx.GetGas(),
+			)
+
+			return next(ctx, tx, simulate)
+		}
+	}
+
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas() // use provided gas limit
 
 	if len(feeCoins) == 0 && !simulate {
 		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
-	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+	if len(feeCoins) > 1 && !simulate {
+		ctx, err = dfd.checkFeeCoins(ctx, tx, params, feeCoins)
+		if err != nil {
+			return ctx, err
+		}
+
+		return next(ctx, tx, simulate)
 	}
 
 	// if simulating - create a dummy zero value for the user
@@ -122,28 +146,63 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
 	}
 
+	minBytePrice := sdk.NewDecCoin(payCoin.GetDenom(), sdkmath.ZeroInt())
+	if params.ByteDimensionEnabled() {
+		minBytePrice, err = dfd.feemarketKeeper.GetMinBytePrice(ctx, payCoin.GetDenom())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to get min byte price for denom %s", payCoin.GetDenom())
+		}
+	}
+
+	txBytes := int64(len(ctx.TxBytes()))
+	feeMultiplier := params.GetTxFeeMultiplier(tx.GetMsgs())
+
+	dynamicFee, err := feemarkettypes.GetDynamicFeeExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	ctx.Logger().Info("fee deduct ante handle",
 		"min gas prices", minGasPrice,
+		"min byte price", minBytePrice,
 		"fee", feeCoins,
 		"gas limit", gas,
+		"tx bytes", txBytes,
+		"fee multiplier", feeMultiplier,
 	)
 
 	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(minGasPrice))
 
 	if !simulate {
-		_, _, err := CheckTxFee(ctx, minGasPrice, payCoin, feeGas, true)
+		_, _, err := CheckTxFee(ctx, minGasPrice, minBytePrice, payCoin, feeGas, txBytes, feeMultiplier, true)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "error checking fee")
 		}
+
+		if dynamicFee != nil {
+			baseGasPrice := EffectiveBaseGasPrice(minGasPrice, minBytePrice, feeGas, txBytes, feeMultiplier)
+			if err := CheckDynamicFee(dynamicFee, baseGasPrice, payCoin, feeGas); err != nil {
+				return ctx, errorsmod.Wrapf(err, "error checking dynamic fee")
+			}
+		}
 	}
 
 	// escrow the entire amount that the account provided as fee (feeCoin)
-	err = dfd.EscrowFunds(ctx, tx, payCoin)
+	err = dfd.EscrowFunds(ctx, tx, sdk.NewCoins(payCoin))
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "error escrowing funds")
 	}
 
-	priorityFee, err := dfd.resolveTxPriorityCoins(ctx, payCoin, params.FeeDenom)
+	// the priority of a tx with a dynamic fee is based on its effective gas price, which
+	// includes the effective tip, rather than on the escrowed fee
+	priorityCoin := payCoin
+	if dynamicFee != nil && !simulate {
+		baseGasPrice := EffectiveBaseGasPrice(minGasPrice, minBytePrice, feeGas, txBytes, feeMultiplier)
+		effectiveFee := dynamicFee.EffectiveGasPrice(baseGasPrice.Amount).MulInt64(feeGas)
+		priorityCoin = sdk.NewCoin(payCoin.Denom, effectiveFee.TruncateInt())
+	}
+
+	priorityFee, err := dfd.resolveTxPriorityCoins(ctx, priorityCoin, params.FeeDenom)
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "error resolving fee priority")
 	}
@@ -158,6 +217,104 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(ctx, tx, simulate)
 }
 
+// checkFeeCoins checks and escrows the fee of a tx that provides multiple fee coins. The fee
+// coins are converted to the fee denom and their total value must cover the required fee at
+// the base gas price. The priority of the tx is based on the total value.
+func (dfd feeMarketCheckDecorator) checkFeeCoins(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	params feemarkettypes.Params,
+	feeCoins sdk.Coins,
+) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	dynamicFee, err := feemarkettypes.GetDynamicFeeExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if dynamicFee != nil {
+		return ctx, feemarkettypes.ErrInvalidDynamicFeeOption.Wrap("dynamic fee extension option requires a single fee coin")
+	}
+
+	_, totalValue, err := GetFeeCoinsValue(ctx, dfd.feemarketKeeper, feeCoins, params.FeeDenom)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "error resolving fee coins")
+	}
+
+	feeGas := int64(feeTx.GetGas())
+
+	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, params.FeeDenom)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", params.FeeDenom)
+	}
+
+	minBytePrice := sdk.NewDecCoin(params.FeeDenom, sdkmath.ZeroInt())
+	if params.ByteDimensionEnabled() {
+		minBytePrice, err = dfd.feemarketKeeper.GetMinBytePrice(ctx, params.FeeDenom)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to get min byte price for denom %s", params.FeeDenom)
+		}
+	}
+
+	txBytes := int64(len(ctx.TxBytes()))
+	feeMultiplier := params.GetTxFeeMultiplier(tx.GetMsgs())
+
+	ctx.Logger().Info("fee deduct ante handle",
+		"min gas prices", minGasPrice,
+		"min byte price", minBytePrice,
+		"fee", feeCoins,
+		"fee value", totalValue,
+		"gas limit", feeGas,
+		"tx bytes", txBytes,
+		"fee multiplier", feeMultiplier,
+	)
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(minGasPrice))
+
+	if _, _, err := CheckTxFee(ctx, minGasPrice, minBytePrice, totalValue, feeGas, txBytes, feeMultiplier, true); err != nil {
+		return ctx, errorsmod.Wrapf(err, "error checking fee")
+	}
+
+	// escrow all fee coins, the post handler charges them and refunds the remainder
+	if err := dfd.EscrowFunds(ctx, tx, feeCoins); err != nil {
+		return ctx, errorsmod.Wrapf(err, "error escrowing funds")
+	}
+
+	return ctx.WithPriority(GetTxPriority(totalValue, feeGas, minGasPrice)), nil
+}
+
+// FeeCoinResolver resolves coins to a denom.
+type FeeCoinResolver interface {
+	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+}
+
+// GetFeeCoinsValue resolves each of the fee coins to the base denom. The value of each coin,
+// truncated to an integer amount, and the total value of all coins are returned.
+func GetFeeCoinsValue(ctx sdk.Context, resolver FeeCoinResolver, feeCoins sdk.Coins, baseDenom string) ([]sdkmath.Int, sdk.Coin, error) {
+	values := make([]sdkmath.Int, len(feeCoins))
+	total := sdk.NewCoin(baseDenom, sdkmath.ZeroInt())
+
+	for i, coin := range feeCoins {
+		value := coin.Amount
+		if coin.Denom != baseDenom {
+			resolved, err := resolver.ResolveToDenom(ctx, sdk.NewDecCoinFromCoin(coin), baseDenom)
+			if err != nil {
+				return nil, sdk.Coin{}, errorsmod.Wrapf(err, "unable to resolve fee coin %s", coin)
+			}
+
+			value = resolved.Amount.TruncateInt()
+		}
+
+		values[i] = value
+		total = total.AddAmount(value)
+	}
+
+	return values, total, nil
+}
+
 // resolveTxPriorityCoins converts the coins to the proper denom used for tx prioritization calculation.
 func (dfd feeMarketCheckDecorator) resolveTxPriorityCoins(ctx sdk.Context, fee sdk.Coin, baseDenom string) (sdk.Coin, error) {
 	if fee.Denom == baseDenom {
@@ -176,7 +333,7 @@ func (dfd feeMarketCheckDecorator) resolveTxPriorityCoins(ctx sdk.Context, fee s
 
 // EscrowFunds escrows the fully provided fee from the payer account during tx execution.
 // The actual fee is deducted in the post handler along with the tip.
-func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coin) error {
+func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -192,12 +349,9 @@ func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, pr
 		if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranter, feePayer) {
-			if !providedFee.IsNil() {
-				err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, sdk.NewCoins(providedFee),
-					sdkTx.GetMsgs())
-				if err != nil {
-					return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
-				}
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, providedFee, sdkTx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -209,7 +363,7 @@ func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, pr
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	return escrow(dfd.bankKeeper, ctx, deductFeesFromAcc, sdk.NewCoins(providedFee))
+	return escrow(dfd.bankKeeper, ctx, deductFeesFromAcc, providedFee)
 }
 
 // escrow deducts coins to the escrow.
@@ -224,36 +378,56 @@ func escrow(bankKeeper BankKeeper, ctx sdk.Context, acc sdk.AccountI, coins sdk.
 }
 
 // CheckTxFee implements the logic for the fee market to check if a Tx has provided sufficient
-// fees given the current state of the fee market. Returns an error if insufficient fees.
-func CheckTxFee(ctx sdk.Context, gasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas int64, isAnte bool) (payCoin sdk.Coin, tip sdk.Coin, err error) {
+// fees given the current state of the fee market. The required fee is the gas price times the
+// gas, plus the byte price times the tx bytes, scaled by the fee multiplier of the tx's messages.
+// Returns an error if insufficient fees.
+func CheckTxFee(
+	ctx sdk.Context,
+	gasPrice sdk.DecCoin,
+	bytePrice sdk.DecCoin,
+	feeCoin sdk.Coin,
+	feeGas int64,
+	txBytes int64,
+	feeMultiplier sdkmath.LegacyDec,
+	isAnte bool,
+) (payCoin sdk.Coin, tip sdk.Coin, err error) {
 	payCoin = feeCoin
 
 	// Ensure that the provided fees meet the minimum
-	if !gasPrice.IsZero() {
+	if !gasPrice.IsZero() || !bytePrice.IsZero() {
 		var (
 			requiredFee sdk.Coin
 			consumedFee sdk.Coin
 		)
 
 		// Determine the required fees by multiplying each required minimum gas
-		// price by the gas, where fee = ceil(minGasPrice * gas).
+		// price by the gas and adding the byte price multiplied by the tx bytes,
+		// where fee = ceil((minGasPrice * gas + minBytePrice * bytes) * feeMultiplier).
 		gasConsumed := int64(ctx.GasMeter().GasConsumed())
 		gcDec := sdkmath.LegacyNewDec(gasConsumed)
 		glDec := sdkmath.LegacyNewDec(feeGas)
 
-		consumedFeeAmount := gasPrice.Amount.Mul(gcDec)
-		limitFee := gasPrice.Amount.Mul(glDec)
+		byteFeeAmount := sdkmath.LegacyZeroDec()
+		if !bytePrice.IsZero() {
+			byteFeeAmount = bytePrice.Amount.MulInt64(txBytes)
+		}
+
+		consumedFeeAmount := gasPrice.Amount.Mul(gcDec).Add(byteFeeAmount).Mul(feeMultiplier)
+		limitFee := gasPrice.Amount.Mul(glDec).Add(byteFeeAmount).Mul(feeMultiplier)
 
 		consumedFee = sdk.NewCoin(gasPrice.Denom, consumedFeeAmount.Ceil().RoundInt())
 		requiredFee = sdk.NewCoin(gasPrice.Denom, limitFee.Ceil().RoundInt())
 
 		if !payCoin.IsGTE(requiredFee) {
 			return sdk.Coin{}, sdk.Coin{}, sdkerrors.ErrInsufficientFee.Wrapf(
-				"got: %s required: %s, minGasPrice: %s, gas: %d",
+				"got: %s required: %s, minGasPrice: %s, gas: %d, minBytePrice: %s, bytes: %d, feeMultiplier: %s",
 				payCoin,
 				requiredFee,
 				gasPrice,
 				gasConsumed,
+				bytePrice,
+				txBytes,
+				feeMultiplier,
 			)
 		}
 
@@ -269,6 +443,55 @@ func CheckTxFee(ctx sdk.Context, gasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas
 	return payCoin, tip, nil
 }
 
+// EffectiveBaseGasPrice returns the base fee of a tx per unit of gas, including the byte fee and
+// the fee multiplier, i.e. (gasPrice * gas + bytePrice * txBytes) * feeMultiplier / gas. If gas is
+// not positive, the gas price times the fee multiplier is returned.
+func EffectiveBaseGasPrice(
+	gasPrice sdk.DecCoin,
+	bytePrice sdk.DecCoin,
+	gas int64,
+	txBytes int64,
+	feeMultiplier sdkmath.LegacyDec,
+) sdk.DecCoin {
+	if gas <= 0 {
+		return sdk.NewDecCoinFromDec(gasPrice.Denom, gasPrice.Amount.Mul(feeMultiplier))
+	}
+
+	fee := gasPrice.Amount.MulInt64(gas)
+	if !bytePrice.IsZero() {
+		fee = fee.Add(bytePrice.Amount.MulInt64(txBytes))
+	}
+
+	return sdk.NewDecCoinFromDec(gasPrice.Denom, fee.Mul(feeMultiplier).QuoInt64(gas))
+}
+
+// CheckDynamicFee checks that the max fee per gas of a dynamic fee extension option covers
+// the effective base gas price, see EffectiveBaseGasPrice, and that the provided fee covers the
+// max fee per gas times the gas limit. The prices of the option are denominated in the denom of
+// the fee.
+func CheckDynamicFee(option *feemarkettypes.ExtensionOptionDynamicFee, baseGasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas int64) error {
+	if option.MaxFeePerGas.LT(baseGasPrice.Amount) {
+		return sdkerrors.ErrInsufficientFee.Wrapf(
+			"max fee per gas %s is below the effective base gas price %s",
+			option.MaxFeePerGas,
+			baseGasPrice,
+		)
+	}
+
+	maxFee := sdk.NewCoin(feeCoin.Denom, option.MaxFeePerGas.MulInt64(feeGas).Ceil().TruncateInt())
+	if feeCoin.IsLT(maxFee) {
+		return sdkerrors.ErrInsufficientFee.Wrapf(
+			"got: %s required: %s, maxFeePerGas: %s, gas: %d",
+			feeCoin,
+			maxFee,
+			option.MaxFeePerGas,
+			feeGas,
+		)
+	}
+
+	return nil
+}
+
 const (
 	// gasPricePrecision is the amount of digit precision to scale the gas prices to.
 	gasPricePrecision = 6
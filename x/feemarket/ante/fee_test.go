@@ -5,13 +5,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 
+	"github.com/skip-mev/feemarket/x/feemarket/ante"
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -24,6 +28,18 @@ func TestAnteHandleMock(t *testing.T) {
 	validFee := sdk.NewCoins(sdk.NewCoin("stake", validFeeAmount.TruncateInt()))
 	validFeeDifferentDenom := sdk.NewCoins(sdk.NewCoin("atom", math.Int(validFeeAmount)))
 
+	dynamicFee := func(t *testing.T, maxFeePerGas, maxPriorityFeePerGas string) []*codectypes.Any {
+		option := types.NewExtensionOptionDynamicFee(
+			math.LegacyMustNewDecFromStr(maxFeePerGas),
+			math.LegacyMustNewDecFromStr(maxPriorityFeePerGas),
+		)
+
+		opt, err := codectypes.NewAnyWithValue(option)
+		require.NoError(t, err)
+
+		return []*codectypes.Any{opt}
+	}
+
 	testCases := []antesuite.TestCase{
 		{
 			Name: "0 gas given should fail",
@@ -157,6 +173,88 @@ func TestAnteHandleMock(t *testing.T) {
 			ExpErr:   nil,
 			Mock:     true,
 		},
+		{
+			Name: "signer has enough funds with dynamic fee, should pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validFee,
+					ExtensionOptions: dynamicFee(t, "1.0", "0.5"),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  true,
+			ExpErr:   nil,
+			Mock:     false,
+		},
+		{
+			Name: "dynamic max fee below the base gas price - fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validFee,
+					ExtensionOptions: dynamicFee(t, "0.5", "0.5"),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "fee does not cover the dynamic max fee - fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validFee,
+					ExtensionOptions: dynamicFee(t, "2.0", "1.0"),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "dynamic max priority fee above max fee - fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validFee,
+					ExtensionOptions: dynamicFee(t, "1.0", "2.0"),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   types.ErrInvalidDynamicFeeOption,
+			Mock:     false,
+		},
 		{
 			Name: "no fee - fail",
 			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
@@ -213,6 +311,9 @@ func TestAnteHandle(t *testing.T) {
 	validFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
 	validFee := sdk.NewCoins(sdk.NewCoin("stake", validFeeAmount.TruncateInt()))
 	validFeeDifferentDenom := sdk.NewCoins(sdk.NewCoin("atom", math.Int(validFeeAmount)))
+	halfFeeAmount := validFeeAmount.QuoInt64(2).Ceil().TruncateInt()
+	validMultiFee := sdk.NewCoins(sdk.NewCoin("stake", halfFeeAmount), sdk.NewCoin("atom", halfFeeAmount))
+	insufficientMultiFee := sdk.NewCoins(sdk.NewCoin("stake", halfFeeAmount), sdk.NewCoin("atom", halfFeeAmount.SubRaw(10)))
 
 	testCases := []antesuite.TestCase{
 		{
@@ -378,6 +479,85 @@ func TestAnteHandle(t *testing.T) {
 			ExpErr:   nil,
 			Mock:     false,
 		},
+		{
+			Name: "signer has enough funds in multiple fee coins, should pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validMultiFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validMultiFee,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  true,
+			ExpErr:   nil,
+			Mock:     false,
+		},
+		{
+			Name: "multiple fee coins do not cover the required fee, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       insufficientMultiFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: insufficientMultiFee,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "multiple fee coins with dynamic fee, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validMultiFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				option, err := codectypes.NewAnyWithValue(types.NewExtensionOptionDynamicFee(
+					math.LegacyMustNewDecFromStr("2"),
+					math.LegacyMustNewDecFromStr("1"),
+				))
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validMultiFee,
+					ExtensionOptions: []*codectypes.Any{option},
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   types.ErrInvalidDynamicFeeOption,
+			Mock:     false,
+		},
 		{
 			Name: "no fee - fail",
 			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
@@ -426,3 +606,210 @@ func TestAnteHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckTxFee(t *testing.T) {
+	gasPrice := sdk.NewDecCoin("stake", math.NewInt(2))
+	bytePrice := sdk.NewDecCoin("stake", math.NewInt(3))
+	zeroBytePrice := sdk.NewDecCoin("stake", math.ZeroInt())
+
+	testCases := []struct {
+		name        string
+		bytePrice   sdk.DecCoin
+		multiplier  math.LegacyDec
+		fee         sdk.Coin
+		isAnte      bool
+		expectedPay sdk.Coin
+		expectedTip sdk.Coin
+		expectedErr error
+	}{
+		{
+			name:        "gas only",
+			bytePrice:   zeroBytePrice,
+			multiplier:  math.LegacyOneDec(),
+			fee:         sdk.NewInt64Coin("stake", 250),
+			isAnte:      true,
+			expectedPay: sdk.NewInt64Coin("stake", 200),
+			expectedTip: sdk.NewInt64Coin("stake", 50),
+		},
+		{
+			name:        "gas and bytes in ante",
+			bytePrice:   bytePrice,
+			multiplier:  math.LegacyOneDec(),
+			fee:         sdk.NewInt64Coin("stake", 250),
+			isAnte:      true,
+			expectedPay: sdk.NewInt64Coin("stake", 230),
+			expectedTip: sdk.NewInt64Coin("stake", 20),
+		},
+		{
+			name:        "gas and bytes in post",
+			bytePrice:   bytePrice,
+			multiplier:  math.LegacyOneDec(),
+			fee:         sdk.NewInt64Coin("stake", 250),
+			isAnte:      false,
+			expectedPay: sdk.NewInt64Coin("stake", 130),
+			expectedTip: sdk.NewInt64Coin("stake", 120),
+		},
+		{
+			name:        "insufficient fee for bytes",
+			bytePrice:   bytePrice,
+			multiplier:  math.LegacyOneDec(),
+			fee:         sdk.NewInt64Coin("stake", 229),
+			isAnte:      true,
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee multiplier scales the required fee",
+			bytePrice:   bytePrice,
+			multiplier:  math.LegacyMustNewDecFromStr("0.5"),
+			fee:         sdk.NewInt64Coin("stake", 250),
+			isAnte:      true,
+			expectedPay: sdk.NewInt64Coin("stake", 115),
+			expectedTip: sdk.NewInt64Coin("stake", 135),
+		},
+		{
+			name:        "insufficient fee for fee multiplier",
+			bytePrice:   zeroBytePrice,
+			multiplier:  math.LegacyNewDec(2),
+			fee:         sdk.NewInt64Coin("stake", 399),
+			isAnte:      true,
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// 100 gas limit, 50 gas consumed and 10 tx bytes.
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(100))
+			ctx.GasMeter().ConsumeGas(50, "test")
+
+			pay, tip, err := ante.CheckTxFee(ctx, gasPrice, tc.bytePrice, tc.fee, 100, 10, tc.multiplier, tc.isAnte)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPay, pay)
+			require.Equal(t, tc.expectedTip, tip)
+		})
+	}
+}
+
+func TestCheckDynamicFee(t *testing.T) {
+	gasPrice := sdk.NewDecCoin("stake", math.NewInt(2))
+
+	testCases := []struct {
+		name         string
+		maxFeePerGas math.LegacyDec
+		fee          sdk.Coin
+		expectedErr  error
+	}{
+		{
+			name:         "max fee covers the base gas price",
+			maxFeePerGas: math.LegacyNewDec(3),
+			fee:          sdk.NewInt64Coin("stake", 300),
+		},
+		{
+			name:         "max fee equals the base gas price",
+			maxFeePerGas: math.LegacyNewDec(2),
+			fee:          sdk.NewInt64Coin("stake", 200),
+		},
+		{
+			name:         "max fee below the base gas price",
+			maxFeePerGas: math.LegacyMustNewDecFromStr("1.5"),
+			fee:          sdk.NewInt64Coin("stake", 300),
+			expectedErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:         "fee does not cover the max fee",
+			maxFeePerGas: math.LegacyNewDec(3),
+			fee:          sdk.NewInt64Coin("stake", 299),
+			expectedErr:  sdkerrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			option := types.NewExtensionOptionDynamicFee(tc.maxFeePerGas, math.LegacyZeroDec())
+
+			// 100 gas limit
+			err := ante.CheckDynamicFee(option, gasPrice, tc.fee, 100)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("max fee below the effective base gas price with a fee multiplier and byte price", func(t *testing.T) {
+		// (2 * 100 gas + 1 * 100 bytes) * 1.5 / 100 gas = 4.5
+		baseGasPrice := ante.EffectiveBaseGasPrice(
+			gasPrice,
+			sdk.NewDecCoin("stake", math.NewInt(1)),
+			100,
+			100,
+			math.LegacyMustNewDecFromStr("1.5"),
+		)
+		require.Equal(t, math.LegacyMustNewDecFromStr("4.5"), baseGasPrice.Amount)
+
+		option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(4), math.LegacyZeroDec())
+		err := ante.CheckDynamicFee(option, baseGasPrice, sdk.NewInt64Coin("stake", 1000), 100)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+		option = types.NewExtensionOptionDynamicFee(math.LegacyNewDec(5), math.LegacyZeroDec())
+		err = ante.CheckDynamicFee(option, baseGasPrice, sdk.NewInt64Coin("stake", 1000), 100)
+		require.NoError(t, err)
+	})
+}
+
+func TestEffectiveBaseGasPrice(t *testing.T) {
+	gasPrice := sdk.NewDecCoin("stake", math.NewInt(2))
+	bytePrice := sdk.NewDecCoin("stake", math.NewInt(1))
+
+	testCases := []struct {
+		name          string
+		bytePrice     sdk.DecCoin
+		gas           int64
+		feeMultiplier math.LegacyDec
+		expected      math.LegacyDec
+	}{
+		{
+			name:          "gas price only",
+			bytePrice:     sdk.NewDecCoin("stake", math.ZeroInt()),
+			gas:           100,
+			feeMultiplier: math.LegacyOneDec(),
+			expected:      math.LegacyNewDec(2),
+		},
+		{
+			name:          "byte fee spread over the gas",
+			bytePrice:     bytePrice,
+			gas:           100,
+			feeMultiplier: math.LegacyOneDec(),
+			expected:      math.LegacyNewDec(3),
+		},
+		{
+			name:          "fee multiplier and byte price",
+			bytePrice:     bytePrice,
+			gas:           50,
+			feeMultiplier: math.LegacyNewDec(2),
+			expected:      math.LegacyNewDec(8),
+		},
+		{
+			name:          "zero gas",
+			bytePrice:     bytePrice,
+			gas:           0,
+			feeMultiplier: math.LegacyNewDec(2),
+			expected:      math.LegacyNewDec(4),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// 100 tx bytes
+			got := ante.EffectiveBaseGasPrice(gasPrice, tc.bytePrice, tc.gas, 100, tc.feeMultiplier)
+			require.Equal(t, sdk.NewDecCoinFromDec("stake", tc.expected), got)
+		})
+	}
+}
@@ -15,6 +15,34 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// GetMinBytePrice provides a mock function with given fields: ctx, denom
+func (_m *FeeMarketKeeper) GetMinBytePrice(ctx types.Context, denom string) (types.DecCoin, error) {
+	ret := _m.Called(ctx, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMinBytePrice")
+	}
+
+	var r0 types.DecCoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, string) (types.DecCoin, error)); ok {
+		return rf(ctx, denom)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, string) types.DecCoin); ok {
+		r0 = rf(ctx, denom)
+	} else {
+		r0 = ret.Get(0).(types.DecCoin)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, string) error); ok {
+		r1 = rf(ctx, denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMinGasPrice provides a mock function with given fields: ctx, denom
 func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, denom)
@@ -170,6 +170,8 @@ func (s *TestSuite) SetupHandlers(mock bool) {
 			s.AccountKeeper,
 			bankKeeper,
 			s.FeeMarketKeeper,
+			nil,
+			nil,
 		),
 	}
 
@@ -198,6 +200,8 @@ type TestCaseArgs struct {
 	GasLimit  uint64
 	Msgs      []sdk.Msg
 	Privs     []cryptotypes.PrivKey
+
+	ExtensionOptions []*codectypes.Any
 }
 
 // DeliverMsgs constructs a tx and runs it through the ante handler. This is used to set the context for a test case, for
@@ -217,6 +221,12 @@ func (s *TestSuite) RunTestCase(t *testing.T, tc TestCase, args TestCaseArgs) {
 	s.TxBuilder.SetFeeAmount(args.FeeAmount)
 	s.TxBuilder.SetGasLimit(args.GasLimit)
 
+	if len(args.ExtensionOptions) > 0 {
+		extBuilder, ok := s.TxBuilder.(client.ExtendedTxBuilder)
+		require.True(t, ok)
+		extBuilder.SetExtensionOptions(args.ExtensionOptions...)
+	}
+
 	// Theoretically speaking, ante handler unit tests should only test
 	// ante handlers, but here we sometimes also test the tx creation
 	// process.
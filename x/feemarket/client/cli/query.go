@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 		GetStateCmd(),
 		GetGasPriceCmd(),
 		GetGasPricesCmd(),
+		GetFeeMultipliersCmd(),
+		GetScheduledParamsCmd(),
+		GetBurnedFeesCmd(),
+		GetDenomConversionsCmd(),
+		GetDenomConversionCmd(),
 	)
 
 	return cmd
@@ -89,19 +94,25 @@ func GetStateCmd() *cobra.Command {
 // GetGasPriceCmd returns the cli-command that queries the current feemarket gas price.
 func GetGasPriceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "gas-price",
+		Use:   "gas-price [denom] [msg-type-url]",
 		Short: "Query for the current feemarket gas price",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Query for the current feemarket gas price, optionally scaled by the fee multiplier of a message type",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
-			resp, err := queryClient.GasPrice(cmd.Context(), &types.GasPriceRequest{
+			req := &types.GasPriceRequest{
 				Denom: args[0],
-			})
+			}
+			if len(args) > 1 {
+				req.MsgTypeUrl = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GasPrice(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -141,3 +152,140 @@ func GetGasPricesCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetFeeMultipliersCmd returns the cli-command that queries the active per message type fee multipliers.
+func GetFeeMultipliersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-multipliers",
+		Short: "Query for the active per message type fee multipliers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.FeeMultipliers(cmd.Context(), &types.FeeMultipliersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetScheduledParamsCmd returns the cli-command that queries the pending scheduled parameter changes.
+func GetScheduledParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params",
+		Short: "Query for the pending scheduled parameter changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ScheduledParams(cmd.Context(), &types.ScheduledParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetBurnedFeesCmd returns the cli-command that queries the total amount of fees burned by the module.
+func GetBurnedFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Query for the total amount of fees burned by the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.BurnedFees(cmd.Context(), &types.BurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDenomConversionsCmd returns the cli-command that queries the denoms accepted for fees in
+// addition to the fee denom along with their conversion rates.
+func GetDenomConversionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-conversions",
+		Short: "Query for the denoms accepted for fees and their conversion rates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.DenomConversions(cmd.Context(), &types.DenomConversionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDenomConversionCmd returns the cli-command that queries the conversion rate of a denom
+// accepted for fees.
+func GetDenomConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-conversion [denom]",
+		Short: "Query for the conversion rate of a denom accepted for fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.DenomConversion(cmd.Context(), &types.DenomConversionRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -95,35 +95,24 @@ func TestAIMDGasPrice(t *testing.T) {
 			lr := state.UpdateLearningRate(params)
 			// Update the base gas price.
 
-			var newPrice math.LegacyDec
-			func() {
-				defer func() {
-					if rec := recover(); rec != nil {
-						newPrice = params.MinBaseGasPrice
-					}
-				}()
-
-				// Calculate the new base gasPrice with the learning rate adjustment.
-				currentBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(state.Window[state.Index]))
-				targetBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
-				utilization := (currentBlockSize.Sub(targetBlockSize)).Quo(targetBlockSize)
-
-				// Truncate the learning rate adjustment to an integer.
-				//
-				// This is equivalent to
-				// 1 + (learningRate * (currentBlockSize - targetBlockSize) / targetBlockSize)
-				learningRateAdjustment := math.LegacyOneDec().Add(lr.Mul(utilization))
-
-				// Calculate the delta adjustment.
-				net := math.LegacyNewDecFromInt(state.GetNetUtilization(params)).Mul(params.Delta)
-
-				// Update the base gasPrice.
-				newPrice = prevBaseGasPrice.Mul(learningRateAdjustment).Add(net)
-				// Ensure the base gasPrice is greater than the minimum base gasPrice.
-				if newPrice.LT(params.MinBaseGasPrice) {
-					newPrice = params.MinBaseGasPrice
-				}
-			}()
+			// Calculate the new base gasPrice with the learning rate adjustment.
+			currentBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(state.Window[state.Index]))
+			targetBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
+			utilization := (currentBlockSize.Sub(targetBlockSize)).Quo(targetBlockSize)
+
+			// This is equivalent to
+			// 1 + (learningRate * (currentBlockSize - targetBlockSize) / targetBlockSize)
+			learningRateAdjustment := types.SaturatingAdd(math.LegacyOneDec(), types.SaturatingMul(lr, utilization))
+
+			// Calculate the delta adjustment.
+			net := types.SaturatingMul(math.LegacyNewDecFromInt(state.GetNetUtilization(params)), params.Delta)
+
+			// Update the base gasPrice.
+			newPrice := types.SaturatingAdd(types.SaturatingMul(prevBaseGasPrice, learningRateAdjustment), net)
+			// Ensure the base gasPrice is greater than the minimum base gasPrice.
+			if newPrice.LT(params.MinBaseGasPrice) {
+				newPrice = params.MinBaseGasPrice
+			}
 
 			state.UpdateBaseGasPrice(params)
 
@@ -153,7 +142,7 @@ func CreateRandomAIMDParams(t *rapid.T) types.Params {
 	d := rapid.Uint64Range(1, 1000).Draw(t, "delta")
 	delta := math.LegacyNewDec(int64(d)).Quo(math.LegacyNewDec(1000))
 
-	targetBlockUtilization := rapid.Uint64Range(1, 30_000_000).Draw(t, "target_block_utilization")
+	targetBlockUtilization := rapid.Uint64Range(2, 30_000_000).Draw(t, "target_block_utilization")
 	maxBlockUtilization := rapid.Uint64Range(targetBlockUtilization, targetBlockUtilization*5).Draw(t, "max_block_utilization")
 
 	distributeFees := rapid.Bool().Draw(t, "distribute_fees")
@@ -0,0 +1,191 @@
+package fuzz_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// TestSaturatingArithmetic ensures that the saturating arithmetic never panics,
+// is bounded by the saturation limit and is exact within the limit.
+func TestSaturatingArithmetic(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		a := CreateRandomDec(t, "a")
+		b := CreateRandomDec(t, "b")
+
+		sum := types.SaturatingAdd(a, b)
+		require.True(t, sum.Abs().LTE(types.MaxDecValue))
+		if exact := a.Add(b); exact.Abs().LTE(types.MaxDecValue) {
+			require.Equal(t, exact, sum)
+		}
+
+		// Compare the magnitude of the product using the 18 decimal fixed-point
+		// representation, since the exact product may overflow math.LegacyDec.
+		product := types.SaturatingMul(a, b)
+		require.True(t, product.Abs().LTE(types.MaxDecValue))
+		exact := new(big.Int).Mul(a.BigInt(), b.BigInt())
+		limit := new(big.Int).Mul(types.MaxDecValue.BigInt(), math.LegacyOneDec().BigInt())
+		if exact.CmpAbs(limit) <= 0 {
+			require.Equal(t, a.Mul(b), product)
+		} else {
+			require.True(t, types.IsSaturated(product))
+		}
+	})
+}
+
+// TestGasPriceSaturation ensures that the base gas price and learning rate
+// updates never panic for extreme states and params, and that the results are
+// bounded by the min base gas price and the saturation limit.
+func TestGasPriceSaturation(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		state := types.DefaultAIMDState()
+		window := rapid.Int64Range(1, 50).Draw(t, "window")
+		state.Window = make([]uint64, window)
+		state.ByteWindow = make([]uint64, window)
+
+		params := CreateRandomAIMDParams(t)
+		params.Alpha = CreateRandomPositiveDec(t, "alpha")
+		params.Delta = CreateRandomPositiveDec(t, "delta")
+		params.MaxLearningRate = types.MaxDecValue
+		state.BaseGasPrice = CreateRandomPositiveDec(t, "base_gas_price")
+		state.LearningRate = CreateRandomPositiveDec(t, "learning_rate")
+
+		numBlocks := rapid.Uint64Range(0, uint64(window)*10).Draw(t, "num_blocks")
+		gasGen := rapid.Uint64Range(0, params.MaxBlockUtilization)
+
+		for i := uint64(0); i < numBlocks; i++ {
+			if err := state.Update(gasGen.Draw(t, "gas"), params); err != nil {
+				t.Fatalf("block update errors: %v", err)
+			}
+
+			state.UpdateLearningRate(params)
+			state.UpdateBaseGasPrice(params)
+			state.UpdatePIDBaseGasPrice(params)
+
+			require.True(t, params.MinBaseGasPrice.LTE(state.BaseGasPrice))
+			require.True(t, state.BaseGasPrice.LTE(types.MaxDecValue))
+			require.True(t, state.LearningRate.LTE(types.MaxDecValue))
+			require.NoError(t, state.ValidateBasic())
+
+			state.IncrementHeight()
+		}
+	})
+}
+
+// TestUpdateFeeMarketSaturation ensures that the full end block update of the fee
+// market never panics or errors for valid params, including the time scaling, the
+// max base gas price and per-block limits and the downtime decay, and that the
+// resulting base gas price stays within its bounds.
+func TestUpdateFeeMarketSaturation(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	k := tk.FeeMarketKeeper
+
+	rapid.Check(t, func(t *rapid.T) {
+		ctx, _ := ctx.CacheContext()
+
+		params := CreateRandomAIMDParams(t)
+		params.PricingModel = rapid.SampledFrom([]string{
+			types.PricingModelEIP1559,
+			types.PricingModelAIMD,
+			types.PricingModelExponential,
+			types.PricingModelPID,
+		}).Draw(t, "pricing_model")
+		params.Window = rapid.Uint64Range(1, 50).Draw(t, "window")
+		params.Alpha = CreateRandomPositiveDec(t, "alpha")
+		params.Delta = CreateRandomPositiveDec(t, "delta")
+		params.MaxLearningRate = types.MaxDecValue
+		if params.PricingModel == types.PricingModelEIP1559 {
+			params.MinLearningRate = params.MaxLearningRate
+		}
+
+		params.MinBaseGasPrice = math.LegacyMinDec(CreateRandomPositiveDec(t, "min_base_gas_price"), types.MaxDecValue)
+		if params.PricingModel == types.PricingModelExponential {
+			params.MinBaseGasPrice = math.LegacyNewDecWithPrec(rapid.Int64Range(1, 1_000_000).Draw(t, "min_base_gas_price"), 6)
+			params.ExcessGasUpdateFraction = rapid.Uint64Range(1, types.MaxExcessGasUpdateFraction).Draw(t, "excess_gas_update_fraction")
+		}
+
+		params.MaxBaseGasPrice = rapid.SampledFrom([]math.LegacyDec{
+			math.LegacyZeroDec(),
+			types.MaxDecValue,
+			types.SaturatingMul(params.MinBaseGasPrice, CreateRandomPositiveDec(t, "max_base_gas_price").Add(math.LegacyOneDec())),
+		}).Draw(t, "max_base_gas_price")
+		params.MaxBaseGasPriceIncrease = rapid.SampledFrom([]math.LegacyDec{
+			math.LegacyZeroDec(),
+			types.MaxBaseGasPriceIncreaseLimit,
+			math.LegacyNewDecWithPrec(rapid.Int64Range(1, 100_000).Draw(t, "max_increase"), 3),
+		}).Draw(t, "max_base_gas_price_increase")
+		params.MaxBaseGasPriceDecrease = math.LegacyNewDecWithPrec(rapid.Int64Range(0, 1000).Draw(t, "max_decrease"), 3)
+
+		params.TargetBlockTime = time.Duration(rapid.Int64Range(0, int64(10*time.Second)).Draw(t, "target_block_time"))
+		if rapid.Bool().Draw(t, "downtime") {
+			params.DowntimeThreshold = params.TargetBlockTime + time.Duration(rapid.Int64Range(1, int64(time.Hour)).Draw(t, "downtime_threshold"))
+		}
+
+		require.NoError(t, k.ValidateParams(params))
+		require.NoError(t, k.SetParams(ctx, params))
+
+		state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+		state.BaseGasPrice = rapid.SampledFrom([]math.LegacyDec{
+			types.MaxDecValue,
+			types.SaturatingAdd(params.MinBaseGasPrice, CreateRandomPositiveDec(t, "base_gas_price")),
+		}).Draw(t, "base_gas_price")
+		state.LearningRate = math.LegacyMinDec(CreateRandomPositiveDec(t, "learning_rate"), types.MaxDecValue)
+		state.ExcessGas = rapid.Uint64Range(0, types.MaxExcessGasExponent*params.ExcessGasUpdateFraction).Draw(t, "excess_gas")
+		state.LastBlockTime = ctx.BlockTime()
+		require.NoError(t, k.SetState(ctx, state))
+
+		numBlocks := rapid.IntRange(1, 10).Draw(t, "num_blocks")
+		gasGen := rapid.Uint64Range(0, params.MaxBlockUtilization)
+		elapsedGen := rapid.Int64Range(0, int64(48*time.Hour))
+
+		for i := 0; i < numBlocks; i++ {
+			state, err := k.GetState(ctx)
+			require.NoError(t, err)
+			require.NoError(t, state.Update(gasGen.Draw(t, "gas"), params))
+			require.NoError(t, k.SetState(ctx, state))
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(elapsedGen.Draw(t, "elapsed"))))
+			require.NoError(t, k.UpdateFeeMarket(ctx))
+
+			state, err = k.GetState(ctx)
+			require.NoError(t, err)
+			require.NoError(t, state.ValidateBasic())
+			require.True(t, params.MinBaseGasPrice.LTE(state.BaseGasPrice))
+			require.True(t, state.BaseGasPrice.LTE(types.MaxDecValue))
+			if params.MaxBaseGasPrice.IsPositive() {
+				require.True(t, state.BaseGasPrice.LTE(params.MaxBaseGasPrice))
+			}
+		}
+	})
+}
+
+// CreateRandomDec returns a random decimal that spans the full range of the
+// saturation limit, including values beyond it.
+func CreateRandomDec(t *rapid.T, label string) math.LegacyDec {
+	d := CreateRandomPositiveDec(t, label)
+	if rapid.Bool().Draw(t, label+"_negative") {
+		return d.Neg()
+	}
+
+	return d
+}
+
+// CreateRandomPositiveDec returns a random positive decimal with a random
+// magnitude between 10^-18 and 10^60.
+func CreateRandomPositiveDec(t *rapid.T, label string) math.LegacyDec {
+	mantissa := rapid.Int64Range(1, 1_000_000).Draw(t, label)
+	exponent := rapid.IntRange(-18, 60).Draw(t, label+"_exponent")
+
+	if exponent < 0 {
+		return math.LegacyNewDecWithPrec(mantissa, int64(-exponent))
+	}
+
+	return math.LegacyNewDec(mantissa).Mul(math.LegacyNewDec(10).Power(uint64(exponent)))
+}
@@ -6,7 +6,21 @@ import (
 
 // EndBlock returns an endblocker for the x/feemarket module. The endblocker
 // is responsible for updating the state of the fee market based on the
-// AIMD learning rate adjustment algorithm.
+// AIMD learning rate adjustment algorithm. Scheduled parameter changes that
+// are due and the parameter ramp in progress are applied, and an expired freeze
+// of the base gas price is lifted, before the fee market is updated.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
+	if err := k.ApplyScheduledParams(ctx); err != nil {
+		return err
+	}
+
+	if err := k.ApplyParamsRamp(ctx); err != nil {
+		return err
+	}
+
+	if err := k.ExpireFeeFreeze(ctx); err != nil {
+		return err
+	}
+
 	return k.UpdateFeeMarket(ctx)
 }
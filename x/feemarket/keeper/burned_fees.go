@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetBurnedFees returns the total amount of fees burned by the module, sorted by denom.
+func (k *Keeper) GetBurnedFees(ctx sdk.Context) (sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyBurnedFeesPrefix)
+	defer iterator.Close()
+
+	var burned sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		denom := string(iterator.Key()[len(types.KeyBurnedFeesPrefix):])
+		burned = burned.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return burned, nil
+}
+
+// SetBurnedFees sets the total amount of burned fees of each of the given denoms.
+func (k *Keeper) SetBurnedFees(ctx sdk.Context, burned sdk.Coins) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, coin := range burned {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetBurnedFeesKey(coin.Denom), bz)
+	}
+
+	return nil
+}
+
+// AddBurnedFees adds the given coins to the total amount of fees burned by the module.
+func (k *Keeper) AddBurnedFees(ctx sdk.Context, coins sdk.Coins) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, coin := range coins {
+		total := coin.Amount
+
+		if bz := store.Get(types.GetBurnedFeesKey(coin.Denom)); bz != nil {
+			var burned math.Int
+			if err := burned.Unmarshal(bz); err != nil {
+				return err
+			}
+
+			total = total.Add(burned)
+		}
+
+		if err := k.SetBurnedFees(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, total))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
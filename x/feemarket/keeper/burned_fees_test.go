@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestBurnedFees() {
+	s.Run("no fees burned", func() {
+		burned, err := s.feeMarketKeeper.GetBurnedFees(s.ctx)
+		s.Require().NoError(err)
+		s.Require().True(burned.IsZero())
+	})
+
+	s.Run("accumulates burned fees per denom", func() {
+		s.Require().NoError(s.feeMarketKeeper.AddBurnedFees(s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+		s.Require().NoError(s.feeMarketKeeper.AddBurnedFees(s.ctx, sdk.NewCoins(
+			sdk.NewInt64Coin("atom", 10),
+			sdk.NewInt64Coin("stake", 50),
+		)))
+
+		burned, err := s.feeMarketKeeper.GetBurnedFees(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(
+			sdk.NewInt64Coin("atom", 10),
+			sdk.NewInt64Coin("stake", 150),
+		), burned)
+	})
+
+	s.Run("set overrides the burned fees of the given denoms", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetBurnedFees(s.ctx, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(5)))))
+
+		burned, err := s.feeMarketKeeper.GetBurnedFees(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(
+			sdk.NewInt64Coin("atom", 10),
+			sdk.NewInt64Coin("stake", 5),
+		), burned)
+	})
+}
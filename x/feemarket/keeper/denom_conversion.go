@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetDenomConversion returns the conversion of the given denom. False is returned if the
+// denom is not in the conversion table.
+func (k *Keeper) GetDenomConversion(ctx sdk.Context, denom string) (types.DenomConversion, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDenomConversionKey(denom))
+	if bz == nil {
+		return types.DenomConversion{}, false, nil
+	}
+
+	dc := types.DenomConversion{}
+	if err := dc.Unmarshal(bz); err != nil {
+		return types.DenomConversion{}, false, err
+	}
+
+	return dc, true, nil
+}
+
+// GetAllDenomConversions returns the conversion table, ordered by denom.
+func (k *Keeper) GetAllDenomConversions(ctx sdk.Context) ([]types.DenomConversion, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDenomConversionPrefix)
+	defer iterator.Close()
+
+	var conversions []types.DenomConversion
+	for ; iterator.Valid(); iterator.Next() {
+		dc := types.DenomConversion{}
+		if err := dc.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		conversions = append(conversions, dc)
+	}
+
+	return conversions, nil
+}
+
+// SetDenomConversion adds the given conversion to the conversion table, replacing any
+// existing conversion of the denom.
+func (k *Keeper) SetDenomConversion(ctx sdk.Context, dc types.DenomConversion) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := dc.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetDenomConversionKey(dc.Denom), bz)
+
+	return nil
+}
+
+// DeleteDenomConversion removes the conversion of the given denom from the conversion table.
+func (k *Keeper) DeleteDenomConversion(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomConversionKey(denom))
+}
+
+var _ types.DenomResolver = &ConversionTableResolver{}
+
+// ConversionTableResolver is a DenomResolver that converts between the fee denom and the
+// denoms of the conversion table managed by the authority. The rates of the table are
+// given in display units, whose exponents are taken from the bank denom metadata.
+type ConversionTableResolver struct {
+	k          *Keeper
+	bankKeeper types.BankKeeper
+}
+
+// NewConversionTableResolver returns a new ConversionTableResolver that reads the conversion
+// table from the given keeper. It can be set as the keeper's resolver with SetDenomResolver.
+func NewConversionTableResolver(k *Keeper, bankKeeper types.BankKeeper) *ConversionTableResolver {
+	return &ConversionTableResolver{
+		k:          k,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// ConvertToDenom converts the coin into the equivalent amount of the given denom using the
+// conversion rates of both denoms to the fee denom.
+func (r *ConversionTableResolver) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	params, err := r.k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	fromRate, err := r.getRate(ctx, params, coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	toRate, err := r.getRate(ctx, params, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	// scale the amount from base units of the coin to base units of the denom
+	amount := coin.Amount
+	fromExponent, toExponent := r.getExponent(ctx, coin.Denom), r.getExponent(ctx, denom)
+	if toExponent >= fromExponent {
+		amount = amount.Mul(pow10(toExponent - fromExponent))
+	} else {
+		amount = amount.Quo(pow10(fromExponent - toExponent))
+	}
+
+	return sdk.NewDecCoinFromDec(denom, amount.Mul(fromRate).Quo(toRate)), nil
+}
+
+// ExtraDenoms returns the denoms of the conversion table.
+func (r *ConversionTableResolver) ExtraDenoms(ctx sdk.Context) ([]string, error) {
+	conversions, err := r.k.GetAllDenomConversions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, len(conversions))
+	for i, dc := range conversions {
+		denoms[i] = dc.Denom
+	}
+
+	return denoms, nil
+}
+
+// getRate returns the conversion rate of the denom to the fee denom, which is one for the
+// fee denom itself.
+func (r *ConversionTableResolver) getRate(ctx sdk.Context, params types.Params, denom string) (math.LegacyDec, error) {
+	if denom == params.FeeDenom {
+		return math.LegacyOneDec(), nil
+	}
+
+	dc, found, err := r.k.GetDenomConversion(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !found {
+		return math.LegacyDec{}, types.ErrDenomNotAccepted.Wrapf("no conversion rate for denom %s", denom)
+	}
+
+	return dc.Rate, nil
+}
+
+// getExponent returns the exponent of the display unit of the denom, or zero if the denom
+// has no metadata.
+func (r *ConversionTableResolver) getExponent(ctx sdk.Context, denom string) uint32 {
+	metadata, found := r.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+
+	return 0
+}
+
+// pow10 returns 10^n as a decimal.
+func pow10(n uint32) math.LegacyDec {
+	return math.LegacyNewDec(10).Power(uint64(n))
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestDenomConversions() {
+	s.Run("no denom conversions", func() {
+		conversions, err := s.feeMarketKeeper.GetAllDenomConversions(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(conversions)
+
+		_, found, err := s.feeMarketKeeper.GetDenomConversion(s.ctx, "atom")
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("set overrides the conversion of the denom", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("osmo", math.LegacyNewDec(3))))
+		s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("atom", math.LegacyNewDec(2))))
+		s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("osmo", math.LegacyNewDec(4))))
+
+		conversions, err := s.feeMarketKeeper.GetAllDenomConversions(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.DenomConversion{
+			types.NewDenomConversion("atom", math.LegacyNewDec(2)),
+			types.NewDenomConversion("osmo", math.LegacyNewDec(4)),
+		}, conversions)
+	})
+
+	s.Run("delete removes the conversion of the denom", func() {
+		s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "atom")
+		s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "osmo")
+
+		conversions, err := s.feeMarketKeeper.GetAllDenomConversions(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(conversions)
+	})
+}
+
+func (s *KeeperTestSuite) TestConversionTableResolver() {
+	s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+	// 1 atom is worth 2 stake and 1 osmo is worth 0.5 stake, where stake has no metadata,
+	// uatom has an exponent of 6 and uosmo an exponent of 3
+	s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("uatom", math.LegacyNewDec(2))))
+	s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("uosmo", math.LegacyMustNewDecFromStr("0.5"))))
+	defer s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "uatom")
+	defer s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "uosmo")
+
+	bankKeeper := mocks.NewBankKeeper(s.T())
+	bankKeeper.On("GetDenomMetaData", mock.Anything, "uatom").Return(metadata("uatom", "atom", 6), true).Maybe()
+	bankKeeper.On("GetDenomMetaData", mock.Anything, "uosmo").Return(metadata("uosmo", "osmo", 3), true).Maybe()
+	bankKeeper.On("GetDenomMetaData", mock.Anything, mock.Anything).Return(banktypes.Metadata{}, false).Maybe()
+
+	resolver := keeper.NewConversionTableResolver(s.feeMarketKeeper, bankKeeper)
+
+	s.Run("same denom is not converted", func() {
+		got, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("uatom", 100), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 100), got)
+	})
+
+	s.Run("converts to the fee denom", func() {
+		got, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("uatom", 1_000_000), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 2), got)
+	})
+
+	s.Run("converts from the fee denom", func() {
+		got, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), "uosmo")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uosmo", 2000), got)
+	})
+
+	s.Run("converts between accepted denoms", func() {
+		got, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("uatom", 1_000_000), "uosmo")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uosmo", 4000), got)
+	})
+
+	s.Run("rejects a denom without conversion", func() {
+		_, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("ujuno", 100), types.DefaultFeeDenom)
+		s.Require().ErrorIs(err, types.ErrDenomNotAccepted)
+	})
+
+	s.Run("lists the accepted denoms", func() {
+		denoms, err := resolver.ExtraDenoms(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]string{"uatom", "uosmo"}, denoms)
+	})
+
+	s.Run("min gas prices include the accepted denoms", func() {
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		mgp, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(types.DefaultFeeDenom, params.MinBaseGasPrice),
+			sdk.NewDecCoinFromDec("uatom", params.MinBaseGasPrice.MulInt64(500_000)),
+			sdk.NewDecCoinFromDec("uosmo", params.MinBaseGasPrice.MulInt64(2000)),
+		), mgp)
+	})
+}
+
+// metadata returns the bank metadata of a denom with the given display unit and exponent.
+func metadata(base, display string, exponent uint32) banktypes.Metadata {
+	return banktypes.Metadata{
+		Base:    base,
+		Display: display,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: display, Exponent: exponent},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetFeeFreeze returns the freeze of the base gas price. False is returned if the base
+// gas price is not frozen.
+func (k *Keeper) GetFeeFreeze(ctx sdk.Context) (types.FeeFreeze, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyFeeFreeze)
+	if bz == nil {
+		return types.FeeFreeze{}, false, nil
+	}
+
+	freeze := types.FeeFreeze{}
+	if err := freeze.Unmarshal(bz); err != nil {
+		return types.FeeFreeze{}, false, err
+	}
+
+	return freeze, true, nil
+}
+
+// SetFeeFreeze sets the freeze of the base gas price, replacing any existing freeze.
+func (k *Keeper) SetFeeFreeze(ctx sdk.Context, freeze types.FeeFreeze) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := freeze.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyFeeFreeze, bz)
+
+	return nil
+}
+
+// DeleteFeeFreeze removes the freeze of the base gas price.
+func (k *Keeper) DeleteFeeFreeze(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFeeFreeze)
+}
+
+// getActiveFeeFreeze returns the freeze of the base gas price if it is in effect at
+// the current height.
+func (k *Keeper) getActiveFeeFreeze(ctx sdk.Context) (types.FeeFreeze, bool, error) {
+	freeze, found, err := k.GetFeeFreeze(ctx)
+	if err != nil || !found {
+		return types.FeeFreeze{}, false, err
+	}
+
+	return freeze, freeze.IsActive(ctx.BlockHeight()), nil
+}
+
+// ExpireFeeFreeze lifts the freeze of the base gas price once its end height is reached.
+func (k *Keeper) ExpireFeeFreeze(ctx sdk.Context) error {
+	freeze, found, err := k.GetFeeFreeze(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !found || freeze.IsActive(ctx.BlockHeight()) {
+		return nil
+	}
+
+	return k.LiftFeeFreeze(ctx)
+}
+
+// LiftFeeFreeze removes the freeze of the base gas price. The fee market resumes from the
+// frozen base gas price, so that lifting the freeze does not return the base gas price to
+// the value it had before the freeze. Pricing models that do not derive the price from the
+// base gas price of the state resume from the state as it was before the freeze.
+func (k *Keeper) LiftFeeFreeze(ctx sdk.Context) error {
+	freeze, found, err := k.GetFeeFreeze(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("base gas price is not frozen")
+	}
+
+	k.DeleteFeeFreeze(ctx)
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting state: %w", err)
+	}
+
+	state.BaseGasPrice = freeze.BaseGasPrice
+	if err := k.SetState(ctx, state); err != nil {
+		return fmt.Errorf("error setting state: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeFreezeLifted,
+		sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+	))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestFeeFreeze() {
+	s.Run("skips fee market updates while frozen", func() {
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		s.setGenesisState(params, state)
+
+		frozenPrice := math.LegacyNewDec(5)
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(frozenPrice, 12)))
+
+		for height := int64(10); height < 12; height++ {
+			ctx := s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+			// fill the block so that an unfrozen fee market would increase the price
+			state, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+
+			s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+			gotState, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(state.BaseGasPrice, gotState.BaseGasPrice)
+
+			price, err := s.feeMarketKeeper.GetBaseGasPrice(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(frozenPrice, price)
+
+			s.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+				types.EventTypeFeeMarketFrozen,
+				sdk.NewAttribute(types.AttributeKeyBaseGasPrice, frozenPrice.String()),
+				sdk.NewAttribute(types.AttributeKeyEndHeight, "12"),
+			))
+		}
+	})
+
+	s.Run("lifts the freeze at the end height", func() {
+		params := types.DefaultParams()
+		s.setGenesisState(params, types.DefaultState())
+
+		frozenPrice := math.LegacyNewDec(5)
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(frozenPrice, 12)))
+
+		ctx := s.ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		_, found, err := s.feeMarketKeeper.GetFeeFreeze(ctx)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		s.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+			types.EventTypeFeeFreezeLifted,
+			sdk.NewAttribute(types.AttributeKeyHeight, "12"),
+		))
+
+		// the fee market resumes from the frozen price, which decreases in an
+		// empty block
+		price, err := s.feeMarketKeeper.GetBaseGasPrice(ctx)
+		s.Require().NoError(err)
+		s.Require().True(price.LT(frozenPrice))
+		s.Require().True(price.GT(params.MinBaseGasPrice))
+	})
+
+	s.Run("lifting requires a freeze", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().Error(s.feeMarketKeeper.LiftFeeFreeze(s.ctx))
+	})
+}
@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // UpdateFeeMarket updates the base fee and learning rate based on the
-// AIMD learning rate adjustment algorithm. Note that if the fee market
-// is disabled, this function will return without updating the fee market.
+// pricing model selected in the parameters. Note that if the fee market
+// is disabled or the base gas price is frozen, this function will return
+// without updating the fee market.
 // This is executed in EndBlock which allows the next block's base fee to
 // be readily available for wallets to estimate gas prices.
 func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
@@ -30,20 +35,92 @@ func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
 		return err
 	}
 
-	// Update the learning rate based on the block utilization seen in the
-	// current block. This is the AIMD learning rate adjustment algorithm.
-	newLR := state.UpdateLearningRate(
-		params,
+	// The update is bounded for valid states only, so surface corrupted state
+	// instead of silently resetting it.
+	if err := state.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// While the base gas price is frozen, the window still advances and the block
+	// time is recorded, so that the fee market resumes from the recent blocks once
+	// the freeze is lifted.
+	freeze, frozen, err := k.getActiveFeeFreeze(ctx)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFeeMarketFrozen,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, freeze.BaseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprint(freeze.EndHeight)),
+		))
+
+		state.LastBlockTime = ctx.BlockTime()
+		state.IncrementHeight()
+		return k.SetState(ctx, state)
+	}
+
+	model, err := k.GetPricingModel(params.PricingModel)
+	if err != nil {
+		return err
+	}
+
+	// Update the learning rate and base gas price based on the block utilization
+	// seen in the current block.
+	previous := state
+	if err := model.UpdateOnBlockEnd(ctx, &state, params); err != nil {
+		return err
+	}
+
+	// Scale the adjustment by the time elapsed since the previous block.
+	elapsed, ok := state.GetElapsedTime(ctx.BlockTime())
+	if ok {
+		state.ScaleBaseGasPriceAdjustment(previous, elapsed, params)
+	}
+
+	// Enforce the configured max base gas price and per-block change limits.
+	bound := state.ClampBaseGasPrice(previous, params)
+
+	// Decay the base gas price toward the minimum if the chain was down.
+	if ok {
+		state.DecayBaseGasPrice(elapsed, params)
+	}
+	state.LastBlockTime = ctx.BlockTime()
+
+	// Update the base byte price based on the tx bytes seen in the current block.
+	state.UpdateBaseBytePrice(params)
+
+	event := sdk.NewEvent(
+		types.EventTypeFeeMarketUpdate,
+		sdk.NewAttribute(types.AttributeKeyBaseGasPrice, model.CurrentPrice(state, params).String()),
+		sdk.NewAttribute(types.AttributeKeyLearningRate, state.LearningRate.String()),
+		sdk.NewAttribute(types.AttributeKeyBaseGasPriceBound, bound),
 	)
+	if params.ByteDimensionEnabled() {
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyBaseBytePrice, state.GetBaseBytePrice(params).String()),
+		)
+	}
+	ctx.EventManager().EmitEvent(event)
 
-	// Update the base gas price based with the new learning rate and delta adjustment.
-	newBaseGasPrice := state.UpdateBaseGasPrice(params)
+	// Report values that were capped at the saturation limit.
+	for _, value := range state.GetSaturatedValues(params) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFeeMarketSaturation,
+			sdk.NewAttribute(types.AttributeKeySaturatedValue, value),
+			sdk.NewAttribute(types.AttributeKeySaturationLimit, types.MaxDecValue.String()),
+		))
+	}
 
 	k.Logger(ctx).Info(
 		"updated the fee market",
 		"height", ctx.BlockHeight(),
-		"new_base_gas_price", newBaseGasPrice,
-		"new_learning_rate", newLR,
+		"pricing_model", model.Name(),
+		"new_base_gas_price", model.CurrentPrice(state, params),
+		"new_learning_rate", state.LearningRate,
+		"new_base_byte_price", state.GetBaseBytePrice(params),
+		"base_gas_price_bound", bound,
 		"average_block_utilization", state.GetAverageUtilization(params),
 		"net_block_utilization", state.GetNetUtilization(params),
 	)
@@ -53,14 +130,58 @@ func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
 	return k.SetState(ctx, state)
 }
 
-// GetBaseGasPrice returns the base fee from the fee market state.
+// GetBaseGasPrice returns the base fee from the fee market state, as determined
+// by the selected pricing model, or the frozen base gas price while the base
+// gas price is frozen.
 func (k *Keeper) GetBaseGasPrice(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return k.getBaseGasPrice(ctx, params)
+}
+
+// getBaseGasPrice returns the base fee from the fee market state using the
+// pricing model selected by the given params, or the frozen base gas price
+// while the base gas price is frozen.
+func (k *Keeper) getBaseGasPrice(ctx sdk.Context, params types.Params) (math.LegacyDec, error) {
+	freeze, frozen, err := k.getActiveFeeFreeze(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if frozen {
+		return freeze.BaseGasPrice, nil
+	}
+
 	state, err := k.GetState(ctx)
 	if err != nil {
 		return math.LegacyDec{}, err
 	}
 
-	return state.BaseGasPrice, nil
+	model, err := k.GetPricingModel(params.PricingModel)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return model.CurrentPrice(state, params), nil
+}
+
+// GetBaseBytePrice returns the base byte price from the fee market state. This is
+// zero if the byte dimension of the fee market is disabled.
+func (k *Keeper) GetBaseBytePrice(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return state.GetBaseBytePrice(params), nil
 }
 
 // GetLearningRate returns the learning rate from the fee market state.
@@ -75,12 +196,12 @@ func (k *Keeper) GetLearningRate(ctx sdk.Context) (math.LegacyDec, error) {
 
 // GetMinGasPrice returns the mininum gas prices for given denom as sdk.DecCoins from the fee market state.
 func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
-	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return sdk.DecCoin{}, err
 	}
 
-	params, err := k.GetParams(ctx)
+	baseGasPrice, err := k.getBaseGasPrice(ctx, params)
 	if err != nil {
 		return sdk.DecCoin{}, err
 	}
@@ -99,14 +220,34 @@ func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, err
 	return gasPrice, nil
 }
 
+// GetMinBytePrice returns the mininum byte price for given denom as sdk.DecCoin from the fee market state.
+func (k *Keeper) GetMinBytePrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseBytePrice := state.GetBaseBytePrice(params)
+	if params.FeeDenom == denom || baseBytePrice.IsZero() {
+		return sdk.NewDecCoinFromDec(denom, baseBytePrice), nil
+	}
+
+	return k.ResolveToDenom(ctx, sdk.NewDecCoinFromDec(params.FeeDenom, baseBytePrice), denom)
+}
+
 // GetMinGasPrices returns the mininum gas prices as sdk.DecCoins from the fee market state.
 func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
-	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return sdk.NewDecCoins(), err
 	}
 
-	params, err := k.GetParams(ctx)
+	baseGasPrice, err := k.getBaseGasPrice(ctx, params)
 	if err != nil {
 		return sdk.NewDecCoins(), err
 	}
@@ -120,6 +261,11 @@ func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
 	}
 
 	for _, denom := range extraDenoms {
+		// resolvers may list the fee denom among the denoms they can convert
+		if denom == params.FeeDenom {
+			continue
+		}
+
 		gasPrice, err := k.ResolveToDenom(ctx, minGasPrice, denom)
 		if err != nil {
 			k.Logger(ctx).Info(
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -409,6 +411,453 @@ func (s *KeeperTestSuite) TestUpdateFeeMarket() {
 	})
 }
 
+func (s *KeeperTestSuite) TestPricingModels() {
+	s.Run("unknown pricing model errors", func() {
+		params := types.DefaultParams()
+		params.PricingModel = "unknown"
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		err := s.feeMarketKeeper.UpdateFeeMarket(s.ctx)
+		s.Require().ErrorIs(err, types.ErrPricingModelNotFound)
+	})
+
+	s.Run("empty pricing model defaults to aimd", func() {
+		model, err := s.feeMarketKeeper.GetPricingModel("")
+		s.Require().NoError(err)
+		s.Require().Equal(types.PricingModelAIMD, model.Name())
+	})
+
+	s.Run("custom pricing model can be registered and selected", func() {
+		s.feeMarketKeeper.RegisterPricingModel(fixedPricingModel{})
+
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PricingModel = fixedPricingModel{}.Name()
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(42), fee)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketBaseGasPriceBounds() {
+	s.Run("max base gas price caps the base gas price", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxBaseGasPrice = params.MinBaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.1"))
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MaxBaseGasPrice, fee)
+
+		s.requireFeeMarketUpdateEvent(fee, types.BaseGasPriceBoundMaxBaseGasPrice)
+	})
+
+	s.Run("max increase limits the per-block increase", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.05")
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.05")), fee)
+
+		s.requireFeeMarketUpdateEvent(fee, types.BaseGasPriceBoundMaxIncrease)
+	})
+
+	s.Run("max increase limits the excess gas of the exponential model", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.05")
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Less(gotState.ExcessGas, params.MaxBlockUtilization-params.TargetBlockUtilization())
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().True(fee.LTE(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.05"))))
+		s.requireExponentialState(params, gotState.ExcessGas)
+		s.requireFeeMarketUpdateEvent(fee, types.BaseGasPriceBoundMaxIncrease)
+
+		// A target block keeps the clamped base gas price.
+		s.addTargetBlock(params)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		s.requireExponentialState(params, gotState.ExcessGas)
+	})
+
+	s.Run("max decrease limits the per-block decrease", func() {
+		state := types.DefaultState()
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(2)
+		params := types.DefaultParams()
+		params.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("0.05")
+
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("0.95")), fee)
+
+		s.requireFeeMarketUpdateEvent(fee, types.BaseGasPriceBoundMaxDecrease)
+	})
+
+	s.Run("unbounded update is reported", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.125")), fee)
+
+		s.requireFeeMarketUpdateEvent(fee, types.BaseGasPriceBoundNone)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketBlockTime() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	s.Run("records the last block time", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithBlockTime(now)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(now, gotState.LastBlockTime)
+	})
+
+	s.Run("long block scales the adjustment", func() {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+		params := types.DefaultParams()
+		params.TargetBlockTime = 5 * time.Second
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithBlockTime(now.Add(10 * time.Second))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		// We expect the base fee to increase by twice 1/8th.
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.25")), fee)
+	})
+
+	s.Run("downtime decays the base gas price", func() {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(9)
+		params := types.DefaultParams()
+		params.DowntimeThreshold = time.Hour
+
+		// A target block keeps the base gas price unchanged, after which the
+		// difference to the min base gas price is halved twice.
+		s.Require().NoError(state.Update(params.TargetBlockUtilization(), params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithBlockTime(now.Add(2 * time.Hour))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinBaseGasPrice.MulInt64(3), fee)
+	})
+
+	s.Run("long block scales the excess gas of the exponential model", func() {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.TargetBlockTime = 5 * time.Second
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithBlockTime(now.Add(10 * time.Second))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		// We expect twice the excess gas of a full block.
+		excessGas := 2 * (params.MaxBlockUtilization - params.TargetBlockUtilization())
+		s.requireExponentialState(params, excessGas)
+
+		// A target block keeps the scaled base gas price.
+		s.addTargetBlock(params)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx.WithBlockTime(now.Add(15 * time.Second))))
+		s.requireExponentialState(params, excessGas)
+	})
+
+	s.Run("downtime decays the excess gas of the exponential model", func() {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.DowntimeThreshold = time.Hour
+		state.ExcessGas = 4 * params.ExcessGasUpdateFraction
+		state.BaseGasPrice = state.GetExponentialBaseGasPrice(params)
+
+		// A target block keeps the excess gas unchanged, after which it is halved
+		// twice.
+		s.Require().NoError(state.Update(params.TargetBlockUtilization(), params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithBlockTime(now.Add(2 * time.Hour))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		s.requireExponentialState(params, params.ExcessGasUpdateFraction)
+
+		// A target block keeps the decayed base gas price.
+		s.addTargetBlock(params)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx.WithBlockTime(now.Add(2*time.Hour + time.Second))))
+		s.requireExponentialState(params, params.ExcessGasUpdateFraction)
+	})
+}
+
+// addTargetBlock records the target block utilization in the current block.
+func (s *KeeperTestSuite) addTargetBlock(params types.Params) {
+	state, err := s.feeMarketKeeper.GetState(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(state.Update(params.TargetBlockUtilization(), params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+}
+
+// requireExponentialState requires the excess gas of the state to match the
+// given excess gas and the base gas price to match the exponential pricing model.
+func (s *KeeperTestSuite) requireExponentialState(params types.Params, excessGas uint64) {
+	state, err := s.feeMarketKeeper.GetState(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(excessGas, state.ExcessGas)
+
+	fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(state.GetExponentialBaseGasPrice(params), fee)
+	s.Require().True(fee.GT(params.MinBaseGasPrice))
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketByteDimension() {
+	s.Run("full block increases the base byte price", func() {
+		state := types.DefaultState()
+		state.BaseBytePrice = math.LegacyNewDec(2)
+		params := types.DefaultParams()
+		params.MaxBlockBytes = 1000
+		params.TargetBlockBytes = 500
+		params.MinBaseBytePrice = math.LegacyOneDec()
+
+		s.Require().NoError(state.UpdateBytes(params.MaxBlockBytes, params))
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		price, err := s.feeMarketKeeper.GetBaseBytePrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("2.25"), price)
+
+		minBytePrice, err := s.feeMarketKeeper.GetMinBytePrice(s.ctx, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(params.FeeDenom, price), minBytePrice)
+	})
+
+	s.Run("disabled byte dimension has a zero byte price", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		minBytePrice, err := s.feeMarketKeeper.GetMinBytePrice(s.ctx, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().True(minBytePrice.IsZero())
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketSaturation() {
+	s.Run("saturated base gas price emits an event", func() {
+		state := types.DefaultState()
+		state.BaseGasPrice = types.MaxDecValue
+		params := types.DefaultParams()
+
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		price, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MaxDecValue, price)
+
+		var found bool
+		for _, event := range s.ctx.EventManager().Events() {
+			if event.Type != types.EventTypeFeeMarketSaturation {
+				continue
+			}
+
+			value, ok := event.GetAttribute(types.AttributeKeySaturatedValue)
+			s.Require().True(ok)
+			s.Require().Equal(types.AttributeKeyBaseGasPrice, value.Value)
+
+			limit, ok := event.GetAttribute(types.AttributeKeySaturationLimit)
+			s.Require().True(ok)
+			s.Require().Equal(types.MaxDecValue.String(), limit.Value)
+			found = true
+		}
+		s.Require().True(found)
+	})
+
+	s.Run("no event without saturation", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		for _, event := range s.ctx.EventManager().Events() {
+			s.Require().NotEqual(types.EventTypeFeeMarketSaturation, event.Type)
+		}
+	})
+
+	s.Run("invalid state returns an error", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyDec{}
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		s.Require().Error(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketFloor() {
+	s.Run("aimd eip1559 at the min learning rate and min base fee", func() {
+		params := types.DefaultAIMDParams()
+		params.Window = 1
+		params.Beta = math.LegacyZeroDec()
+		params.MinBaseGasPrice = math.LegacySmallestDec()
+		params.MinLearningRate = math.LegacySmallestDec()
+		state := types.NewState(params.Window, params.MinBaseGasPrice, params.MaxLearningRate)
+		s.Require().NoError(state.Update(params.TargetBlockUtilization(), params))
+		s.setGenesisState(params, state)
+
+		// A target block lowers the learning rate to the minimum.
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		lr, err := s.feeMarketKeeper.GetLearningRate(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinLearningRate, lr)
+
+		// Empty blocks keep the base gas price at the minimum.
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		}
+		s.requireFloorState(params)
+	})
+
+	s.Run("empty blocks with default eip1559 with a learning rate of one", func() {
+		params := types.DefaultParams()
+		params.MinBaseGasPrice = math.LegacySmallestDec()
+		params.MinLearningRate = math.LegacyOneDec()
+		params.MaxLearningRate = math.LegacyOneDec()
+		state := types.NewState(params.Window, math.LegacyNewDec(10), params.MaxLearningRate)
+		s.setGenesisState(params, state)
+
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		}
+		s.requireFloorState(params)
+	})
+
+	s.Run("empty blocks with the exponential model at the min base fee", func() {
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.MinBaseGasPrice = math.LegacySmallestDec()
+		state := types.NewState(params.Window, params.MinBaseGasPrice, params.MaxLearningRate)
+		s.setGenesisState(params, state)
+
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		}
+		s.requireFloorState(params)
+	})
+}
+
+// requireFloorState requires the base gas price to be at the minimum and the
+// state to remain valid.
+func (s *KeeperTestSuite) requireFloorState(params types.Params) {
+	state, err := s.feeMarketKeeper.GetState(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(state.ValidateBasic())
+	s.Require().Equal(params.MinBaseGasPrice, state.BaseGasPrice)
+}
+
+// requireFeeMarketUpdateEvent asserts that a fee market update event was emitted
+// with the given base gas price and bound.
+func (s *KeeperTestSuite) requireFeeMarketUpdateEvent(baseGasPrice math.LegacyDec, bound string) {
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFeeMarketUpdate {
+			continue
+		}
+
+		price, ok := event.GetAttribute(types.AttributeKeyBaseGasPrice)
+		s.Require().True(ok)
+		s.Require().Equal(baseGasPrice.String(), price.Value)
+
+		b, ok := event.GetAttribute(types.AttributeKeyBaseGasPriceBound)
+		s.Require().True(ok)
+		s.Require().Equal(bound, b.Value)
+		return
+	}
+
+	s.Fail("fee market update event not found")
+}
+
+// fixedPricingModel is a pricing model that always prices gas at 42.
+type fixedPricingModel struct{}
+
+func (fixedPricingModel) Name() string { return "fixed" }
+
+func (fixedPricingModel) ValidateParams(_ types.Params) error { return nil }
+
+func (fixedPricingModel) UpdateOnBlockEnd(_ sdk.Context, state *types.State, _ types.Params) error {
+	state.BaseGasPrice = math.LegacyNewDec(42)
+	return nil
+}
+
+func (fixedPricingModel) CurrentPrice(state types.State, _ types.Params) math.LegacyDec {
+	return state.BaseGasPrice
+}
+
 func (s *KeeperTestSuite) TestGetBaseFee() {
 	s.Run("can retrieve base fee with default eip-1559", func() {
 		gs := types.DefaultGenesisState()
@@ -471,6 +920,33 @@ func (s *KeeperTestSuite) TestGetMinGasPrices() {
 		s.Require().NoError(err)
 		s.Require().Equal(expected, mgp)
 	})
+
+	s.Run("can retrieve min gas prices with extra denoms", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		s.feeMarketKeeper.SetDenomResolver(&extraDenomsResolver{denoms: []string{"atom", sdk.DefaultBondDenom}})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		expected := sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, gs.State.BaseGasPrice),
+			sdk.NewDecCoinFromDec("atom", gs.State.BaseGasPrice),
+		)
+
+		mgp, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, mgp)
+	})
+}
+
+// extraDenomsResolver is a TestDenomResolver that lists the given extra denoms.
+type extraDenomsResolver struct {
+	types.TestDenomResolver
+	denoms []string
+}
+
+func (r *extraDenomsResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	return r.denoms, nil
 }
 
 func (s *KeeperTestSuite) setGenesisState(params types.Params, state types.State) {
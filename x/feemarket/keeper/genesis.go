@@ -12,6 +12,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.ValidateParams(gs.Params); err != nil {
+		panic(err)
+	}
+
 	if gs.Params.Window != uint64(len(gs.State.Window)) {
 		panic("genesis state and parameters do not match for window")
 	}
@@ -25,6 +29,34 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, sp := range gs.ScheduledParams {
+		if err := k.SetScheduledParams(ctx, sp); err != nil {
+			panic(err)
+		}
+	}
+
+	if gs.ParamsRamp != nil {
+		if err := k.SetParamsRamp(ctx, *gs.ParamsRamp); err != nil {
+			panic(err)
+		}
+	}
+
+	if gs.FeeFreeze != nil {
+		if err := k.SetFeeFreeze(ctx, *gs.FeeFreeze); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetBurnedFees(ctx, gs.BurnedFees); err != nil {
+		panic(err)
+	}
+
+	for _, dc := range gs.DenomConversions {
+		if err := k.SetDenomConversion(ctx, dc); err != nil {
+			panic(err)
+		}
+	}
+
 	// always init enabled height to -1 until it is explicitly set later in the application
 	k.SetEnabledHeight(ctx, -1)
 }
@@ -43,5 +75,46 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, state)
+	// Get the feemarket module's pending parameter changes.
+	scheduled, err := k.GetAllScheduledParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the feemarket module's parameter ramp in progress.
+	ramp, found, err := k.GetParamsRamp(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the feemarket module's freeze of the base gas price.
+	freeze, frozen, err := k.GetFeeFreeze(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the total amount of fees burned by the feemarket module.
+	burned, err := k.GetBurnedFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the feemarket module's denom conversion table.
+	conversions, err := k.GetAllDenomConversions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := types.NewGenesisState(params, state)
+	gs.ScheduledParams = scheduled
+	if found {
+		gs.ParamsRamp = &ramp
+	}
+	if frozen {
+		gs.FeeFreeze = &freeze
+	}
+	gs.BurnedFees = burned
+	gs.DenomConversions = conversions
+
+	return gs
 }
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -25,6 +28,26 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		})
 	})
 
+	s.Run("unknown pricing model should panic", func() {
+		gs := types.DefaultGenesisState()
+		gs.Params.PricingModel = "unknown"
+		s.Require().Panics(func() {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+		})
+	})
+
+	s.Run("duplicate scheduled params should panic", func() {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(10, types.DefaultParams(), false),
+			types.NewScheduledParams(10, types.DefaultAIMDParams(), false),
+		}
+
+		s.Require().Panics(func() {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+		})
+	})
+
 	s.Run("mismatch in params and state for window should panic", func() {
 		gs := types.DefaultAIMDGenesisState()
 		gs.Params.Window = 1
@@ -59,4 +82,88 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 		s.Require().Equal(gs, exportedGenesis)
 	})
+
+	s.Run("export genesis includes scheduled params", func() {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(10, types.DefaultParams(), false),
+			types.NewScheduledParams(20, types.DefaultAIMDParams(), true),
+		}
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs, exportedGenesis)
+
+		for _, sp := range gs.ScheduledParams {
+			s.feeMarketKeeper.DeleteScheduledParams(s.ctx, sp.Height)
+		}
+	})
+
+	s.Run("export genesis includes the params ramp", func() {
+		gs := types.DefaultGenesisState()
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.DefaultAIMDParams(), 10, 4)
+		gs.ParamsRamp = &ramp
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs, exportedGenesis)
+		s.feeMarketKeeper.DeleteParamsRamp(s.ctx)
+	})
+
+	s.Run("export genesis includes the fee freeze", func() {
+		gs := types.DefaultGenesisState()
+		freeze := types.NewFeeFreeze(math.LegacyNewDec(5), 15)
+		gs.FeeFreeze = &freeze
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs, exportedGenesis)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(s.ctx)
+	})
+
+	s.Run("export genesis includes the burned fees", func() {
+		gs := types.DefaultGenesisState()
+		gs.BurnedFees = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100))
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs, exportedGenesis)
+	})
+
+	s.Run("export genesis includes the denom conversions", func() {
+		gs := types.DefaultGenesisState()
+		gs.DenomConversions = []types.DenomConversion{
+			types.NewDenomConversion("atom", math.LegacyNewDec(2)),
+			types.NewDenomConversion("osmo", math.LegacyMustNewDecFromStr("0.5")),
+		}
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs.DenomConversions, exportedGenesis.DenomConversions)
+
+		for _, dc := range gs.DenomConversions {
+			s.feeMarketKeeper.DeleteDenomConversion(s.ctx, dc.Denom)
+		}
+	})
 }
@@ -20,6 +20,10 @@ type Keeper struct {
 	ak       types.AccountKeeper
 	resolver types.DenomResolver
 
+	// pricingModels contains the pricing models that can be selected via
+	// Params.PricingModel, keyed by name.
+	pricingModels map[string]types.PricingModel
+
 	// The address that is capable of executing a MsgParams message.
 	// Typically, this will be the governance module's address.
 	authority          string
@@ -43,14 +47,21 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", feeRecipientModule))
 	}
 
-	return &Keeper{
+	k := &Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
 		ak:                 authKeeper,
 		resolver:           resolver,
+		pricingModels:      make(map[string]types.PricingModel),
 		authority:          authority,
 		feeRecipientModule: feeRecipientModule,
 	}
+
+	for _, model := range types.DefaultPricingModels() {
+		k.RegisterPricingModel(model)
+	}
+
+	return k
 }
 
 // Logger returns a feemarket module-specific logger.
@@ -104,6 +115,60 @@ func (k *Keeper) SetDenomResolver(resolver types.DenomResolver) {
 	k.resolver = resolver
 }
 
+// RegisterPricingModel registers a pricing model with the keeper so that it can be
+// selected via Params.PricingModel. Registering a model with the name of an already
+// registered model replaces it.
+func (k *Keeper) RegisterPricingModel(model types.PricingModel) {
+	k.pricingModels[model.Name()] = model
+}
+
+// GetPricingModel returns the pricing model registered under the given name. For
+// backwards compatibility, an empty name resolves to the AIMD pricing model, which
+// was the only algorithm before pricing models became pluggable.
+func (k *Keeper) GetPricingModel(name string) (types.PricingModel, error) {
+	if name == "" {
+		name = types.PricingModelAIMD
+	}
+
+	model, ok := k.pricingModels[name]
+	if !ok {
+		return nil, types.ErrPricingModelNotFound.Wrapf("pricing model %q", name)
+	}
+
+	return model, nil
+}
+
+// ValidateParams performs basic validation on the given parameters as well as the
+// validation of the pricing model they select.
+func (k *Keeper) ValidateParams(params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	model, err := k.GetPricingModel(params.PricingModel)
+	if err != nil {
+		return err
+	}
+
+	return model.ValidateParams(params)
+}
+
+// validateParamsUpdate validates the parameters of a parameter update with ValidateBasic and,
+// if they enable the fee market, with the pricing model. The pricing configuration is only
+// validated once the fee market is enabled, since disabled parameters are never used to price
+// transactions, but the parameters are always stored and exported, so they must be valid.
+func (k *Keeper) validateParamsUpdate(params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	return k.ValidateParams(params)
+}
+
 // GetState returns the feemarket module's state.
 func (k *Keeper) GetState(ctx sdk.Context) (types.State, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -133,6 +198,64 @@ func (k *Keeper) SetState(ctx sdk.Context, state types.State) error {
 	return nil
 }
 
+// UpdateParams sets the given params and carries the fee market state forward to them, or
+// reinitializes the state if requested. The enabled height is set if the fee market becomes
+// enabled. Any parameter ramp in progress is cancelled, since it would otherwise overwrite
+// the params.
+func (k *Keeper) UpdateParams(ctx sdk.Context, params types.Params, resetState bool) error {
+	if err := k.setParams(ctx, params, resetState); err != nil {
+		return err
+	}
+
+	k.DeleteParamsRamp(ctx)
+
+	return nil
+}
+
+// setParams sets the given params and carries the fee market state forward to them, or
+// reinitializes the state if requested.
+func (k *Keeper) setParams(ctx sdk.Context, params types.Params, resetState bool) error {
+	gotParams, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting params: %w", err)
+	}
+
+	// if going from disabled -> enabled, set enabled height
+	if !gotParams.Enabled && params.Enabled {
+		k.SetEnabledHeight(ctx, ctx.BlockHeight())
+	}
+
+	// the pricing configuration is only validated once the fee market is enabled,
+	// since disabled parameters are never used to price transactions
+	if params.Enabled {
+		if err := k.ValidateParams(params); err != nil {
+			return fmt.Errorf("invalid params: %w", err)
+		}
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("error setting params: %w", err)
+	}
+
+	// carry the current state forward unless a reset is explicitly requested
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting state: %w", err)
+	}
+
+	if resetState || len(state.Window) == 0 {
+		state = types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	} else {
+		state.ApplyParams(params)
+	}
+
+	if err := k.SetState(ctx, state); err != nil {
+		return fmt.Errorf("error setting state: %w", err)
+	}
+
+	return nil
+}
+
 // GetParams returns the feemarket module's parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 	store := ctx.KVStore(k.storeKey)
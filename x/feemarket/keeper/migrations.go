@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/skip-mev/feemarket/x/feemarket/migrations/v2"
+	v3 "github.com/skip-mev/feemarket/x/feemarket/migrations/v3"
+	v4 "github.com/skip-mev/feemarket/x/feemarket/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +22,13 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
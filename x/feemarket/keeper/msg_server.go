@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,25 +31,367 @@ func (ms MsgServer) Params(goCtx context.Context, msg *types.MsgParams) (*types.
 		return nil, fmt.Errorf("invalid authority to execute message")
 	}
 
+	if err := ms.k.UpdateParams(ctx, msg.Params, msg.ResetState); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgParamsResponse{}, nil
+}
+
+// UpdateParamsPartial defines a method that updates the parameters listed in the update mask. The
+// merged parameters are validated and an event is emitted with the old and new value of each
+// updated parameter. The signer of the message must be the module authority.
+func (ms MsgServer) UpdateParamsPartial(
+	goCtx context.Context,
+	msg *types.MsgUpdateParamsPartial,
+) (*types.MsgUpdateParamsPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
 	gotParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting params: %w", err)
 	}
 
-	// if going from disabled -> enabled, set enabled height
-	if !gotParams.Enabled && msg.Params.Enabled {
-		ms.k.SetEnabledHeight(ctx, ctx.BlockHeight())
+	params, changes, err := gotParams.ApplyUpdateMask(msg.Params, msg.UpdateMask)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update mask: %w", err)
 	}
 
-	params := msg.Params
-	if err := ms.k.SetParams(ctx, params); err != nil {
-		return nil, fmt.Errorf("error setting params: %w", err)
+	if err := ms.k.validateParamsUpdate(params); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
 	}
 
-	newState := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
-	if err := ms.k.SetState(ctx, newState); err != nil {
-		return nil, fmt.Errorf("error setting state: %w", err)
+	if err := ms.k.UpdateParams(ctx, params, false); err != nil {
+		return nil, err
 	}
 
-	return &types.MsgParamsResponse{}, nil
+	for _, change := range changes {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeParamsUpdate,
+			sdk.NewAttribute(types.AttributeKeyParam, change.Field),
+			sdk.NewAttribute(types.AttributeKeyOldValue, change.OldValue),
+			sdk.NewAttribute(types.AttributeKeyNewValue, change.NewValue),
+		))
+	}
+
+	return &types.MsgUpdateParamsPartialResponse{}, nil
+}
+
+// ScheduleParams defines a method that schedules new parameters to take effect at a future block
+// height. The signer of the message must be the module authority.
+func (ms MsgServer) ScheduleParams(goCtx context.Context, msg *types.MsgScheduleParams) (*types.MsgScheduleParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	scheduled := msg.ScheduledParams
+	if scheduled.Height <= ctx.BlockHeight() {
+		return nil, fmt.Errorf("scheduled height %d must be greater than the current height %d", scheduled.Height, ctx.BlockHeight())
+	}
+
+	if err := ms.k.validateParamsUpdate(scheduled.Params); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	_, found, err := ms.k.GetScheduledParams(ctx, scheduled.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scheduled params: %w", err)
+	}
+
+	if found {
+		return nil, fmt.Errorf("params are already scheduled at height %d", scheduled.Height)
+	}
+
+	if err := ms.k.SetScheduledParams(ctx, scheduled); err != nil {
+		return nil, fmt.Errorf("error setting scheduled params: %w", err)
+	}
+
+	return &types.MsgScheduleParamsResponse{}, nil
+}
+
+// CancelScheduledParams defines a method that cancels a pending parameter change. The signer of
+// the message must be the module authority.
+func (ms MsgServer) CancelScheduledParams(
+	goCtx context.Context,
+	msg *types.MsgCancelScheduledParams,
+) (*types.MsgCancelScheduledParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	_, found, err := ms.k.GetScheduledParams(ctx, msg.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scheduled params: %w", err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no params are scheduled at height %d", msg.Height)
+	}
+
+	ms.k.DeleteScheduledParams(ctx, msg.Height)
+
+	return &types.MsgCancelScheduledParamsResponse{}, nil
+}
+
+// RampParams defines a method that starts a ramp from the current parameters to the target
+// parameters over the given number of blocks, replacing any ramp in progress. The signer of
+// the message must be the module authority.
+func (ms MsgServer) RampParams(goCtx context.Context, msg *types.MsgRampParams) (*types.MsgRampParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if msg.Blocks == 0 {
+		return nil, fmt.Errorf("ramp blocks must be positive")
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	// every step of the ramp lies between the params at the start of the ramp and the
+	// target params, so validating both validates the whole ramp
+	ramp := types.NewParamsRamp(params, msg.TargetParams, ctx.BlockHeight(), msg.Blocks)
+	for _, p := range []types.Params{ramp.ParamsAt(ramp.StartHeight), ramp.TargetParams} {
+		if err := p.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid params: %w", err)
+		}
+
+		if p.Enabled {
+			if err := ms.k.ValidateParams(p); err != nil {
+				return nil, fmt.Errorf("invalid params: %w", err)
+			}
+		}
+	}
+
+	if err := ms.k.SetParamsRamp(ctx, ramp); err != nil {
+		return nil, fmt.Errorf("error setting params ramp: %w", err)
+	}
+
+	return &types.MsgRampParamsResponse{}, nil
+}
+
+// FreezeBaseGasPrice defines a method that freezes the base gas price at the given price for the
+// given number of blocks. The signer of the message must be the guardian or the module authority.
+// The guardian can freeze the base gas price for at most MaxFreezeBlocks blocks and only if it is
+// not frozen already, whereas the authority replaces any freeze in effect.
+func (ms MsgServer) FreezeBaseGasPrice(
+	goCtx context.Context,
+	msg *types.MsgFreezeBaseGasPrice,
+) (*types.MsgFreezeBaseGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	isAuthority := msg.Signer == ms.k.GetAuthority()
+	if !isAuthority && (params.Guardian == "" || msg.Signer != params.Guardian) {
+		return nil, fmt.Errorf("invalid guardian or authority to execute message")
+	}
+
+	if msg.Blocks == 0 {
+		return nil, fmt.Errorf("freeze blocks must be positive")
+	}
+
+	if err := types.ValidateFrozenBaseGasPrice(msg.BaseGasPrice); err != nil {
+		return nil, err
+	}
+
+	if msg.BaseGasPrice.LT(params.MinBaseGasPrice) {
+		return nil, fmt.Errorf("frozen base gas price cannot be less than min base gas price")
+	}
+
+	if !params.MaxBaseGasPrice.IsNil() && params.MaxBaseGasPrice.IsPositive() && msg.BaseGasPrice.GT(params.MaxBaseGasPrice) {
+		return nil, fmt.Errorf("frozen base gas price cannot be greater than max base gas price")
+	}
+
+	if !isAuthority {
+		if msg.Blocks > params.MaxFreezeBlocks {
+			return nil, fmt.Errorf("freeze blocks cannot exceed %d; got %d", params.MaxFreezeBlocks, msg.Blocks)
+		}
+
+		// only the authority can extend a freeze in effect
+		_, frozen, err := ms.k.getActiveFeeFreeze(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting fee freeze: %w", err)
+		}
+
+		if frozen {
+			return nil, fmt.Errorf("base gas price is already frozen")
+		}
+	}
+
+	endHeight, err := freezeEndHeight(ctx.BlockHeight(), msg.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	freeze := types.NewFeeFreeze(msg.BaseGasPrice, endHeight)
+	if err := ms.k.SetFeeFreeze(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("error setting fee freeze: %w", err)
+	}
+
+	emitFeeFreezeEvent(ctx, freeze, msg.Signer)
+
+	return &types.MsgFreezeBaseGasPriceResponse{}, nil
+}
+
+// ExtendFeeFreeze defines a method that extends the freeze of the base gas price in effect by the
+// given number of blocks. The signer of the message must be the module authority.
+func (ms MsgServer) ExtendFeeFreeze(
+	goCtx context.Context,
+	msg *types.MsgExtendFeeFreeze,
+) (*types.MsgExtendFeeFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if msg.Blocks == 0 {
+		return nil, fmt.Errorf("freeze blocks must be positive")
+	}
+
+	freeze, frozen, err := ms.k.getActiveFeeFreeze(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee freeze: %w", err)
+	}
+
+	if !frozen {
+		return nil, fmt.Errorf("base gas price is not frozen")
+	}
+
+	freeze.EndHeight, err = freezeEndHeight(freeze.EndHeight, msg.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetFeeFreeze(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("error setting fee freeze: %w", err)
+	}
+
+	emitFeeFreezeEvent(ctx, freeze, msg.Authority)
+
+	return &types.MsgExtendFeeFreezeResponse{}, nil
+}
+
+// LiftFeeFreeze defines a method that lifts the freeze of the base gas price. The signer of the
+// message must be the module authority.
+func (ms MsgServer) LiftFeeFreeze(
+	goCtx context.Context,
+	msg *types.MsgLiftFeeFreeze,
+) (*types.MsgLiftFeeFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if err := ms.k.LiftFeeFreeze(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLiftFeeFreezeResponse{}, nil
+}
+
+// SetDenomConversion defines a method that accepts a denom for fees at a conversion rate, or
+// updates the rate of an accepted denom. The signer of the message must be the module authority.
+func (ms MsgServer) SetDenomConversion(
+	goCtx context.Context,
+	msg *types.MsgSetDenomConversion,
+) (*types.MsgSetDenomConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	dc := msg.DenomConversion
+	if err := dc.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	if dc.Denom == params.FeeDenom {
+		return nil, fmt.Errorf("cannot set a conversion rate for the fee denom %s", dc.Denom)
+	}
+
+	if err := ms.k.SetDenomConversion(ctx, dc); err != nil {
+		return nil, fmt.Errorf("error setting denom conversion: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomConversionSet,
+		sdk.NewAttribute(types.AttributeKeyDenom, dc.Denom),
+		sdk.NewAttribute(types.AttributeKeyRate, dc.Rate.String()),
+	))
+
+	return &types.MsgSetDenomConversionResponse{}, nil
+}
+
+// RemoveDenomConversion defines a method that removes a denom from the conversion table so that
+// it is no longer accepted for fees. The signer of the message must be the module authority.
+func (ms MsgServer) RemoveDenomConversion(
+	goCtx context.Context,
+	msg *types.MsgRemoveDenomConversion,
+) (*types.MsgRemoveDenomConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	_, found, err := ms.k.GetDenomConversion(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("error getting denom conversion: %w", err)
+	}
+
+	if !found {
+		return nil, types.ErrDenomNotAccepted.Wrapf("no conversion rate for denom %s", msg.Denom)
+	}
+
+	ms.k.DeleteDenomConversion(ctx, msg.Denom)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomConversionRemoved,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveDenomConversionResponse{}, nil
+}
+
+// freezeEndHeight returns the height the given number of blocks after the given height.
+func freezeEndHeight(height int64, blocks uint64) (int64, error) {
+	if blocks > uint64(math.MaxInt64-height) {
+		return 0, fmt.Errorf("freeze blocks %d overflow the block height", blocks)
+	}
+
+	return height + int64(blocks), nil
+}
+
+// emitFeeFreezeEvent emits an event for the given freeze of the base gas price.
+func emitFeeFreezeEvent(ctx sdk.Context, freeze types.FeeFreeze, signer string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeFreeze,
+		sdk.NewAttribute(types.AttributeKeyBaseGasPrice, freeze.BaseGasPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprint(freeze.EndHeight)),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+	))
 }
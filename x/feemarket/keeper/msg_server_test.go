@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -32,6 +35,18 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().Equal(req.Params, params)
 	})
 
+	s.Run("rejects a req with an unknown pricing model", func() {
+		params := types.DefaultParams()
+		params.PricingModel = "unknown"
+
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().Error(err)
+	})
+
 	s.Run("rejects a req with invalid signer", func() {
 		req := &types.MsgParams{
 			Authority: "invalid",
@@ -80,7 +95,7 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().Equal(s.ctx.BlockHeight(), newHeight)
 	})
 
-	s.Run("resets state after new params request", func() {
+	s.Run("resets state after new params request with reset flag", func() {
 		params, err := s.feeMarketKeeper.GetParams(s.ctx)
 		s.Require().NoError(err)
 
@@ -95,8 +110,9 @@ func (s *KeeperTestSuite) TestMsgParams() {
 
 		params.Window = 100
 		req := &types.MsgParams{
-			Authority: s.authorityAccount.String(),
-			Params:    params,
+			Authority:  s.authorityAccount.String(),
+			Params:     params,
+			ResetState: true,
 		}
 		_, err = s.msgServer.Params(s.ctx, req)
 		s.Require().NoError(err)
@@ -105,5 +121,557 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().NoError(err)
 		s.Require().Equal(params.Window, uint64(len(state.Window)))
 		s.Require().Equal(state.Window[0], uint64(0))
+		s.Require().Equal(params.MinBaseGasPrice, state.BaseGasPrice)
+	})
+
+	s.Run("preserves state after new params request", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.2")
+		for i := range state.Window {
+			state.Window[i] = uint64(i + 1)
+		}
+		state.Index = 3
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		params.SendTipToProposer = !params.SendTipToProposer
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+	})
+
+	s.Run("resamples the window and clamps the state to the new params", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.2")
+		for i := range state.Window {
+			state.Window[i] = uint64(i + 1)
+		}
+		state.Index = uint64(len(state.Window) - 1)
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		params.Window = 2
+		params.MaxBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		params.MaxLearningRate = math.LegacyMustNewDecFromStr("0.1")
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{1, uint64(len(state.Window))}, gotState.Window)
+		s.Require().Equal(uint64(1), gotState.Index)
+		s.Require().Equal(params.MaxBaseGasPrice, gotState.BaseGasPrice)
+		s.Require().Equal(params.MaxLearningRate, gotState.LearningRate)
+	})
+
+	s.Run("clamps the excess gas of the exponential model to the new params", func() {
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		state := types.DefaultState()
+		state.ExcessGas = 2 * params.ExcessGasUpdateFraction
+		state.BaseGasPrice = state.GetExponentialBaseGasPrice(params)
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		params.MaxBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Less(gotState.ExcessGas, state.ExcessGas)
+		s.Require().True(gotState.BaseGasPrice.LTE(params.MaxBaseGasPrice))
+
+		// A target block keeps the clamped base gas price.
+		s.addTargetBlock(params)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+		s.requireExponentialState(params, gotState.ExcessGas)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParamsPartial() {
+	s.Run("updates only the fields in the mask", func() {
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		update := types.Params{
+			FeeDenom:          "atom",
+			SendTipToProposer: !params.SendTipToProposer,
+			Window:            100,
+		}
+		req := &types.MsgUpdateParamsPartial{
+			Authority:  s.authorityAccount.String(),
+			Params:     update,
+			UpdateMask: []string{"fee_denom", "send_tip_to_proposer"},
+		}
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().NoError(err)
+
+		expected := params
+		expected.FeeDenom = update.FeeDenom
+		expected.SendTipToProposer = update.SendTipToProposer
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, gotParams)
+
+		// the fee market state is carried forward
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+
+		var events []sdk.Event
+		for _, event := range s.ctx.EventManager().Events() {
+			if event.Type == types.EventTypeParamsUpdate {
+				events = append(events, event)
+			}
+		}
+		s.Require().Len(events, 2)
+
+		s.Require().Equal(sdk.NewEvent(
+			types.EventTypeParamsUpdate,
+			sdk.NewAttribute(types.AttributeKeyParam, "fee_denom"),
+			sdk.NewAttribute(types.AttributeKeyOldValue, params.FeeDenom),
+			sdk.NewAttribute(types.AttributeKeyNewValue, "atom"),
+		), events[0])
+	})
+
+	s.Run("rejects merged params that are invalid", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+		req := &types.MsgUpdateParamsPartial{
+			Authority:  s.authorityAccount.String(),
+			Params:     types.Params{},
+			UpdateMask: []string{"fee_denom"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().Error(err)
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), gotParams)
+	})
+
+	s.Run("rejects merged params with a zero window that disable the fee market", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+		req := &types.MsgUpdateParamsPartial{
+			Authority:  s.authorityAccount.String(),
+			Params:     types.Params{},
+			UpdateMask: []string{"enabled", "window"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().ErrorContains(err, "window cannot be zero")
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), gotParams)
+	})
+
+	s.Run("rejects an unknown field", func() {
+		req := &types.MsgUpdateParamsPartial{
+			Authority:  s.authorityAccount.String(),
+			Params:     types.DefaultParams(),
+			UpdateMask: []string{"unknown"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		req := &types.MsgUpdateParamsPartial{
+			Authority:  "invalid",
+			Params:     types.DefaultParams(),
+			UpdateMask: []string{"fee_denom"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgScheduleParams() {
+	s.Run("schedules params at a future height", func() {
+		params := types.DefaultParams()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		ctx := s.ctx.WithBlockHeight(5)
+		newParams := types.DefaultAIMDParams()
+		req := types.NewMsgScheduleParams(s.authorityAccount.String(), 10, newParams, true)
+		_, err := s.msgServer.ScheduleParams(ctx, &req)
+		s.Require().NoError(err)
+
+		got, found, err := s.feeMarketKeeper.GetScheduledParams(ctx, 10)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(req.ScheduledParams, got)
+
+		// the current params are unchanged
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		// a second change cannot be scheduled at the same height
+		_, err = s.msgServer.ScheduleParams(ctx, &req)
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.DeleteScheduledParams(ctx, 10)
+	})
+
+	s.Run("rejects a height that is not in the future", func() {
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgScheduleParams(s.authorityAccount.String(), 5, types.DefaultParams(), false)
+		_, err := s.msgServer.ScheduleParams(ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects invalid params", func() {
+		params := types.DefaultParams()
+		params.PricingModel = "unknown"
+		req := types.NewMsgScheduleParams(s.authorityAccount.String(), 10, params, false)
+		_, err := s.msgServer.ScheduleParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects invalid params that disable the fee market", func() {
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgScheduleParams(s.authorityAccount.String(), 10, types.Params{}, false)
+		_, err := s.msgServer.ScheduleParams(ctx, &req)
+		s.Require().ErrorContains(err, "window cannot be zero")
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(ctx, 10)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		req := types.NewMsgScheduleParams("invalid", 10, types.DefaultParams(), false)
+		_, err := s.msgServer.ScheduleParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgCancelScheduledParams() {
+	s.Run("cancels scheduled params", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(10, types.DefaultParams(), false)))
+
+		req := types.NewMsgCancelScheduledParams(s.authorityAccount.String(), 10)
+		_, err := s.msgServer.CancelScheduledParams(s.ctx, &req)
+		s.Require().NoError(err)
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("rejects a height without scheduled params", func() {
+		req := types.NewMsgCancelScheduledParams(s.authorityAccount.String(), 10)
+		_, err := s.msgServer.CancelScheduledParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(10, types.DefaultParams(), false)))
+
+		req := types.NewMsgCancelScheduledParams("invalid", 10)
+		_, err := s.msgServer.CancelScheduledParams(s.ctx, &req)
+		s.Require().Error(err)
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().True(found)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgRampParams() {
+	s.Run("starts a ramp from the current params", func() {
+		params := types.DefaultParams()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgRampParams(s.authorityAccount.String(), types.DefaultAIMDParams(), 100)
+		_, err := s.msgServer.RampParams(ctx, &req)
+		s.Require().NoError(err)
+
+		ramp, found, err := s.feeMarketKeeper.GetParamsRamp(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(types.NewParamsRamp(params, types.DefaultAIMDParams(), 5, 100), ramp)
+
+		// the current params are unchanged
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		s.feeMarketKeeper.DeleteParamsRamp(ctx)
+	})
+
+	s.Run("rejects a ramp with invalid steps", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultAIMDParams()))
+
+		// the eip-1559 pricing model requires equal learning rate bounds, which do
+		// not hold while they are interpolated
+		req := types.NewMsgRampParams(s.authorityAccount.String(), types.DefaultParams(), 10)
+		_, err := s.msgServer.RampParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a ramp without blocks", func() {
+		req := types.NewMsgRampParams(s.authorityAccount.String(), types.DefaultAIMDParams(), 0)
+		_, err := s.msgServer.RampParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects invalid params", func() {
+		params := types.DefaultAIMDParams()
+		params.PricingModel = "unknown"
+		req := types.NewMsgRampParams(s.authorityAccount.String(), params, 10)
+		_, err := s.msgServer.RampParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		req := types.NewMsgRampParams("invalid", types.DefaultAIMDParams(), 10)
+		_, err := s.msgServer.RampParams(s.ctx, &req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgFreezeBaseGasPrice() {
+	guardian := sdk.AccAddress("guardian").String()
+	params := types.DefaultParams()
+	params.Guardian = guardian
+	params.MaxFreezeBlocks = 10
+
+	s.Run("the guardian can freeze the base gas price", func() {
+		s.setGenesisState(params, types.DefaultState())
+
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgFreezeBaseGasPrice(guardian, math.LegacyNewDec(5), 10)
+		_, err := s.msgServer.FreezeBaseGasPrice(ctx, &req)
+		s.Require().NoError(err)
+
+		freeze, found, err := s.feeMarketKeeper.GetFeeFreeze(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(types.NewFeeFreeze(math.LegacyNewDec(5), 15), freeze)
+
+		// the guardian cannot extend the freeze
+		_, err = s.msgServer.FreezeBaseGasPrice(ctx, &req)
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(ctx)
+	})
+
+	s.Run("the guardian cannot freeze for more than max freeze blocks", func() {
+		s.setGenesisState(params, types.DefaultState())
+
+		req := types.NewMsgFreezeBaseGasPrice(guardian, math.LegacyNewDec(5), 11)
+		_, err := s.msgServer.FreezeBaseGasPrice(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("the authority can replace a freeze for any number of blocks", func() {
+		s.setGenesisState(params, types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(math.LegacyNewDec(5), 15)))
+
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgFreezeBaseGasPrice(s.authorityAccount.String(), math.LegacyNewDec(2), 100)
+		_, err := s.msgServer.FreezeBaseGasPrice(ctx, &req)
+		s.Require().NoError(err)
+
+		freeze, found, err := s.feeMarketKeeper.GetFeeFreeze(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(types.NewFeeFreeze(math.LegacyNewDec(2), 105), freeze)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(ctx)
+	})
+
+	s.Run("rejects a price below the min base gas price", func() {
+		aimdParams := types.DefaultAIMDParams()
+		s.setGenesisState(aimdParams, types.DefaultAIMDState())
+
+		req := types.NewMsgFreezeBaseGasPrice(s.authorityAccount.String(), math.LegacyOneDec(), 10)
+		_, err := s.msgServer.FreezeBaseGasPrice(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.setGenesisState(params, types.DefaultState())
+
+		req := types.NewMsgFreezeBaseGasPrice(sdk.AccAddress("invalid").String(), math.LegacyNewDec(5), 10)
+		_, err := s.msgServer.FreezeBaseGasPrice(s.ctx, &req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgExtendFeeFreeze() {
+	s.Run("extends the freeze in effect", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(math.LegacyNewDec(5), 15)))
+
+		ctx := s.ctx.WithBlockHeight(5)
+		req := types.NewMsgExtendFeeFreeze(s.authorityAccount.String(), 10)
+		_, err := s.msgServer.ExtendFeeFreeze(ctx, &req)
+		s.Require().NoError(err)
+
+		freeze, found, err := s.feeMarketKeeper.GetFeeFreeze(ctx)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(int64(25), freeze.EndHeight)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(ctx)
+	})
+
+	s.Run("rejects a req without a freeze in effect", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		req := types.NewMsgExtendFeeFreeze(s.authorityAccount.String(), 10)
+		_, err := s.msgServer.ExtendFeeFreeze(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(math.LegacyNewDec(5), 15)))
+
+		req := types.NewMsgExtendFeeFreeze("invalid", 10)
+		_, err := s.msgServer.ExtendFeeFreeze(s.ctx, &req)
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(s.ctx)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgLiftFeeFreeze() {
+	s.Run("lifts the freeze in effect", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(math.LegacyNewDec(5), 15)))
+
+		req := types.NewMsgLiftFeeFreeze(s.authorityAccount.String())
+		_, err := s.msgServer.LiftFeeFreeze(s.ctx, &req)
+		s.Require().NoError(err)
+
+		_, found, err := s.feeMarketKeeper.GetFeeFreeze(s.ctx)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(5), state.BaseGasPrice)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, types.NewFeeFreeze(math.LegacyNewDec(5), 15)))
+
+		req := types.NewMsgLiftFeeFreeze(sdk.AccAddress("guardian").String())
+		_, err := s.msgServer.LiftFeeFreeze(s.ctx, &req)
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(s.ctx)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSetDenomConversion() {
+	s.Run("sets a denom conversion", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		dc := types.NewDenomConversion("atom", math.LegacyNewDec(2))
+		req := types.NewMsgSetDenomConversion(s.authorityAccount.String(), dc)
+		_, err := s.msgServer.SetDenomConversion(s.ctx, &req)
+		s.Require().NoError(err)
+
+		got, found, err := s.feeMarketKeeper.GetDenomConversion(s.ctx, "atom")
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(dc, got)
+
+		s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "atom")
+	})
+
+	s.Run("rejects a conversion for the fee denom", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		dc := types.NewDenomConversion(types.DefaultFeeDenom, math.LegacyNewDec(2))
+		req := types.NewMsgSetDenomConversion(s.authorityAccount.String(), dc)
+		_, err := s.msgServer.SetDenomConversion(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects an invalid conversion", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		dc := types.NewDenomConversion("atom", math.LegacyZeroDec())
+		req := types.NewMsgSetDenomConversion(s.authorityAccount.String(), dc)
+		_, err := s.msgServer.SetDenomConversion(s.ctx, &req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		dc := types.NewDenomConversion("atom", math.LegacyNewDec(2))
+		req := types.NewMsgSetDenomConversion(sdk.AccAddress("guardian").String(), dc)
+		_, err := s.msgServer.SetDenomConversion(s.ctx, &req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveDenomConversion() {
+	s.Run("removes a denom conversion", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("atom", math.LegacyNewDec(2))))
+
+		req := types.NewMsgRemoveDenomConversion(s.authorityAccount.String(), "atom")
+		_, err := s.msgServer.RemoveDenomConversion(s.ctx, &req)
+		s.Require().NoError(err)
+
+		_, found, err := s.feeMarketKeeper.GetDenomConversion(s.ctx, "atom")
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("rejects a req for a denom without conversion", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		req := types.NewMsgRemoveDenomConversion(s.authorityAccount.String(), "atom")
+		_, err := s.msgServer.RemoveDenomConversion(s.ctx, &req)
+		s.Require().ErrorIs(err, types.ErrDenomNotAccepted)
+	})
+
+	s.Run("rejects a req with invalid signer", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, types.NewDenomConversion("atom", math.LegacyNewDec(2))))
+
+		req := types.NewMsgRemoveDenomConversion(sdk.AccAddress("guardian").String(), "atom")
+		_, err := s.msgServer.RemoveDenomConversion(s.ctx, &req)
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "atom")
 	})
 }
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetParamsRamp returns the parameter ramp in progress. False is returned if no
+// ramp is in progress.
+func (k *Keeper) GetParamsRamp(ctx sdk.Context) (types.ParamsRamp, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyParamsRamp)
+	if bz == nil {
+		return types.ParamsRamp{}, false, nil
+	}
+
+	ramp := types.ParamsRamp{}
+	if err := ramp.Unmarshal(bz); err != nil {
+		return types.ParamsRamp{}, false, err
+	}
+
+	return ramp, true, nil
+}
+
+// SetParamsRamp sets the parameter ramp in progress, replacing any existing ramp.
+func (k *Keeper) SetParamsRamp(ctx sdk.Context, ramp types.ParamsRamp) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := ramp.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyParamsRamp, bz)
+
+	return nil
+}
+
+// DeleteParamsRamp removes the parameter ramp in progress.
+func (k *Keeper) DeleteParamsRamp(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyParamsRamp)
+}
+
+// ApplyParamsRamp sets the params to the interpolated params of the ramp in progress
+// at the current height. The ramp is removed once it completes. A ramp step that
+// fails validation ends the ramp and is reported with an event, so that a bad ramp
+// cannot halt the chain.
+func (k *Keeper) ApplyParamsRamp(ctx sdk.Context) error {
+	ramp, found, err := k.GetParamsRamp(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !found || ctx.BlockHeight() <= ramp.StartHeight {
+		return nil
+	}
+
+	// apply the step in a cached context so that a failed step leaves no partial
+	// writes behind
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.setParams(cacheCtx, ramp.ParamsAt(ctx.BlockHeight()), false); err != nil {
+		k.Logger(ctx).Error(
+			"failed to apply params ramp",
+			"height", ctx.BlockHeight(),
+			"err", err,
+		)
+
+		k.DeleteParamsRamp(ctx)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeParamsRampFailed,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+		return nil
+	}
+	write()
+
+	if ramp.IsComplete(ctx.BlockHeight()) {
+		k.DeleteParamsRamp(ctx)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeParamsRampCompleted,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestApplyParamsRamp() {
+	s.Run("ramps the params in end block", func() {
+		start := types.DefaultParams()
+		s.setGenesisState(start, types.DefaultState())
+
+		target := types.DefaultAIMDParams()
+		ramp := types.NewParamsRamp(start, target, 10, 4)
+		s.Require().NoError(s.feeMarketKeeper.SetParamsRamp(s.ctx, ramp))
+
+		var prev types.Params
+		for height := int64(11); height < 14; height++ {
+			ctx := s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+			params, err := s.feeMarketKeeper.GetParams(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(ramp.ParamsAt(height), params)
+			if height > 11 {
+				s.Require().True(params.MinBaseGasPrice.GT(prev.MinBaseGasPrice))
+			}
+			prev = params
+
+			// the base gas price follows the min base gas price
+			state, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			s.Require().True(state.BaseGasPrice.GTE(params.MinBaseGasPrice))
+		}
+
+		ctx := s.ctx.WithBlockHeight(14).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		params, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(target, params)
+
+		_, found, err := s.feeMarketKeeper.GetParamsRamp(ctx)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		s.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+			types.EventTypeParamsRampCompleted,
+			sdk.NewAttribute(types.AttributeKeyHeight, "14"),
+		))
+	})
+
+	s.Run("a params update cancels the ramp", func() {
+		start := types.DefaultParams()
+		s.setGenesisState(start, types.DefaultState())
+		s.Require().NoError(s.feeMarketKeeper.SetParamsRamp(s.ctx, types.NewParamsRamp(start, types.DefaultAIMDParams(), 10, 4)))
+
+		ctx := s.ctx.WithBlockHeight(11)
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    start,
+		}
+		_, err := s.msgServer.Params(ctx, req)
+		s.Require().NoError(err)
+
+		_, found, err := s.feeMarketKeeper.GetParamsRamp(ctx)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("ends the ramp if a step is invalid", func() {
+		start := types.DefaultParams()
+		s.setGenesisState(start, types.DefaultState())
+
+		target := types.DefaultParams()
+		target.PricingModel = "unknown"
+		s.Require().NoError(s.feeMarketKeeper.SetParamsRamp(s.ctx, types.NewParamsRamp(start, target, 10, 1)))
+
+		ctx := s.ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		params, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(start, params)
+
+		_, found, err := s.feeMarketKeeper.GetParamsRamp(ctx)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		var failed []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeParamsRampFailed {
+				failed = append(failed, event)
+			}
+		}
+		s.Require().Len(failed, 1)
+	})
+}
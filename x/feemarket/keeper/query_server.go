@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -28,12 +29,38 @@ func (q QueryServer) Params(goCtx context.Context, _ *types.ParamsRequest) (*typ
 	return &types.ParamsResponse{Params: params}, err
 }
 
-// State defines a method that returns the current feemarket state.
+// State defines a method that returns the current feemarket state, the progress of the
+// parameter ramp in progress and the freeze of the base gas price, if any.
 func (q QueryServer) State(goCtx context.Context, _ *types.StateRequest) (*types.StateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	state, err := q.k.GetState(ctx)
-	return &types.StateResponse{State: state}, err
+	if err != nil {
+		return &types.StateResponse{State: state}, err
+	}
+
+	resp := &types.StateResponse{State: state, RampProgress: math.LegacyZeroDec()}
+
+	ramp, found, err := q.k.GetParamsRamp(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	if found {
+		resp.ParamsRamp = &ramp
+		resp.RampProgress = ramp.Progress(ctx.BlockHeight())
+	}
+
+	freeze, frozen, err := q.k.GetFeeFreeze(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	if frozen {
+		resp.FeeFreeze = &freeze
+	}
+
+	return resp, nil
 }
 
 // GasPrice defines a method that returns the current feemarket base gas price.
@@ -41,7 +68,17 @@ func (q QueryServer) GasPrice(goCtx context.Context, req *types.GasPriceRequest)
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	gasPrice, err := q.k.GetMinGasPrice(ctx, req.GetDenom())
-	return &types.GasPriceResponse{Price: gasPrice}, err
+	if err != nil || req.GetMsgTypeUrl() == "" {
+		return &types.GasPriceResponse{Price: gasPrice}, err
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return &types.GasPriceResponse{}, err
+	}
+
+	gasPrice.Amount = gasPrice.Amount.Mul(params.GetMsgFeeMultiplier(req.GetMsgTypeUrl()))
+	return &types.GasPriceResponse{Price: gasPrice}, nil
 }
 
 // GasPrices defines a method that returns the current feemarket list of gas prices.
@@ -51,3 +88,58 @@ func (q QueryServer) GasPrices(goCtx context.Context, _ *types.GasPricesRequest)
 	gasPrices, err := q.k.GetMinGasPrices(ctx)
 	return &types.GasPricesResponse{Prices: gasPrices}, err
 }
+
+// FeeMultipliers defines a method that returns the active per message type fee multipliers.
+func (q QueryServer) FeeMultipliers(goCtx context.Context, _ *types.FeeMultipliersRequest) (*types.FeeMultipliersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := q.k.GetParams(ctx)
+	return &types.FeeMultipliersResponse{Multipliers: params.MsgFeeMultipliers}, err
+}
+
+// ScheduledParams defines a method that returns the pending scheduled parameter changes.
+func (q QueryServer) ScheduledParams(goCtx context.Context, _ *types.ScheduledParamsRequest) (*types.ScheduledParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduled, err := q.k.GetAllScheduledParams(ctx)
+	return &types.ScheduledParamsResponse{ScheduledParams: scheduled}, err
+}
+
+// BurnedFees defines a method that returns the total amount of fees burned by the module.
+func (q QueryServer) BurnedFees(goCtx context.Context, _ *types.BurnedFeesRequest) (*types.BurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burned, err := q.k.GetBurnedFees(ctx)
+	return &types.BurnedFeesResponse{BurnedFees: burned}, err
+}
+
+// DenomConversions defines a method that returns the denoms accepted for fees in addition to the
+// fee denom along with their conversion rates.
+func (q QueryServer) DenomConversions(
+	goCtx context.Context,
+	_ *types.DenomConversionsRequest,
+) (*types.DenomConversionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	conversions, err := q.k.GetAllDenomConversions(ctx)
+	return &types.DenomConversionsResponse{DenomConversions: conversions}, err
+}
+
+// DenomConversion defines a method that returns the conversion rate of a denom accepted for fees.
+func (q QueryServer) DenomConversion(
+	goCtx context.Context,
+	req *types.DenomConversionRequest,
+) (*types.DenomConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dc, found, err := q.k.GetDenomConversion(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, types.ErrDenomNotAccepted.Wrapf("no conversion rate for denom %s", req.GetDenom())
+	}
+
+	return &types.DenomConversionResponse{DenomConversion: dc}, nil
+}
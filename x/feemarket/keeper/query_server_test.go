@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -23,16 +24,27 @@ func (s *KeeperTestSuite) TestParamsRequest() {
 
 	s.Run("can get updated params", func() {
 		params := types.Params{
-			Alpha:               math.LegacyMustNewDecFromStr("0.1"),
-			Beta:                math.LegacyMustNewDecFromStr("0.1"),
-			Gamma:               math.LegacyMustNewDecFromStr("0.1"),
-			Delta:               math.LegacyMustNewDecFromStr("0.1"),
-			MinBaseGasPrice:     math.LegacyNewDec(10),
-			MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxBlockUtilization: 10,
-			Window:              1,
-			Enabled:             true,
+			Alpha:                   math.LegacyMustNewDecFromStr("0.1"),
+			Beta:                    math.LegacyMustNewDecFromStr("0.1"),
+			Gamma:                   math.LegacyMustNewDecFromStr("0.1"),
+			Delta:                   math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseGasPrice:         math.LegacyNewDec(10),
+			MaxBaseGasPrice:         math.LegacyNewDec(100),
+			MaxBaseGasPriceIncrease: math.LegacyMustNewDecFromStr("0.1"),
+			MaxBaseGasPriceDecrease: math.LegacyMustNewDecFromStr("0.1"),
+			PidProportionalGain:     math.LegacyMustNewDecFromStr("0.1"),
+			PidIntegralGain:         math.LegacyMustNewDecFromStr("0.1"),
+			PidDerivativeGain:       math.LegacyMustNewDecFromStr("0.1"),
+			PidIntegralLimit:        math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseBytePrice:        math.LegacyMustNewDecFromStr("0.1"),
+			ByteLearningRate:        math.LegacyMustNewDecFromStr("0.1"),
+			UnusedGasPenalty:        math.LegacyMustNewDecFromStr("0.1"),
+			MinLearningRate:         math.LegacyMustNewDecFromStr("0.1"),
+			MaxLearningRate:         math.LegacyMustNewDecFromStr("0.1"),
+			MaxBlockUtilization:     10,
+			TargetUtilizationRatio:  math.LegacyMustNewDecFromStr("0.5"),
+			Window:                  1,
+			Enabled:                 true,
 		}
 		err := s.feeMarketKeeper.SetParams(s.ctx, params)
 		s.Require().NoError(err)
@@ -68,10 +80,12 @@ func (s *KeeperTestSuite) TestStateRequest() {
 
 	s.Run("can get updated state", func() {
 		state := types.State{
-			BaseGasPrice: math.LegacyOneDec(),
-			LearningRate: math.LegacyOneDec(),
-			Window:       []uint64{1},
-			Index:        0,
+			BaseGasPrice:  math.LegacyOneDec(),
+			LearningRate:  math.LegacyOneDec(),
+			Window:        []uint64{1},
+			Index:         0,
+			BaseBytePrice: math.LegacyOneDec(),
+			ByteWindow:    []uint64{1},
 		}
 		err := s.feeMarketKeeper.SetState(s.ctx, state)
 		s.Require().NoError(err)
@@ -157,3 +171,155 @@ func (s *KeeperTestSuite) TestBaseFeeRequest() {
 		s.Require().Equal(resp.GetPrice(), fee)
 	})
 }
+
+func (s *KeeperTestSuite) TestFeeMultipliersRequest() {
+	s.Run("can get fee multipliers", func() {
+		params := types.DefaultParams()
+		params.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+		}
+		err := s.feeMarketKeeper.SetParams(s.ctx, params)
+		s.Require().NoError(err)
+
+		resp, err := s.queryServer.FeeMultipliers(s.ctx, &types.FeeMultipliersRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		s.Require().Equal(params.MsgFeeMultipliers, resp.Multipliers)
+	})
+
+	s.Run("gas price is scaled by the msg type multiplier", func() {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(10)
+		err := s.feeMarketKeeper.SetState(s.ctx, state)
+		s.Require().NoError(err)
+
+		params := types.DefaultParams()
+		params.MinBaseGasPrice = math.LegacyOneDec()
+		params.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+		}
+		err = s.feeMarketKeeper.SetParams(s.ctx, params)
+		s.Require().NoError(err)
+
+		resp, err := s.queryServer.GasPrice(s.ctx, &types.GasPriceRequest{
+			Denom:      params.FeeDenom,
+			MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(5), resp.GetPrice().Amount)
+
+		resp, err = s.queryServer.GasPrice(s.ctx, &types.GasPriceRequest{
+			Denom:      params.FeeDenom,
+			MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(10), resp.GetPrice().Amount)
+	})
+}
+
+func (s *KeeperTestSuite) TestScheduledParamsRequest() {
+	s.Run("can get scheduled params", func() {
+		scheduled := []types.ScheduledParams{
+			types.NewScheduledParams(10, types.DefaultParams(), false),
+			types.NewScheduledParams(20, types.DefaultAIMDParams(), true),
+		}
+		for _, sp := range scheduled {
+			s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, sp))
+		}
+
+		resp, err := s.queryServer.ScheduledParams(s.ctx, &types.ScheduledParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		s.Require().Equal(scheduled, resp.ScheduledParams)
+	})
+}
+
+func (s *KeeperTestSuite) TestBurnedFeesRequest() {
+	s.Run("can get burned fees", func() {
+		burned := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		s.Require().NoError(s.feeMarketKeeper.AddBurnedFees(s.ctx, burned))
+
+		resp, err := s.queryServer.BurnedFees(s.ctx, &types.BurnedFeesRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		s.Require().Equal(burned, resp.BurnedFees)
+	})
+}
+
+func (s *KeeperTestSuite) TestDenomConversionsRequest() {
+	atom := types.NewDenomConversion("atom", math.LegacyNewDec(2))
+	osmo := types.NewDenomConversion("osmo", math.LegacyMustNewDecFromStr("0.5"))
+	s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, osmo))
+	s.Require().NoError(s.feeMarketKeeper.SetDenomConversion(s.ctx, atom))
+	defer s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "atom")
+	defer s.feeMarketKeeper.DeleteDenomConversion(s.ctx, "osmo")
+
+	s.Run("can get all denom conversions", func() {
+		resp, err := s.queryServer.DenomConversions(s.ctx, &types.DenomConversionsRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		s.Require().Equal([]types.DenomConversion{atom, osmo}, resp.DenomConversions)
+	})
+
+	s.Run("can get a denom conversion", func() {
+		resp, err := s.queryServer.DenomConversion(s.ctx, &types.DenomConversionRequest{Denom: "osmo"})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		s.Require().Equal(osmo, resp.DenomConversion)
+	})
+
+	s.Run("rejects a denom without conversion", func() {
+		_, err := s.queryServer.DenomConversion(s.ctx, &types.DenomConversionRequest{Denom: "juno"})
+		s.Require().ErrorIs(err, types.ErrDenomNotAccepted)
+	})
+}
+
+func (s *KeeperTestSuite) TestStateRequestParamsRamp() {
+	s.Run("state includes the progress of the params ramp", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		resp, err := s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().Nil(resp.ParamsRamp)
+		s.Require().True(resp.RampProgress.IsZero())
+
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.DefaultAIMDParams(), 10, 4)
+		s.Require().NoError(s.feeMarketKeeper.SetParamsRamp(s.ctx, ramp))
+
+		resp, err = s.queryServer.State(s.ctx.WithBlockHeight(13), &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(&ramp, resp.ParamsRamp)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.75"), resp.RampProgress)
+
+		s.feeMarketKeeper.DeleteParamsRamp(s.ctx)
+	})
+}
+
+func (s *KeeperTestSuite) TestStateRequestFeeFreeze() {
+	s.Run("state includes the fee freeze", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		resp, err := s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().Nil(resp.FeeFreeze)
+
+		freeze := types.NewFeeFreeze(math.LegacyNewDec(5), 15)
+		s.Require().NoError(s.feeMarketKeeper.SetFeeFreeze(s.ctx, freeze))
+
+		ctx := s.ctx.WithBlockHeight(10)
+		resp, err = s.queryServer.State(ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(&freeze, resp.FeeFreeze)
+
+		gasPrice, err := s.queryServer.GasPrice(ctx, &types.GasPriceRequest{Denom: types.DefaultFeeDenom})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(5), gasPrice.Price.Amount)
+
+		s.feeMarketKeeper.DeleteFeeFreeze(s.ctx)
+	})
+}
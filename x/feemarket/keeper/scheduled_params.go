@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetScheduledParams returns the scheduled parameter change at the given height.
+// False is returned if no change is scheduled at the height.
+func (k *Keeper) GetScheduledParams(ctx sdk.Context, height int64) (types.ScheduledParams, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetScheduledParamsKey(height))
+	if bz == nil {
+		return types.ScheduledParams{}, false, nil
+	}
+
+	scheduled := types.ScheduledParams{}
+	if err := scheduled.Unmarshal(bz); err != nil {
+		return types.ScheduledParams{}, false, err
+	}
+
+	return scheduled, true, nil
+}
+
+// SetScheduledParams stores the given scheduled parameter change, replacing any
+// change scheduled at the same height.
+func (k *Keeper) SetScheduledParams(ctx sdk.Context, scheduled types.ScheduledParams) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := scheduled.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetScheduledParamsKey(scheduled.Height), bz)
+
+	return nil
+}
+
+// DeleteScheduledParams removes the scheduled parameter change at the given height.
+func (k *Keeper) DeleteScheduledParams(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledParamsKey(height))
+}
+
+// GetAllScheduledParams returns all scheduled parameter changes, ordered by height.
+func (k *Keeper) GetAllScheduledParams(ctx sdk.Context) ([]types.ScheduledParams, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyScheduledParamsPrefix)
+	defer iterator.Close()
+
+	var scheduled []types.ScheduledParams
+	for ; iterator.Valid(); iterator.Next() {
+		sp := types.ScheduledParams{}
+		if err := sp.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		scheduled = append(scheduled, sp)
+	}
+
+	return scheduled, nil
+}
+
+// ApplyScheduledParams applies all scheduled parameter changes up to and including
+// the current height in height order and removes them from the store. A change that
+// fails validation is dropped and reported with an event, so that a bad schedule
+// cannot halt the chain.
+func (k *Keeper) ApplyScheduledParams(ctx sdk.Context) error {
+	scheduled, err := k.GetAllScheduledParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sp := range scheduled {
+		if sp.Height > ctx.BlockHeight() {
+			break
+		}
+
+		k.DeleteScheduledParams(ctx, sp.Height)
+
+		// apply the change in a cached context so that a failed change leaves no
+		// partial writes behind
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.UpdateParams(cacheCtx, sp.Params, sp.ResetState); err != nil {
+			k.Logger(ctx).Error(
+				"failed to apply scheduled params",
+				"height", sp.Height,
+				"err", err,
+			)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeScheduledParamsFailed,
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(sp.Height)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduledParamsApplied,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(sp.Height)),
+		))
+	}
+
+	return nil
+}
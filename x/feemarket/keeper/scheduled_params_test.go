@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledParams() {
+	s.Run("can set and get scheduled params", func() {
+		scheduled := types.NewScheduledParams(10, types.DefaultAIMDParams(), true)
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, scheduled))
+
+		got, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(scheduled, got)
+
+		_, found, err = s.feeMarketKeeper.GetScheduledParams(s.ctx, 11)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		s.feeMarketKeeper.DeleteScheduledParams(s.ctx, 10)
+		_, found, err = s.feeMarketKeeper.GetScheduledParams(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("scheduled params are returned in height order", func() {
+		for _, height := range []int64{300, 2, 256, 1} {
+			s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(height, types.DefaultParams(), false)))
+		}
+
+		scheduled, err := s.feeMarketKeeper.GetAllScheduledParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(scheduled, 4)
+		for i, height := range []int64{1, 2, 256, 300} {
+			s.Require().Equal(height, scheduled[i].Height)
+			s.feeMarketKeeper.DeleteScheduledParams(s.ctx, height)
+		}
+	})
+}
+
+func (s *KeeperTestSuite) TestApplyScheduledParams() {
+	s.Run("applies due params in end block before updating the fee market", func() {
+		params := types.DefaultParams()
+		s.setGenesisState(params, types.DefaultState())
+
+		newParams := types.DefaultParams()
+		newParams.MinBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(10, newParams, false)))
+
+		// the change is not applied before its height
+		ctx := s.ctx.WithBlockHeight(9)
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		ctx = s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err = s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(newParams, gotParams)
+
+		// the state is clamped to the new params before the fee market is updated
+		gotState, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(newParams.MinBaseGasPrice, gotState.BaseGasPrice)
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(ctx, 10)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		s.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+			types.EventTypeScheduledParamsApplied,
+			sdk.NewAttribute(types.AttributeKeyHeight, "10"),
+		))
+	})
+
+	s.Run("applies missed params in height order", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		first := types.DefaultParams()
+		first.FeeDenom = "first"
+		second := types.DefaultParams()
+		second.FeeDenom = "second"
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(6, second, false)))
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(5, first, false)))
+
+		ctx := s.ctx.WithBlockHeight(7)
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(second, gotParams)
+
+		scheduled, err := s.feeMarketKeeper.GetAllScheduledParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(scheduled)
+	})
+
+	s.Run("drops invalid params without halting", func() {
+		params := types.DefaultParams()
+		s.setGenesisState(params, types.DefaultState())
+
+		invalid := types.DefaultParams()
+		invalid.PricingModel = "unknown"
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.NewScheduledParams(3, invalid, false)))
+
+		ctx := s.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(ctx, 3)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		var failed []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeScheduledParamsFailed {
+				failed = append(failed, event)
+			}
+		}
+		s.Require().Len(failed, 1)
+	})
+}
@@ -0,0 +1,67 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/skip-mev/feemarket/x/feemarket"
+	v3 "github.com/skip-mev/feemarket/x/feemarket/migrations/v3"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestParamsUpgrade(t *testing.T) {
+	var (
+		encCfg = moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+		cdc    = encCfg.Codec
+
+		storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+		tKey     = storetypes.NewTransientStoreKey("transient_test")
+		ctx      = testutil.DefaultContext(storeKey, tKey)
+	)
+
+	// Write old params
+	oldParams := types.Params{
+		Alpha:               math.LegacyMustNewDecFromStr("0.0"),
+		Beta:                math.LegacyMustNewDecFromStr("1.0"),
+		Gamma:               math.LegacyMustNewDecFromStr("0.0"),
+		Delta:               math.LegacyMustNewDecFromStr("0.0"),
+		MinBaseGasPrice:     math.LegacyOneDec(),
+		MinLearningRate:     math.LegacyMustNewDecFromStr("0.125"),
+		MaxLearningRate:     math.LegacyMustNewDecFromStr("0.125"),
+		MaxBlockUtilization: 30_000_000,
+		Window:              1,
+		FeeDenom:            types.DefaultFeeDenom,
+		Enabled:             true,
+		DistributeFees:      true,
+		SendTipToProposer:   true,
+	}
+
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+
+	store.Set(types.KeyParams, bz)
+
+	// Run migration
+	require.NoError(t, v3.MigrateStore(ctx, cdc, storeKey))
+
+	bz = store.Get(types.KeyParams)
+	require.NotNil(t, bz)
+
+	var newParams types.Params
+	cdc.MustUnmarshal(bz, &newParams)
+
+	// Check params are correct
+	expectedParams := oldParams
+	expectedParams.TargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.5")
+	require.Equal(t, expectedParams.TargetUtilizationRatio, newParams.TargetUtilizationRatio)
+	require.Equal(t, cdc.MustMarshal(&expectedParams), bz)
+	require.NoError(t, newParams.ValidateBasic())
+	require.Equal(t, oldParams.MaxBlockUtilization/2, newParams.TargetBlockUtilization())
+}
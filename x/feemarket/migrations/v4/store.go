@@ -0,0 +1,48 @@
+package v4
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration adds new feemarket param -- ExcessGasUpdateFraction.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	if err := migrateParams(ctx, cdc, storeKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating feemarket params...")
+
+	// fetch old params
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyParams)
+	if bz == nil {
+		return errors.New("cannot fetch feemarket params from KV store")
+	}
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	// the update fraction is only used by the exponential pricing model, which did not exist before
+	params.ExcessGasUpdateFraction = types.DefaultExcessGasUpdateFraction
+
+	// set params
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyParams, bz)
+
+	ctx.Logger().Info("Finished migrating feemarket params")
+
+	return nil
+}
@@ -26,7 +26,7 @@ import (
 )
 
 // ConsensusVersion is the x/feemarket module's consensus version identifier.
-const ConsensusVersion = 2
+const ConsensusVersion = 4
 
 var (
 	_ module.HasName        = AppModule{}
@@ -124,6 +124,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/feemarket from version 1 to 2: %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/feemarket from version 2 to 3: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/feemarket from version 3 to 4: %v", err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the feemarket
@@ -172,6 +180,16 @@ type Inputs struct {
 	Cdc           codec.Codec
 	Key           *store.KVStoreKey
 	AccountKeeper types.AccountKeeper
+
+	// DenomResolver converts fees paid in other denoms to the fee denom. If it is not
+	// provided, the conversion table of the module is used as the resolver, see
+	// keeper.ConversionTableResolver.
+	DenomResolver types.DenomResolver `optional:"true"`
+
+	// BankKeeper is used by the default resolver to look up denom metadata. If neither
+	// a DenomResolver nor a BankKeeper is provided, only the fee denom can be used to
+	// pay fees.
+	BankKeeper types.BankKeeper `optional:"true"`
 }
 
 type Outputs struct {
@@ -206,11 +224,15 @@ func ProvideModule(in Inputs) Outputs {
 		in.Cdc,
 		in.Key,
 		in.AccountKeeper,
-		nil,
+		in.DenomResolver,
 		authority.String(),
 		feeRecipientModule,
 	)
 
+	if in.DenomResolver == nil && in.BankKeeper != nil {
+		Keeper.SetDenomResolver(keeper.NewConversionTableResolver(Keeper, in.BankKeeper))
+	}
+
 	m := NewAppModule(in.Cdc, *Keeper)
 
 	return Outputs{Keeper: *Keeper, Module: m}
@@ -0,0 +1,75 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	modulev1 "github.com/skip-mev/feemarket/api/feemarket/feemarket/module/v1"
+	"github.com/skip-mev/feemarket/x/feemarket"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func TestProvideModule(t *testing.T) {
+	setup := func(t *testing.T, resolver types.DenomResolver, bankKeeper types.BankKeeper) (sdk.Context, feemarket.Outputs) {
+		t.Helper()
+
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+		accountKeeper := mocks.NewAccountKeeper(t)
+		accountKeeper.On("GetModuleAddress", authtypes.FeeCollectorName).
+			Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+
+		out := feemarket.ProvideModule(feemarket.Inputs{
+			Config:        &modulev1.Module{},
+			Cdc:           moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{}).Codec,
+			Key:           key,
+			AccountKeeper: accountKeeper,
+			DenomResolver: resolver,
+			BankKeeper:    bankKeeper,
+		})
+		require.NoError(t, out.Keeper.SetParams(ctx, types.DefaultParams()))
+
+		return ctx, out
+	}
+
+	t.Run("uses the conversion table by default", func(t *testing.T) {
+		bankKeeper := mocks.NewBankKeeper(t)
+		bankKeeper.On("GetDenomMetaData", mock.Anything, mock.Anything).Return(banktypes.Metadata{}, false)
+
+		ctx, out := setup(t, nil, bankKeeper)
+		require.NoError(t, out.Keeper.SetDenomConversion(ctx, types.NewDenomConversion("atom", math.LegacyNewDec(2))))
+
+		got, err := out.Keeper.ResolveToDenom(ctx, sdk.NewDecCoin("atom", math.NewInt(10)), types.DefaultFeeDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin(types.DefaultFeeDenom, math.NewInt(20)), got)
+
+		_, err = out.Keeper.ResolveToDenom(ctx, sdk.NewDecCoin("osmo", math.NewInt(10)), types.DefaultFeeDenom)
+		require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+	})
+
+	t.Run("uses the provided resolver", func(t *testing.T) {
+		ctx, out := setup(t, &types.TestDenomResolver{}, mocks.NewBankKeeper(t))
+
+		got, err := out.Keeper.ResolveToDenom(ctx, sdk.NewDecCoin("osmo", math.NewInt(10)), types.DefaultFeeDenom)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin(types.DefaultFeeDenom, math.NewInt(10)), got)
+	})
+
+	t.Run("has no resolver without a bank keeper", func(t *testing.T) {
+		ctx, out := setup(t, nil, nil)
+
+		_, err := out.Keeper.ResolveToDenom(ctx, sdk.NewDecCoin("atom", math.NewInt(10)), types.DefaultFeeDenom)
+		require.ErrorIs(t, err, types.ErrResolverNotSet)
+	})
+}
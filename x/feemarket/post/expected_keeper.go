@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -31,6 +32,23 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the contract needed to fund the community pool and to
+// allocate tips to validators.
+//
+//go:generate mockery --name DistributionKeeper --filename mock_distribution_keeper.go
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
+}
+
+// StakingKeeper defines the contract needed to resolve the block proposer.
+//
+//go:generate mockery --name StakingKeeper --filename mock_staking_keeper.go
+type StakingKeeper interface {
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
 }
 
 // FeeMarketKeeper defines the expected feemarket keeper.
@@ -43,6 +61,8 @@ type FeeMarketKeeper interface {
 	SetState(ctx sdk.Context, state feemarkettypes.State) error
 	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
 	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	GetMinBytePrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
 	GetEnabledHeight(ctx sdk.Context) (int64, error)
 	GetFeeRecipientModule() string
+	AddBurnedFees(ctx sdk.Context, coins sdk.Coins) error
 }
@@ -2,6 +2,7 @@ package post
 
 import (
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 
@@ -9,6 +10,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
@@ -24,21 +27,38 @@ const BankSendGasConsumption = 12490
 // Call next PostHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface
 type FeeMarketDeductDecorator struct {
-	accountKeeper   AccountKeeper
-	bankKeeper      BankKeeper
-	feemarketKeeper FeeMarketKeeper
+	accountKeeper      AccountKeeper
+	bankKeeper         BankKeeper
+	feemarketKeeper    FeeMarketKeeper
+	distributionKeeper DistributionKeeper
+	stakingKeeper      StakingKeeper
 }
 
-func NewFeeMarketDeductDecorator(ak AccountKeeper, bk BankKeeper, fmk FeeMarketKeeper) FeeMarketDeductDecorator {
+// NewFeeMarketDeductDecorator returns a new FeeMarketDeductDecorator. The distribution keeper
+// is only used to fund the community pool and to share tips with delegators, and may be nil
+// if neither is configured. The staking keeper resolves the block proposer to its validator.
+// If it is nil, tips for the block proposer are sent to the fee recipient module.
+func NewFeeMarketDeductDecorator(
+	ak AccountKeeper,
+	bk BankKeeper,
+	fmk FeeMarketKeeper,
+	dk DistributionKeeper,
+	sk StakingKeeper,
+) FeeMarketDeductDecorator {
 	return FeeMarketDeductDecorator{
-		accountKeeper:   ak,
-		bankKeeper:      bk,
-		feemarketKeeper: fmk,
+		accountKeeper:      ak,
+		bankKeeper:         bk,
+		feemarketKeeper:    fmk,
+		distributionKeeper: dk,
+		stakingKeeper:      sk,
 	}
 }
 
 // PostHandle deducts the fee from the fee payer based on the min base fee and the gas consumed in the gasmeter.
-// If there is a difference between the provided fee and the min-base fee, the difference is paid as a tip.
+// If there is a difference between the provided fee and the min-base fee, the difference is paid as a tip,
+// except for the fee paid for unused gas, which is handled according to the unused gas policy.
+// If the tx has a dynamic fee extension option, the tip is capped by the option instead and the
+// rest of the fee is refunded.
 // Fees are sent to the x/feemarket fee-collector address.
 func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
 	// GenTx consume no fee
@@ -82,16 +102,100 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(err, "unable to get fee market state")
 	}
 
-	feeCoins := feeTx.GetFee()
 	gas := ctx.GasMeter().GasConsumed() // use context gas consumed
 
+	feePayer := sdk.AccAddress(feeTx.FeePayer()).String()
+	if params.IsTxFeeExempt(state, feePayer, tx.GetMsgs(), feeTx.GetGas()) {
+		if err := dfd.UpdateExemptState(ctx, state, params, gas); err != nil {
+			return ctx, err
+		}
+
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeCoins := feeTx.GetFee()
+
 	if len(feeCoins) == 0 && !simulate {
 		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
-	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+
+	feeGas := int64(feeTx.GetGas())
+	txBytes := uint64(len(ctx.TxBytes()))
+	feeMultiplier := params.GetTxFeeMultiplier(tx.GetMsgs())
+
+	dynamicFee, err := feemarkettypes.GetDynamicFeeExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	var fees, tips, refund sdk.Coins
+	if len(feeCoins) > 1 && !simulate {
+		if dynamicFee != nil {
+			return ctx, feemarkettypes.ErrInvalidDynamicFeeOption.Wrap("dynamic fee extension option requires a single fee coin")
+		}
+
+		fees, tips, refund, err = dfd.splitFeeCoins(ctx, params, feeCoins, feeGas, txBytes, feeMultiplier)
+	} else {
+		fees, tips, refund, err = dfd.splitFeeCoin(ctx, params, feeCoins, gas, feeGas, txBytes, feeMultiplier, dynamicFee, simulate)
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	ctx.Logger().Info("fee deduct post handle",
+		"fee", fees,
+		"tip", tips,
+		"refund", refund,
+	)
+
+	if err := dfd.PayOutFeesAndTips(ctx, fees, tips); err != nil {
+		return ctx, err
+	}
+
+	if err := dfd.RefundFee(ctx, feeTx, refund); err != nil {
+		return ctx, err
+	}
+
+	err = state.Update(gas, params)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
+	}
+
+	err = state.UpdateBytes(txBytes, params)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
+	}
+
+	err = dfd.feemarketKeeper.SetState(ctx, state)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to set fee market state")
+	}
+
+	if simulate {
+		// consume the gas that would be consumed during normal execution
+		ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation send gas consumption")
+
+		if dynamicFee != nil || params.RefundsUnusedGas() {
+			ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation refund gas consumption")
+		}
 	}
 
+	return next(ctx, tx, simulate, success)
+}
+
+// splitFeeCoin splits the single fee coin of a tx into the fee, tip and refund. If simulating,
+// the provided fee is ignored and a zero fee in the fee denom is used instead.
+func (dfd FeeMarketDeductDecorator) splitFeeCoin(
+	ctx sdk.Context,
+	params feemarkettypes.Params,
+	feeCoins sdk.Coins,
+	gas uint64,
+	feeGas int64,
+	txBytes uint64,
+	feeMultiplier math.LegacyDec,
+	dynamicFee *feemarkettypes.ExtensionOptionDynamicFee,
+	simulate bool,
+) (sdk.Coins, sdk.Coins, sdk.Coins, error) {
 	// if simulating and user did not provider a fee - create a dummy value for them
 	var (
 		tip     = sdk.NewCoin(params.FeeDenom, math.ZeroInt())
@@ -101,55 +205,159 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 		payCoin = feeCoins[0]
 	}
 
-	feeGas := int64(feeTx.GetGas())
-
 	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, payCoin.GetDenom())
 	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+		return nil, nil, nil, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+	}
+
+	minBytePrice := sdk.NewDecCoin(payCoin.GetDenom(), math.ZeroInt())
+	if params.ByteDimensionEnabled() {
+		minBytePrice, err = dfd.feemarketKeeper.GetMinBytePrice(ctx, payCoin.GetDenom())
+		if err != nil {
+			return nil, nil, nil, errorsmod.Wrapf(err, "unable to get min byte price for denom %s", payCoin.GetDenom())
+		}
 	}
 
 	ctx.Logger().Info("fee deduct post handle",
 		"min gas prices", minGasPrice,
+		"min byte price", minBytePrice,
 		"gas consumed", gas,
+		"tx bytes", txBytes,
+		"fee multiplier", feeMultiplier,
 	)
 
+	refund := sdk.NewCoin(payCoin.GetDenom(), math.ZeroInt())
 	if !simulate {
-		payCoin, tip, err = ante.CheckTxFee(ctx, minGasPrice, payCoin, feeGas, false)
+		feeCoin := payCoin
+
+		payCoin, tip, err = ante.CheckTxFee(ctx, minGasPrice, minBytePrice, feeCoin, feeGas, int64(txBytes), feeMultiplier, false)
 		if err != nil {
-			return ctx, err
+			return nil, nil, nil, err
+		}
+
+		switch {
+		case dynamicFee != nil:
+			baseGasPrice := ante.EffectiveBaseGasPrice(minGasPrice, minBytePrice, int64(gas), int64(txBytes), feeMultiplier)
+			payCoin, tip, refund = ApplyDynamicFee(dynamicFee, baseGasPrice, gas, payCoin, tip)
+		case params.RefundsUnusedGas():
+			// the fee required for the gas limit, the difference to the fee for the consumed gas
+			// is the fee paid for unused gas
+			requiredFee, _, err := ante.CheckTxFee(ctx, minGasPrice, minBytePrice, feeCoin, feeGas, int64(txBytes), feeMultiplier, true)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			payCoin, tip, refund = ApplyUnusedGasPolicy(params, requiredFee, payCoin, tip)
 		}
 	}
 
-	ctx.Logger().Info("fee deduct post handle",
-		"fee", payCoin,
-		"tip", tip,
-	)
+	return sdk.Coins{payCoin}, sdk.Coins{tip}, sdk.NewCoins(refund), nil
+}
 
-	if err := dfd.PayOutFeeAndTip(ctx, payCoin, tip); err != nil {
-		return ctx, err
+// splitFeeCoins splits multiple fee coins of a tx into the fee, tip and refund. The fee and
+// tip are determined from the value of all fee coins in the fee denom and then charged from
+// the fee coins by SplitFeeCoins.
+func (dfd FeeMarketDeductDecorator) splitFeeCoins(
+	ctx sdk.Context,
+	params feemarkettypes.Params,
+	feeCoins sdk.Coins,
+	feeGas int64,
+	txBytes uint64,
+	feeMultiplier math.LegacyDec,
+) (sdk.Coins, sdk.Coins, sdk.Coins, error) {
+	values, totalValue, err := ante.GetFeeCoinsValue(ctx, dfd.feemarketKeeper, feeCoins, params.FeeDenom)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	err = state.Update(gas, params)
+	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, params.FeeDenom)
 	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
+		return nil, nil, nil, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", params.FeeDenom)
 	}
 
-	err = dfd.feemarketKeeper.SetState(ctx, state)
+	minBytePrice := sdk.NewDecCoin(params.FeeDenom, math.ZeroInt())
+	if params.ByteDimensionEnabled() {
+		minBytePrice, err = dfd.feemarketKeeper.GetMinBytePrice(ctx, params.FeeDenom)
+		if err != nil {
+			return nil, nil, nil, errorsmod.Wrapf(err, "unable to get min byte price for denom %s", params.FeeDenom)
+		}
+	}
+
+	ctx.Logger().Info("fee deduct post handle",
+		"min gas prices", minGasPrice,
+		"min byte price", minBytePrice,
+		"fee coins", feeCoins,
+		"fee coins value", totalValue,
+		"tx bytes", txBytes,
+		"fee multiplier", feeMultiplier,
+	)
+
+	feeValue, tipValue, err := ante.CheckTxFee(ctx, minGasPrice, minBytePrice, totalValue, feeGas, int64(txBytes), feeMultiplier, false)
 	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to set fee market state")
+		return nil, nil, nil, err
 	}
 
-	if simulate {
-		// consume the gas that would be consumed during normal execution
-		ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation send gas consumption")
+	if params.RefundsUnusedGas() {
+		requiredFee, _, err := ante.CheckTxFee(ctx, minGasPrice, minBytePrice, totalValue, feeGas, int64(txBytes), feeMultiplier, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		feeValue, tipValue, _ = ApplyUnusedGasPolicy(params, requiredFee, feeValue, tipValue)
 	}
 
-	return next(ctx, tx, simulate, success)
+	fees, tips, refund := SplitFeeCoins(feeCoins, values, feeValue.Amount, tipValue.Amount)
+	return fees, tips, refund, nil
+}
+
+// UpdateExemptState counts the gas and bytes of a fee exempt tx towards the block utilization
+// and the exempt gas budget of the block, without deducting a fee.
+func (dfd FeeMarketDeductDecorator) UpdateExemptState(ctx sdk.Context, state feemarkettypes.State, params feemarkettypes.Params, gas uint64) error {
+	txBytes := uint64(len(ctx.TxBytes()))
+
+	ctx.Logger().Info("fee exempt post handle",
+		"gas consumed", gas,
+		"tx bytes", txBytes,
+	)
+
+	if err := state.Update(gas, params); err != nil {
+		return errorsmod.Wrapf(err, "unable to update fee market state")
+	}
+
+	if err := state.UpdateBytes(txBytes, params); err != nil {
+		return errorsmod.Wrapf(err, "unable to update fee market state")
+	}
+
+	state.ExemptGas += gas
+	if err := dfd.feemarketKeeper.SetState(ctx, state); err != nil {
+		return errorsmod.Wrapf(err, "unable to set fee market state")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		feemarkettypes.EventTypeFeeExempt,
+		sdk.NewAttribute(feemarkettypes.AttributeKeyExemptGas, fmt.Sprint(gas)),
+	))
+
+	return nil
 }
 
 // PayOutFeeAndTip deducts the provided fee and tip from the fee payer.
 // If the tx uses a feegranter, the fee granter address will pay the fee instead of the tx signer.
 func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sdk.Coin) error {
+	var fees, tips sdk.Coins
+	if !fee.IsNil() {
+		fees = sdk.Coins{fee}
+	}
+	if !tip.IsNil() {
+		tips = sdk.Coins{tip}
+	}
+
+	return dfd.PayOutFeesAndTips(ctx, fees, tips)
+}
+
+// PayOutFeesAndTips pays out the provided fees and tips from the fee collector account, which
+// holds the fees escrowed by the ante handler. Empty fees or tips are skipped.
+func (dfd FeeMarketDeductDecorator) PayOutFeesAndTips(ctx sdk.Context, fee, tip sdk.Coins) error {
 	params, err := dfd.feemarketKeeper.GetParams(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting feemarket params: %v", err)
@@ -160,10 +368,27 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 	feeRecipientModule := dfd.feemarketKeeper.GetFeeRecipientModule()
 
 	// deduct the fees and tip
-	if !fee.IsNil() {
-		err := DeductCoins(dfd.bankKeeper, ctx, sdk.NewCoins(fee), feeRecipientModule, params.DistributeFees)
-		if err != nil {
-			return err
+	if len(fee) > 0 {
+		switch {
+		case params.FeeSplit != nil:
+			splitEvents, err := dfd.payOutSplitFee(ctx, *params.FeeSplit, sdk.NewCoins(fee...), feeRecipientModule)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, splitEvents...)
+		case params.BurnFees:
+			burnEvent, err := dfd.burnFee(ctx, sdk.NewCoins(fee...))
+			if err != nil {
+				return err
+			}
+
+			events = append(events, burnEvent)
+		default:
+			err := DeductCoins(dfd.bankKeeper, ctx, sdk.NewCoins(fee...), feeRecipientModule, params.DistributeFees)
+			if err != nil {
+				return err
+			}
 		}
 
 		events = append(events, sdk.NewEvent(
@@ -172,30 +397,348 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 		))
 	}
 
-	proposer := sdk.AccAddress(ctx.BlockHeader().ProposerAddress)
-	if !tip.IsNil() {
-		tipPayee := proposer.String()
+	if len(tip) > 0 {
+		if params.TipSplit != nil {
+			splitEvents, err := dfd.payOutSplitTip(ctx, params, sdk.NewCoins(tip...), feeRecipientModule)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, splitEvents...)
+		} else {
+			tipPayee := dfd.accountKeeper.GetModuleAddress(feeRecipientModule).String()
+
+			if params.SendTipToProposer {
+				tipPayee, err = dfd.payTipToProposer(ctx, params, sdk.NewCoins(tip...), feeRecipientModule)
+			} else {
+				err = SendTip(dfd.bankKeeper, ctx, false, feeRecipientModule, nil, sdk.NewCoins(tip...))
+			}
+			if err != nil {
+				return err
+			}
+
+			events = append(events, sdk.NewEvent(
+				feemarkettypes.EventTypeTipPay,
+				sdk.NewAttribute(feemarkettypes.AttributeKeyTip, tip.String()),
+				sdk.NewAttribute(feemarkettypes.AttributeKeyTipPayee, tipPayee),
+			))
+		}
+	}
 
-		if !params.SendTipToProposer {
-			tipPayee = dfd.accountKeeper.GetModuleAddress(feeRecipientModule).String()
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// payOutSplitFee burns the fee and sends it to the community pool and the fee recipient
+// module according to the fee split. An event is returned for each destination that
+// receives a non-zero share.
+func (dfd FeeMarketDeductDecorator) payOutSplitFee(
+	ctx sdk.Context,
+	split feemarkettypes.FeeSplit,
+	fee sdk.Coins,
+	feeRecipientModule string,
+) (sdk.Events, error) {
+	var events sdk.Events
+
+	burn, communityPool, recipient := split.Split(fee)
+
+	if !burn.IsZero() {
+		burnEvent, err := dfd.burnFee(ctx, burn)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, burnEvent)
+	}
+
+	if !communityPool.IsZero() {
+		if dfd.distributionKeeper == nil {
+			return nil, fmt.Errorf("distribution keeper is required to fund the community pool")
+		}
+
+		sender := dfd.accountKeeper.GetModuleAddress(feemarkettypes.FeeCollectorName)
+		if err := dfd.distributionKeeper.FundCommunityPool(ctx, communityPool, sender); err != nil {
+			return nil, err
 		}
 
-		err := SendTip(dfd.bankKeeper, ctx, params.SendTipToProposer, feeRecipientModule, proposer, sdk.NewCoins(tip))
+		events = append(events, sdk.NewEvent(
+			feemarkettypes.EventTypeFeeCommunityPool,
+			sdk.NewAttribute(sdk.AttributeKeyFee, communityPool.String()),
+		))
+	}
+
+	if !recipient.IsZero() {
+		if err := DeductCoins(dfd.bankKeeper, ctx, recipient, feeRecipientModule, true); err != nil {
+			return nil, err
+		}
+
+		events = append(events, sdk.NewEvent(
+			feemarkettypes.EventTypeFeeDistribute,
+			sdk.NewAttribute(sdk.AttributeKeyFee, recipient.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyFeeRecipient, dfd.accountKeeper.GetModuleAddress(feeRecipientModule).String()),
+		))
+	}
+
+	return events, nil
+}
+
+// payOutSplitTip sends the tip to the block proposer and the fee recipient module
+// according to the tip split. An event is returned for each destination that receives
+// a non-zero share.
+func (dfd FeeMarketDeductDecorator) payOutSplitTip(
+	ctx sdk.Context,
+	params feemarkettypes.Params,
+	tip sdk.Coins,
+	feeRecipientModule string,
+) (sdk.Events, error) {
+	var events sdk.Events
+
+	proposerTip, recipientTip := params.TipSplit.Split(tip)
+
+	if !proposerTip.IsZero() {
+		tipPayee, err := dfd.payTipToProposer(ctx, params, proposerTip, feeRecipientModule)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		events = append(events, sdk.NewEvent(
 			feemarkettypes.EventTypeTipPay,
-			sdk.NewAttribute(feemarkettypes.AttributeKeyTip, tip.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyTip, proposerTip.String()),
 			sdk.NewAttribute(feemarkettypes.AttributeKeyTipPayee, tipPayee),
 		))
 	}
 
-	ctx.EventManager().EmitEvents(events)
+	if !recipientTip.IsZero() {
+		if err := SendTip(dfd.bankKeeper, ctx, false, feeRecipientModule, nil, recipientTip); err != nil {
+			return nil, err
+		}
+
+		events = append(events, sdk.NewEvent(
+			feemarkettypes.EventTypeTipPay,
+			sdk.NewAttribute(feemarkettypes.AttributeKeyTip, recipientTip.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyTipPayee, dfd.accountKeeper.GetModuleAddress(feeRecipientModule).String()),
+		))
+	}
+
+	return events, nil
+}
+
+// payTipToProposer pays the tip to the validator that proposed the block, which is resolved
+// from the consensus address of the proposer. If DistributeTipsToDelegators is set, the tip is
+// allocated to the validator through the distribution module and shared with its delegators.
+// Otherwise, it is sent to the operator account of the validator. If the proposer cannot be
+// resolved, the tip is sent to the fee recipient module. The address of the payee is returned.
+func (dfd FeeMarketDeductDecorator) payTipToProposer(
+	ctx sdk.Context,
+	params feemarkettypes.Params,
+	tip sdk.Coins,
+	feeRecipientModule string,
+) (string, error) {
+	validator, operator, err := dfd.getProposer(ctx)
+	if err != nil {
+		ctx.Logger().Info("unable to resolve block proposer, sending tip to fee recipient module",
+			"err", err,
+		)
+
+		if err := SendTip(dfd.bankKeeper, ctx, false, feeRecipientModule, nil, tip); err != nil {
+			return "", err
+		}
+
+		return dfd.accountKeeper.GetModuleAddress(feeRecipientModule).String(), nil
+	}
+
+	if !params.DistributeTipsToDelegators {
+		if err := SendTip(dfd.bankKeeper, ctx, true, feeRecipientModule, operator, tip); err != nil {
+			return "", err
+		}
+
+		return operator.String(), nil
+	}
+
+	if dfd.distributionKeeper == nil {
+		return "", fmt.Errorf("distribution keeper is required to distribute tips to delegators")
+	}
+
+	if err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feemarkettypes.FeeCollectorName, distrtypes.ModuleName, tip); err != nil {
+		return "", err
+	}
+
+	if err := dfd.distributionKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(tip...)); err != nil {
+		return "", err
+	}
+
+	return validator.GetOperator(), nil
+}
+
+// getProposer returns the validator that proposed the current block and its operator account.
+func (dfd FeeMarketDeductDecorator) getProposer(ctx sdk.Context) (stakingtypes.Validator, sdk.AccAddress, error) {
+	if dfd.stakingKeeper == nil {
+		return stakingtypes.Validator{}, nil, fmt.Errorf("staking keeper is not set")
+	}
+
+	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	validator, err := dfd.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, nil, fmt.Errorf("unable to get validator for proposer %s: %w", consAddr, err)
+	}
+
+	operator, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return stakingtypes.Validator{}, nil, fmt.Errorf("invalid operator address %s: %w", validator.GetOperator(), err)
+	}
+
+	return validator, sdk.AccAddress(operator), nil
+}
+
+// RefundFee refunds the part of the escrowed fee that is not charged from the fee collector
+// account to the fee granter of the tx or, if there is none, to the fee payer.
+func (dfd FeeMarketDeductDecorator) RefundFee(ctx sdk.Context, feeTx sdk.FeeTx, refund sdk.Coins) error {
+	refund = sdk.NewCoins(refund...)
+	if refund.IsZero() {
+		return nil
+	}
+
+	refundee := sdk.AccAddress(feeTx.FeePayer())
+	if granter := feeTx.FeeGranter(); granter != nil {
+		refundee = granter
+	}
+
+	if err := dfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.FeeCollectorName, refundee, refund); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		feemarkettypes.EventTypeFeeRefund,
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefundee, refundee.String()),
+	))
+
 	return nil
 }
 
+// burnFee burns the given fee from the fee collector account and adds it to the total
+// burned fees.
+func (dfd FeeMarketDeductDecorator) burnFee(ctx sdk.Context, fee sdk.Coins) (sdk.Event, error) {
+	if err := BurnCoins(dfd.bankKeeper, ctx, fee); err != nil {
+		return sdk.Event{}, err
+	}
+
+	if err := dfd.feemarketKeeper.AddBurnedFees(ctx, fee); err != nil {
+		return sdk.Event{}, err
+	}
+
+	return sdk.NewEvent(
+		feemarkettypes.EventTypeFeeBurn,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	), nil
+}
+
+// ApplyUnusedGasPolicy applies the unused gas policy of the params to the fee and tip of a
+// tx, where requiredFee is the fee required for the gas limit of the tx. The fee paid for
+// unused gas is taken out of the tip and split into a refund, a penalty that is added to the
+// fee and a remaining tip. The new fee, tip and refund are returned.
+func ApplyUnusedGasPolicy(params feemarkettypes.Params, requiredFee, fee, tip sdk.Coin) (sdk.Coin, sdk.Coin, sdk.Coin) {
+	unusedFee := requiredFee.Amount.Sub(fee.Amount)
+	if !unusedFee.IsPositive() {
+		return fee, tip, sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+
+	refund, penalty, unusedTip := params.SplitUnusedGasFee(unusedFee)
+
+	return fee.AddAmount(penalty), tip.SubAmount(unusedFee).AddAmount(unusedTip), sdk.NewCoin(fee.Denom, refund)
+}
+
+// ApplyDynamicFee applies a dynamic fee extension option to the fee and tip of a tx, where
+// fee is the fee for the gas consumed and baseGasPrice is the effective base gas price of the
+// tx for the gas consumed, see ante.EffectiveBaseGasPrice. The fee and tip together are capped
+// at the effective gas price of the option times the gas consumed, and the rest of the escrowed
+// fee is refunded. The fee itself is always charged in full. The new fee, tip and refund are
+// returned.
+func ApplyDynamicFee(
+	option *feemarkettypes.ExtensionOptionDynamicFee,
+	baseGasPrice sdk.DecCoin,
+	gasConsumed uint64,
+	fee, tip sdk.Coin,
+) (sdk.Coin, sdk.Coin, sdk.Coin) {
+	maxCharge := option.EffectiveGasPrice(baseGasPrice.Amount).MulInt(math.NewIntFromUint64(gasConsumed)).TruncateInt()
+	maxTip := math.MaxInt(maxCharge.Sub(fee.Amount), math.ZeroInt())
+	if !tip.Amount.GT(maxTip) {
+		return fee, tip, sdk.NewCoin(fee.Denom, math.ZeroInt())
+	}
+
+	return fee, sdk.NewCoin(tip.Denom, maxTip), tip.SubAmount(maxTip)
+}
+
+// SplitFeeCoins charges a fee and a tip, both given as amounts in the fee denom, from the fee
+// coins of a tx, where values holds the value of each fee coin in the fee denom. The coins are
+// charged in ascending order of their value, ties broken by denom, so the payer's smallest
+// holdings are spent first. A coin is charged partially by the share of its value that is
+// still needed, rounded up. Whatever is not charged as fee or tip is refunded.
+func SplitFeeCoins(feeCoins sdk.Coins, values []math.Int, feeValue, tipValue math.Int) (sdk.Coins, sdk.Coins, sdk.Coins) {
+	order := make([]int, len(feeCoins))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		vi, vj := values[order[i]], values[order[j]]
+		if !vi.Equal(vj) {
+			return vi.LT(vj)
+		}
+
+		return feeCoins[order[i]].Denom < feeCoins[order[j]].Denom
+	})
+
+	var (
+		fees, tips, refund sdk.Coins
+		remaining          = make([]math.Int, len(feeCoins))
+	)
+	for i, coin := range feeCoins {
+		remaining[i] = coin.Amount
+	}
+
+	// charge takes the given value from the fee coins in order and returns the charged coins
+	charge := func(value math.Int) sdk.Coins {
+		var charged sdk.Coins
+		for _, i := range order {
+			if !value.IsPositive() {
+				break
+			}
+
+			coinValue := values[i]
+			if !coinValue.IsPositive() || !remaining[i].IsPositive() {
+				continue
+			}
+
+			// the value of the remaining amount of the coin
+			remainingValue := coinValue.Mul(remaining[i]).Quo(feeCoins[i].Amount)
+
+			amount := remaining[i]
+			if remainingValue.GT(value) {
+				amount = math.LegacyNewDecFromInt(value).MulInt(feeCoins[i].Amount).QuoInt(coinValue).Ceil().TruncateInt()
+				amount = math.MinInt(amount, remaining[i])
+				value = math.ZeroInt()
+			} else {
+				value = value.Sub(remainingValue)
+			}
+
+			remaining[i] = remaining[i].Sub(amount)
+			charged = charged.Add(sdk.NewCoin(feeCoins[i].Denom, amount))
+		}
+
+		return charged
+	}
+
+	fees = charge(feeValue)
+	tips = charge(tipValue)
+
+	for i, coin := range feeCoins {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, remaining[i]))
+	}
+
+	return fees, tips, refund
+}
+
 // DeductCoins deducts coins from the given account.
 // Coins can be sent to the module account (causes coins to be distributed to stakers),
 // or kept in the fee collector account (soft burn).
@@ -209,7 +752,17 @@ func DeductCoins(bankKeeper BankKeeper, ctx sdk.Context, coins sdk.Coins, feeRec
 	return nil
 }
 
-// SendTip sends a tip to the current block proposer or to the module account.
+// BurnCoins burns the given coins from the fee collector account. The fee collector
+// module account must have the Burner permission.
+func BurnCoins(bankKeeper BankKeeper, ctx sdk.Context, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+
+	return bankKeeper.BurnCoins(ctx, feemarkettypes.FeeCollectorName, coins)
+}
+
+// SendTip sends a tip to the given proposer account or to the module account.
 func SendTip(
 	bankKeeper BankKeeper,
 	ctx sdk.Context,
@@ -5,15 +5,22 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/feemarket/x/feemarket/ante"
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/post"
+	postmocks "github.com/skip-mev/feemarket/x/feemarket/post/mocks"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -84,6 +91,405 @@ func TestDeductCoins(t *testing.T) {
 	}
 }
 
+func TestBurnCoins(t *testing.T) {
+	tests := []struct {
+		name    string
+		coins   sdk.Coins
+		burn    bool
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			coins:   sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10))),
+			burn:    true,
+			wantErr: false,
+		},
+		{
+			name:    "valid no coins",
+			coins:   sdk.NewCoins(),
+			burn:    false,
+			wantErr: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			s := antesuite.SetupTestSuite(t, true)
+			if tc.burn {
+				s.MockBankKeeper.On("BurnCoins", s.Ctx, types.FeeCollectorName, tc.coins).Return(nil).Once()
+			}
+
+			if err := post.BurnCoins(s.MockBankKeeper, s.Ctx, tc.coins); (err != nil) != tc.wantErr {
+				s.Errorf(err, "BurnCoins() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestPayOutFeeAndTipSplit(t *testing.T) {
+	s := antesuite.SetupTestSuite(t, true)
+	dk := postmocks.NewDistributionKeeper(t)
+	sk := postmocks.NewStakingKeeper(t)
+
+	params := types.DefaultParams()
+	feeSplit := types.NewFeeSplit(5000, 3000, 2000)
+	tipSplit := types.NewTipSplit(7000, 3000)
+	params.FeeSplit = &feeSplit
+	params.TipSplit = &tipSplit
+	s.Require().NoError(s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	recipientModule := s.FeeMarketKeeper.GetFeeRecipientModule()
+	recipientAddress := s.AccountKeeper.GetModuleAddress(recipientModule)
+	proposer := sdk.ConsAddress("proposer")
+	operator := sdk.ValAddress("operator")
+	ctx := s.Ctx.WithBlockHeader(cmtproto.Header{ProposerAddress: proposer}).WithEventManager(sdk.NewEventManager())
+
+	sk.On("GetValidatorByConsAddr", mock.Anything, proposer).
+		Return(stakingtypes.Validator{OperatorAddress: operator.String()}, nil).Once()
+
+	s.MockBankKeeper.On("BurnCoins", mock.Anything, types.FeeCollectorName,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 500))).Return(nil).Once()
+	dk.On("FundCommunityPool", mock.Anything, sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+		s.AccountKeeper.GetModuleAddress(types.FeeCollectorName)).Return(nil).Once()
+	s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, recipientModule,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil).Once()
+	s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, sdk.AccAddress(operator),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 70))).Return(nil).Once()
+	s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, recipientModule,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Return(nil).Once()
+
+	dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.MockBankKeeper, s.FeeMarketKeeper, dk, sk)
+	err := dfd.PayOutFeeAndTip(ctx, sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("stake", 100))
+	s.Require().NoError(err)
+
+	burned, err := s.FeeMarketKeeper.GetBurnedFees(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), burned)
+
+	events := ctx.EventManager().Events()
+	s.Require().Contains(events, sdk.NewEvent(types.EventTypeFeeBurn,
+		sdk.NewAttribute(sdk.AttributeKeyFee, "500stake"),
+	))
+	s.Require().Contains(events, sdk.NewEvent(types.EventTypeFeeCommunityPool,
+		sdk.NewAttribute(sdk.AttributeKeyFee, "300stake"),
+	))
+	s.Require().Contains(events, sdk.NewEvent(types.EventTypeFeeDistribute,
+		sdk.NewAttribute(sdk.AttributeKeyFee, "200stake"),
+		sdk.NewAttribute(types.AttributeKeyFeeRecipient, recipientAddress.String()),
+	))
+	s.Require().Contains(events, sdk.NewEvent(types.EventTypeTipPay,
+		sdk.NewAttribute(types.AttributeKeyTip, "70stake"),
+		sdk.NewAttribute(types.AttributeKeyTipPayee, sdk.AccAddress(operator).String()),
+	))
+	s.Require().Contains(events, sdk.NewEvent(types.EventTypeTipPay,
+		sdk.NewAttribute(types.AttributeKeyTip, "30stake"),
+		sdk.NewAttribute(types.AttributeKeyTipPayee, recipientAddress.String()),
+	))
+}
+
+func TestPayOutTipToProposer(t *testing.T) {
+	proposer := sdk.ConsAddress("proposer")
+	operator := sdk.ValAddress("operator")
+	validator := stakingtypes.Validator{OperatorAddress: operator.String()}
+	tip := sdk.NewInt64Coin("stake", 100)
+
+	testCases := []struct {
+		name                       string
+		distributeTipsToDelegators bool
+		malleate                   func(s *antesuite.TestSuite, dk *postmocks.DistributionKeeper, sk *postmocks.StakingKeeper)
+		expectedPayee              func(s *antesuite.TestSuite) string
+	}{
+		{
+			name: "tip is sent to the operator account of the proposer",
+			malleate: func(s *antesuite.TestSuite, _ *postmocks.DistributionKeeper, sk *postmocks.StakingKeeper) {
+				sk.On("GetValidatorByConsAddr", mock.Anything, proposer).Return(validator, nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName,
+					sdk.AccAddress(operator), sdk.NewCoins(tip)).Return(nil).Once()
+			},
+			expectedPayee: func(_ *antesuite.TestSuite) string {
+				return sdk.AccAddress(operator).String()
+			},
+		},
+		{
+			name:                       "tip is shared with the delegators of the proposer",
+			distributeTipsToDelegators: true,
+			malleate: func(s *antesuite.TestSuite, dk *postmocks.DistributionKeeper, sk *postmocks.StakingKeeper) {
+				sk.On("GetValidatorByConsAddr", mock.Anything, proposer).Return(validator, nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName,
+					distrtypes.ModuleName, sdk.NewCoins(tip)).Return(nil).Once()
+				dk.On("AllocateTokensToValidator", mock.Anything, validator,
+					sdk.NewDecCoinsFromCoins(tip)).Return(nil).Once()
+			},
+			expectedPayee: func(_ *antesuite.TestSuite) string {
+				return operator.String()
+			},
+		},
+		{
+			name: "tip is sent to the fee recipient module if the proposer is unknown",
+			malleate: func(s *antesuite.TestSuite, _ *postmocks.DistributionKeeper, sk *postmocks.StakingKeeper) {
+				sk.On("GetValidatorByConsAddr", mock.Anything, proposer).
+					Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName,
+					s.FeeMarketKeeper.GetFeeRecipientModule(), sdk.NewCoins(tip)).Return(nil).Once()
+			},
+			expectedPayee: func(s *antesuite.TestSuite) string {
+				return s.AccountKeeper.GetModuleAddress(s.FeeMarketKeeper.GetFeeRecipientModule()).String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			s := antesuite.SetupTestSuite(t, true)
+			dk := postmocks.NewDistributionKeeper(t)
+			sk := postmocks.NewStakingKeeper(t)
+
+			params := types.DefaultParams()
+			params.DistributeTipsToDelegators = tc.distributeTipsToDelegators
+			s.Require().NoError(s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+			tc.malleate(s, dk, sk)
+
+			ctx := s.Ctx.WithBlockHeader(cmtproto.Header{ProposerAddress: proposer}).WithEventManager(sdk.NewEventManager())
+			dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.MockBankKeeper, s.FeeMarketKeeper, dk, sk)
+			s.Require().NoError(dfd.PayOutFeeAndTip(ctx, sdk.Coin{}, tip))
+
+			s.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(types.EventTypeTipPay,
+				sdk.NewAttribute(types.AttributeKeyTip, tip.String()),
+				sdk.NewAttribute(types.AttributeKeyTipPayee, tc.expectedPayee(s)),
+			))
+		})
+	}
+}
+
+func TestApplyUnusedGasPolicy(t *testing.T) {
+	requiredFee := sdk.NewInt64Coin("stake", 1000)
+	fee := sdk.NewInt64Coin("stake", 600)
+	tip := sdk.NewInt64Coin("stake", 500)
+
+	testCases := []struct {
+		name           string
+		policy         types.UnusedGasPolicy
+		penalty        math.LegacyDec
+		fee            sdk.Coin
+		expectedFee    sdk.Coin
+		expectedTip    sdk.Coin
+		expectedRefund sdk.Coin
+	}{
+		{
+			name:           "unused gas is paid as tip",
+			policy:         types.UnusedGasPolicy_UNUSED_GAS_POLICY_TIP,
+			fee:            fee,
+			expectedFee:    fee,
+			expectedTip:    tip,
+			expectedRefund: sdk.NewInt64Coin("stake", 0),
+		},
+		{
+			name:           "unspecified policy pays unused gas as tip",
+			policy:         types.UnusedGasPolicy_UNUSED_GAS_POLICY_UNSPECIFIED,
+			fee:            fee,
+			expectedFee:    fee,
+			expectedTip:    tip,
+			expectedRefund: sdk.NewInt64Coin("stake", 0),
+		},
+		{
+			name:           "unused gas is refunded",
+			policy:         types.UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND,
+			fee:            fee,
+			expectedFee:    fee,
+			expectedTip:    sdk.NewInt64Coin("stake", 100),
+			expectedRefund: sdk.NewInt64Coin("stake", 400),
+		},
+		{
+			name:           "part of the unused gas is charged as penalty",
+			policy:         types.UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY,
+			penalty:        math.LegacyMustNewDecFromStr("0.33"),
+			fee:            fee,
+			expectedFee:    sdk.NewInt64Coin("stake", 732),
+			expectedTip:    sdk.NewInt64Coin("stake", 100),
+			expectedRefund: sdk.NewInt64Coin("stake", 268),
+		},
+		{
+			name:           "no unused gas",
+			policy:         types.UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND,
+			fee:            requiredFee,
+			expectedFee:    requiredFee,
+			expectedTip:    tip,
+			expectedRefund: sdk.NewInt64Coin("stake", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.UnusedGasPolicy = tc.policy
+			if !tc.penalty.IsNil() {
+				params.UnusedGasPenalty = tc.penalty
+			}
+
+			gotFee, gotTip, gotRefund := post.ApplyUnusedGasPolicy(params, requiredFee, tc.fee, tip)
+			require.Equal(t, tc.expectedFee, gotFee)
+			require.Equal(t, tc.expectedTip, gotTip)
+			require.Equal(t, tc.expectedRefund, gotRefund)
+		})
+	}
+}
+
+func TestApplyDynamicFee(t *testing.T) {
+	baseGasPrice := sdk.NewDecCoin("stake", math.NewInt(2))
+
+	testCases := []struct {
+		name                 string
+		maxFeePerGas         math.LegacyDec
+		maxPriorityFeePerGas math.LegacyDec
+		tip                  sdk.Coin
+		expectedTip          sdk.Coin
+		expectedRefund       sdk.Coin
+	}{
+		{
+			name:                 "tip is capped at the max priority fee",
+			maxFeePerGas:         math.LegacyNewDec(10),
+			maxPriorityFeePerGas: math.LegacyNewDec(1),
+			tip:                  sdk.NewInt64Coin("stake", 800),
+			expectedTip:          sdk.NewInt64Coin("stake", 100),
+			expectedRefund:       sdk.NewInt64Coin("stake", 700),
+		},
+		{
+			name:                 "tip is capped at the max fee",
+			maxFeePerGas:         math.LegacyMustNewDecFromStr("2.5"),
+			maxPriorityFeePerGas: math.LegacyNewDec(1),
+			tip:                  sdk.NewInt64Coin("stake", 800),
+			expectedTip:          sdk.NewInt64Coin("stake", 50),
+			expectedRefund:       sdk.NewInt64Coin("stake", 750),
+		},
+		{
+			name:                 "no tip if the max fee equals the base gas price",
+			maxFeePerGas:         math.LegacyNewDec(2),
+			maxPriorityFeePerGas: math.LegacyNewDec(1),
+			tip:                  sdk.NewInt64Coin("stake", 800),
+			expectedTip:          sdk.NewInt64Coin("stake", 0),
+			expectedRefund:       sdk.NewInt64Coin("stake", 800),
+		},
+		{
+			name:                 "tip below the cap is kept",
+			maxFeePerGas:         math.LegacyNewDec(10),
+			maxPriorityFeePerGas: math.LegacyNewDec(5),
+			tip:                  sdk.NewInt64Coin("stake", 300),
+			expectedTip:          sdk.NewInt64Coin("stake", 300),
+			expectedRefund:       sdk.NewInt64Coin("stake", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			option := types.NewExtensionOptionDynamicFee(tc.maxFeePerGas, tc.maxPriorityFeePerGas)
+			fee := sdk.NewInt64Coin("stake", 200)
+
+			// 100 gas consumed
+			gotFee, gotTip, gotRefund := post.ApplyDynamicFee(option, baseGasPrice, 100, fee, tc.tip)
+			require.Equal(t, fee, gotFee)
+			require.Equal(t, tc.expectedTip, gotTip)
+			require.Equal(t, tc.expectedRefund, gotRefund)
+		})
+	}
+
+	t.Run("caps the fee and tip with a fee multiplier and byte price", func(t *testing.T) {
+		// (2 * 100 gas + 1 * 100 bytes) * 1.5 / 100 gas = 4.5
+		effectiveBaseGasPrice := ante.EffectiveBaseGasPrice(
+			baseGasPrice,
+			sdk.NewDecCoin("stake", math.NewInt(1)),
+			100,
+			100,
+			math.LegacyMustNewDecFromStr("1.5"),
+		)
+		fee := sdk.NewInt64Coin("stake", 450)
+		tip := sdk.NewInt64Coin("stake", 800)
+
+		// min(10, 4.5 + 1) * 100 gas = 550
+		option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(10), math.LegacyNewDec(1))
+		gotFee, gotTip, gotRefund := post.ApplyDynamicFee(option, effectiveBaseGasPrice, 100, fee, tip)
+		require.Equal(t, fee, gotFee)
+		require.Equal(t, sdk.NewInt64Coin("stake", 100), gotTip)
+		require.Equal(t, sdk.NewInt64Coin("stake", 700), gotRefund)
+
+		// min(5, 4.5 + 1) * 100 gas = 500
+		option = types.NewExtensionOptionDynamicFee(math.LegacyNewDec(5), math.LegacyNewDec(1))
+		gotFee, gotTip, gotRefund = post.ApplyDynamicFee(option, effectiveBaseGasPrice, 100, fee, tip)
+		require.Equal(t, fee, gotFee)
+		require.Equal(t, sdk.NewInt64Coin("stake", 50), gotTip)
+		require.Equal(t, sdk.NewInt64Coin("stake", 750), gotRefund)
+
+		// the fee is charged in full even if it exceeds the cap
+		option = types.NewExtensionOptionDynamicFee(math.LegacyNewDec(4), math.LegacyNewDec(1))
+		gotFee, gotTip, gotRefund = post.ApplyDynamicFee(option, effectiveBaseGasPrice, 100, fee, tip)
+		require.Equal(t, fee, gotFee)
+		require.Equal(t, sdk.NewInt64Coin("stake", 0), gotTip)
+		require.Equal(t, sdk.NewInt64Coin("stake", 800), gotRefund)
+	})
+}
+
+func TestSplitFeeCoins(t *testing.T) {
+	testCases := []struct {
+		name           string
+		feeCoins       sdk.Coins
+		values         []math.Int
+		feeValue       math.Int
+		tipValue       math.Int
+		expectedFees   sdk.Coins
+		expectedTips   sdk.Coins
+		expectedRefund sdk.Coins
+	}{
+		{
+			name:           "cheapest coin is charged first",
+			feeCoins:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 300)),
+			values:         []math.Int{math.NewInt(200), math.NewInt(300)},
+			feeValue:       math.NewInt(250),
+			tipValue:       math.NewInt(100),
+			expectedFees:   sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 50)),
+			expectedTips:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expectedRefund: sdk.NewCoins(sdk.NewInt64Coin("stake", 150)),
+		},
+		{
+			name:           "partial amounts are rounded up",
+			feeCoins:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 300)),
+			values:         []math.Int{math.NewInt(300), math.NewInt(300)},
+			feeValue:       math.NewInt(100),
+			tipValue:       math.NewInt(1),
+			expectedFees:   sdk.NewCoins(sdk.NewInt64Coin("atom", 34)),
+			expectedTips:   sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			expectedRefund: sdk.NewCoins(sdk.NewInt64Coin("atom", 65), sdk.NewInt64Coin("stake", 300)),
+		},
+		{
+			name:           "coins without value are refunded",
+			feeCoins:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 300)),
+			values:         []math.Int{math.ZeroInt(), math.NewInt(300)},
+			feeValue:       math.NewInt(300),
+			tipValue:       math.ZeroInt(),
+			expectedFees:   sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+			expectedTips:   nil,
+			expectedRefund: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		},
+		{
+			name:           "all coins are charged",
+			feeCoins:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 300)),
+			values:         []math.Int{math.NewInt(100), math.NewInt(300)},
+			feeValue:       math.NewInt(350),
+			tipValue:       math.NewInt(50),
+			expectedFees:   sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 250)),
+			expectedTips:   sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			expectedRefund: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, tips, refund := post.SplitFeeCoins(tc.feeCoins, tc.values, tc.feeValue, tc.tipValue)
+			require.Equal(t, tc.expectedFees, fees)
+			require.Equal(t, tc.expectedTips, tips)
+			require.Equal(t, tc.expectedRefund, refund)
+		})
+	}
+}
+
 func TestDeductCoinsAndDistribute(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -199,7 +605,7 @@ func TestPostHandleMock(t *testing.T) {
 	const (
 		baseDenom              = "stake"
 		resolvableDenom        = "atom"
-		expectedConsumedGas    = 10649
+		expectedConsumedGas    = 13713
 		expectedConsumedSimGas = expectedConsumedGas + post.BankSendGasConsumption
 		gasLimit               = expectedConsumedSimGas
 	)
@@ -276,7 +682,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -298,7 +704,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil)
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -320,7 +726,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -342,7 +748,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -399,7 +805,7 @@ func TestPostHandleMock(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15412, // extra gas consumed because msg server is run, but deduction is skipped
+			ExpectConsumedGas: 23682, // extra gas consumed because msg server is run, but deduction is skipped
 			Mock:              true,
 		},
 		{
@@ -408,7 +814,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything,
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything,
 					mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
@@ -431,7 +837,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -453,7 +859,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -475,7 +881,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -583,11 +989,12 @@ func TestPostHandleMock(t *testing.T) {
 func TestPostHandle(t *testing.T) {
 	// Same data for every test case
 	const (
-		baseDenom           = "stake"
-		resolvableDenom     = "atom"
-		expectedConsumedGas = 36668
+		baseDenom              = "stake"
+		resolvableDenom        = "atom"
+		expectedConsumedGas    = 45295
+		expectedConsumedSimGas = 44035
 
-		expectedConsumedGasResolve = 36542 // slight difference due to denom resolver
+		expectedConsumedGasResolve = 45169 // slight difference due to denom resolver
 
 		gasLimit = 100000
 	)
@@ -598,6 +1005,8 @@ func TestPostHandle(t *testing.T) {
 	validFeeWithTip := sdk.NewCoins(sdk.NewCoin(baseDenom, validFeeAmountWithTip.TruncateInt()))
 	validResolvableFee := sdk.NewCoins(sdk.NewCoin(resolvableDenom, validFeeAmount.TruncateInt()))
 	validResolvableFeeWithTip := sdk.NewCoins(sdk.NewCoin(resolvableDenom, validFeeAmountWithTip.TruncateInt()))
+	halfFeeAmount := validFeeAmount.QuoInt64(2).Ceil().TruncateInt()
+	validMultiFee := sdk.NewCoins(sdk.NewCoin(baseDenom, halfFeeAmount), sdk.NewCoin(resolvableDenom, halfFeeAmount))
 
 	testCases := []antesuite.TestCase{
 		{
@@ -635,7 +1044,7 @@ func TestPostHandle(t *testing.T) {
 			ExpPass:           true,
 			ExpErr:            nil,
 			Mock:              false,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 		},
 		{
 			Name: "0 gas given should fail",
@@ -671,7 +1080,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -764,7 +1173,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -811,7 +1220,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15412, // extra gas consumed because msg server is run, but bank keepers are skipped
+			ExpectConsumedGas: 23682, // extra gas consumed because msg server is run, but bank keepers are skipped
 			Mock:              false,
 		},
 		{
@@ -861,7 +1270,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -880,7 +1289,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -948,7 +1357,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -967,7 +1376,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
 			Mock:              false,
 		},
 		{
@@ -986,9 +1395,179 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          true,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedSimGas,
+			Mock:              false,
+		},
+		{
+			Name: "signer has enough funds, should pass and burn fee",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				params := types.DefaultParams()
+				params.BurnFees = true
+				err := s.FeeMarketKeeper.SetParams(s.Ctx, params)
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: 57990, // extra gas consumed by the burn and the burned fees update
+			Mock:              false,
+		},
+		{
+			Name: "signer has enough funds, should pass and refund unused gas",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				params := types.DefaultParams()
+				params.UnusedGasPolicy = types.UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND
+				err := s.FeeMarketKeeper.SetParams(s.Ctx, params)
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: 47724, // extra gas consumed by the refund
+			Mock:              false,
+		},
+		{
+			Name: "signer has enough funds in multiple fee coins, should pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validMultiFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validMultiFee,
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: 55143, // extra gas consumed by resolving and sending multiple coins
 			Mock:              false,
 		},
+		{
+			Name: "signer has enough funds with dynamic fee, should pass and refund",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFeeWithTip,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				option, err := codectypes.NewAnyWithValue(types.NewExtensionOptionDynamicFee(
+					math.LegacyMustNewDecFromStr("1.001"),
+					math.LegacyMustNewDecFromStr("0.0001"),
+				))
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:             []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:         gasLimit,
+					FeeAmount:        validFeeWithTip,
+					ExtensionOptions: []*codectypes.Any{option},
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: 47724, // extra gas consumed by the refund
+			Mock:              false,
+		},
+		{
+			Name: "fee exempt tx, should pass without fee",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				params := types.DefaultParams()
+				params.FeeExemptions = []types.FeeExemption{
+					{MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}), MaxTxGas: gasLimit},
+				}
+				params.MaxBlockExemptGas = gasLimit
+				err := s.FeeMarketKeeper.SetParams(s.Ctx, params)
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: nil,
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: 8117, // no fee is escrowed or deducted
+			Mock:              false,
+		},
+		{
+			Name: "fee exempt tx exceeding the gas cap, no fee - fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				params := types.DefaultParams()
+				params.FeeExemptions = []types.FeeExemption{
+					{MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}), MaxTxGas: gasLimit - 1},
+				}
+				params.MaxBlockExemptGas = gasLimit
+				err := s.FeeMarketKeeper.SetParams(s.Ctx, params)
+				s.Require().NoError(err)
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: nil,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  true,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   types.ErrNoFeeCoins,
+			Mock:     false,
+		},
 		{
 			Name: "no fee - fail",
 			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
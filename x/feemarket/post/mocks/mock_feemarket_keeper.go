@@ -15,6 +15,24 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddBurnedFees provides a mock function with given fields: ctx, coins
+func (_m *FeeMarketKeeper) AddBurnedFees(ctx types.Context, coins types.Coins) error {
+	ret := _m.Called(ctx, coins)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBurnedFees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.Coins) error); ok {
+		r0 = rf(ctx, coins)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetEnabledHeight provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
 	ret := _m.Called(ctx)
@@ -61,6 +79,34 @@ func (_m *FeeMarketKeeper) GetFeeRecipientModule() string {
 	return r0
 }
 
+// GetMinBytePrice provides a mock function with given fields: ctx, denom
+func (_m *FeeMarketKeeper) GetMinBytePrice(ctx types.Context, denom string) (types.DecCoin, error) {
+	ret := _m.Called(ctx, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMinBytePrice")
+	}
+
+	var r0 types.DecCoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, string) (types.DecCoin, error)); ok {
+		return rf(ctx, denom)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, string) types.DecCoin); ok {
+		r0 = rf(ctx, denom)
+	} else {
+		r0 = ret.Get(0).(types.DecCoin)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, string) error); ok {
+		r1 = rf(ctx, denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMinGasPrice provides a mock function with given fields: ctx, denom
 func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, denom)
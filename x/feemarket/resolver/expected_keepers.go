@@ -0,0 +1,57 @@
+package resolver
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CurrencyPair identifies a price feed by the tickers of its base and quote currency, e.g. ATOM/USD.
+type CurrencyPair struct {
+	Base  string
+	Quote string
+}
+
+// String returns the currency pair as "BASE/QUOTE".
+func (cp CurrencyPair) String() string {
+	return cp.Base + "/" + cp.Quote
+}
+
+// QuotePrice is a price reported by a price feed.
+type QuotePrice struct {
+	// Price is the price of one unit of the base currency in the quote currency, scaled
+	// by 10^Decimals.
+	Price math.Int
+	// Decimals is the number of decimals of the price.
+	Decimals uint64
+	// BlockTimestamp is the block time at which the price was last updated.
+	BlockTimestamp time.Time
+}
+
+// PriceKeeper defines the price feed used by the OracleDenomResolver, e.g. an adapter
+// around the x/oracle keeper and its market map.
+//
+//go:generate mockery --name PriceKeeper --filename mock_price_keeper.go
+type PriceKeeper interface {
+	GetPriceForCurrencyPair(ctx sdk.Context, cp CurrencyPair) (QuotePrice, error)
+}
+
+// TwapKeeper defines the AMM used by the TwapDenomResolver, e.g. an adapter around the
+// twap and pool manager keepers of a DEX module.
+//
+//go:generate mockery --name TwapKeeper --filename mock_twap_keeper.go
+type TwapKeeper interface {
+	// GetArithmeticTwapToNow returns the time-weighted average price of the base denom in
+	// the quote denom of the pool from the start time to the current block time.
+	GetArithmeticTwapToNow(
+		ctx sdk.Context,
+		poolID uint64,
+		baseDenom string,
+		quoteDenom string,
+		startTime time.Time,
+	) (math.LegacyDec, error)
+	// GetTotalPoolLiquidity returns the reserves of the pool.
+	GetTotalPoolLiquidity(ctx sdk.Context, poolID uint64) (sdk.Coins, error)
+}
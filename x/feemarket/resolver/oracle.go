@@ -0,0 +1,171 @@
+package resolver
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+var _ types.DenomResolver = &OracleDenomResolver{}
+
+// OracleDenom configures the price feed of a denom for the OracleDenomResolver.
+type OracleDenom struct {
+	// Denom is the denom that is priced, e.g. uatom.
+	Denom string
+	// Ticker is the base currency of the price feed of the denom, e.g. ATOM.
+	Ticker string
+	// Exponent is the number of decimals of the denom relative to its ticker, e.g. 6 for uatom.
+	Exponent uint32
+}
+
+// OracleDenomResolver is a DenomResolver that converts between denoms using the prices of
+// a price feed. Every denom, including the fee denom, must be configured with the ticker of
+// its price feed, and all price feeds must share the same quote currency. Conversions fail
+// if a price is missing, zero or older than the max price age.
+type OracleDenomResolver struct {
+	priceKeeper PriceKeeper
+	quote       string
+	maxPriceAge time.Duration
+	denoms      map[string]OracleDenom
+}
+
+// NewOracleDenomResolver returns a new OracleDenomResolver that prices the given denoms in
+// the quote currency. A max price age of zero disables the staleness check.
+func NewOracleDenomResolver(
+	priceKeeper PriceKeeper,
+	quote string,
+	maxPriceAge time.Duration,
+	denoms ...OracleDenom,
+) (*OracleDenomResolver, error) {
+	if priceKeeper == nil {
+		return nil, fmt.Errorf("price keeper cannot be nil")
+	}
+
+	if quote == "" {
+		return nil, fmt.Errorf("quote currency cannot be empty")
+	}
+
+	if maxPriceAge < 0 {
+		return nil, fmt.Errorf("max price age cannot be negative: %s", maxPriceAge)
+	}
+
+	r := &OracleDenomResolver{
+		priceKeeper: priceKeeper,
+		quote:       quote,
+		maxPriceAge: maxPriceAge,
+		denoms:      make(map[string]OracleDenom, len(denoms)),
+	}
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom.Denom); err != nil {
+			return nil, err
+		}
+
+		if denom.Ticker == "" {
+			return nil, fmt.Errorf("ticker for denom %s cannot be empty", denom.Denom)
+		}
+
+		if _, ok := r.denoms[denom.Denom]; ok {
+			return nil, fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+
+		r.denoms[denom.Denom] = denom
+	}
+
+	return r, nil
+}
+
+// ConvertToDenom converts the coin into the equivalent amount of the given denom using the
+// prices of both denoms in the quote currency.
+func (r *OracleDenomResolver) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	fromPrice, fromScale, err := r.getPrice(ctx, coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	toPrice, toScale, err := r.getPrice(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	// amount = coin.Amount * (fromPrice / 10^fromScale) / (toPrice / 10^toScale), computed on
+	// the underlying integers of the decimals so that the intermediate product cannot overflow
+	// and the division is done last to keep the precision
+	numerator := new(big.Int).Mul(coin.Amount.BigInt(), fromPrice.BigInt())
+	numerator.Mul(numerator, pow10(toScale))
+	denominator := new(big.Int).Mul(pow10(fromScale), toPrice.BigInt())
+
+	amount := math.LegacyNewDecFromBigIntWithPrec(numerator.Quo(numerator, denominator), math.LegacyPrecision)
+	if amount.GT(types.MaxDecValue) {
+		return sdk.DecCoin{}, types.ErrConversionOverflow.Wrapf(
+			"converting %s to %s exceeds the max value of %s",
+			coin,
+			denom,
+			types.MaxDecValue,
+		)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, amount), nil
+}
+
+// ExtraDenoms returns the configured denoms in sorted order.
+func (r *OracleDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	denoms := make([]string, 0, len(r.denoms))
+	for denom := range r.denoms {
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+
+	return denoms, nil
+}
+
+// getPrice returns the price of the ticker of the denom in the quote currency along with
+// the scale of the price per base unit of the denom, which is the sum of the decimals of the
+// price and the exponent of the denom.
+func (r *OracleDenomResolver) getPrice(ctx sdk.Context, denom string) (math.Int, uint64, error) {
+	config, ok := r.denoms[denom]
+	if !ok {
+		return math.Int{}, 0, types.ErrPriceUnavailable.Wrapf("no price feed for denom %s", denom)
+	}
+
+	cp := CurrencyPair{Base: config.Ticker, Quote: r.quote}
+
+	price, err := r.priceKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math.Int{}, 0, types.ErrPriceUnavailable.Wrapf("unable to get price for %s: %s", cp, err)
+	}
+
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return math.Int{}, 0, types.ErrPriceUnavailable.Wrapf("invalid price for %s: %s", cp, price.Price)
+	}
+
+	if r.maxPriceAge > 0 {
+		if age := ctx.BlockTime().Sub(price.BlockTimestamp); age > r.maxPriceAge {
+			return math.Int{}, 0, types.ErrStalePrice.Wrapf(
+				"price for %s is %s old, max age is %s",
+				cp,
+				age,
+				r.maxPriceAge,
+			)
+		}
+	}
+
+	return price.Price, price.Decimals + uint64(config.Exponent), nil
+}
+
+// pow10 returns 10^n.
+func pow10(n uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(n), nil)
+}
@@ -0,0 +1,287 @@
+package resolver_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/resolver"
+	"github.com/skip-mev/feemarket/x/feemarket/resolver/mocks"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestNewOracleDenomResolver(t *testing.T) {
+	priceKeeper := mocks.NewPriceKeeper(t)
+	atom := resolver.OracleDenom{Denom: "uatom", Ticker: "ATOM", Exponent: 6}
+
+	testCases := []struct {
+		name        string
+		priceKeeper resolver.PriceKeeper
+		quote       string
+		maxPriceAge time.Duration
+		denoms      []resolver.OracleDenom
+		expErr      bool
+	}{
+		{
+			name:        "valid resolver",
+			priceKeeper: priceKeeper,
+			quote:       "USD",
+			maxPriceAge: time.Minute,
+			denoms:      []resolver.OracleDenom{atom},
+		},
+		{
+			name:        "nil price keeper",
+			priceKeeper: nil,
+			quote:       "USD",
+			denoms:      []resolver.OracleDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "empty quote",
+			priceKeeper: priceKeeper,
+			quote:       "",
+			denoms:      []resolver.OracleDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "negative max price age",
+			priceKeeper: priceKeeper,
+			quote:       "USD",
+			maxPriceAge: -time.Minute,
+			denoms:      []resolver.OracleDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "invalid denom",
+			priceKeeper: priceKeeper,
+			quote:       "USD",
+			denoms:      []resolver.OracleDenom{{Denom: "1", Ticker: "ATOM"}},
+			expErr:      true,
+		},
+		{
+			name:        "empty ticker",
+			priceKeeper: priceKeeper,
+			quote:       "USD",
+			denoms:      []resolver.OracleDenom{{Denom: "uatom"}},
+			expErr:      true,
+		},
+		{
+			name:        "duplicate denom",
+			priceKeeper: priceKeeper,
+			quote:       "USD",
+			denoms:      []resolver.OracleDenom{atom, atom},
+			expErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resolver.NewOracleDenomResolver(tc.priceKeeper, tc.quote, tc.maxPriceAge, tc.denoms...)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOracleDenomResolverConvertToDenom(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.Context{}.WithBlockTime(now)
+
+	atomUSD := resolver.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	osmoUSD := resolver.CurrencyPair{Base: "OSMO", Quote: "USD"}
+	evmosUSD := resolver.CurrencyPair{Base: "EVMOS", Quote: "USD"}
+	ethUSD := resolver.CurrencyPair{Base: "ETH", Quote: "USD"}
+	injUSD := resolver.CurrencyPair{Base: "INJ", Quote: "USD"}
+
+	// $10 per ATOM, $0.50 per OSMO and $0.02 per EVMOS
+	atomPrice := resolver.QuotePrice{Price: math.NewInt(1_000_000_000), Decimals: 8, BlockTimestamp: now}
+	osmoPrice := resolver.QuotePrice{Price: math.NewInt(50_000_000), Decimals: 8, BlockTimestamp: now}
+	evmosPrice := resolver.QuotePrice{Price: math.NewInt(20_000), Decimals: 6, BlockTimestamp: now}
+
+	// $3000 per ETH and $0.02 per INJ, both with 18 decimals
+	ethPrice := resolver.QuotePrice{Price: math.NewIntWithDecimal(3000, 18), Decimals: 18, BlockTimestamp: now}
+	injPrice := resolver.QuotePrice{Price: math.NewIntWithDecimal(2, 16), Decimals: 18, BlockTimestamp: now}
+
+	denoms := []resolver.OracleDenom{
+		{Denom: "uatom", Ticker: "ATOM", Exponent: 6},
+		{Denom: "uosmo", Ticker: "OSMO", Exponent: 6},
+		{Denom: "aevmos", Ticker: "EVMOS", Exponent: 18},
+		{Denom: "aeth", Ticker: "ETH", Exponent: 18},
+		{Denom: "ainj", Ticker: "INJ", Exponent: 18},
+	}
+
+	testCases := []struct {
+		name        string
+		coin        sdk.DecCoin
+		denom       string
+		maxPriceAge time.Duration
+		malleate    func(*mocks.PriceKeeper)
+		expected    sdk.DecCoin
+		expErr      error
+	}{
+		{
+			name:     "same denom is not converted",
+			coin:     sdk.NewInt64DecCoin("uatom", 100),
+			denom:    "uatom",
+			malleate: func(*mocks.PriceKeeper) {},
+			expected: sdk.NewInt64DecCoin("uatom", 100),
+		},
+		{
+			name:  "convert between denoms with the same exponent",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(atomPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, osmoUSD).Return(osmoPrice, nil)
+			},
+			expected: sdk.NewInt64DecCoin("uosmo", 2000),
+		},
+		{
+			name:  "convert between denoms with different exponents and decimals",
+			coin:  sdk.NewInt64DecCoin("uatom", 1_000_000),
+			denom: "aevmos",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(atomPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, evmosUSD).Return(evmosPrice, nil)
+			},
+			expected: sdk.NewDecCoinFromDec("aevmos", math.LegacyNewDecFromInt(math.NewIntWithDecimal(500, 18))),
+		},
+		{
+			name:  "convert large amounts between 18-decimal denoms with 18-decimal prices",
+			coin:  sdk.NewDecCoinFromDec("aeth", math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 30))),
+			denom: "ainj",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, ethUSD).Return(ethPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, injUSD).Return(injPrice, nil)
+			},
+			expected: sdk.NewDecCoinFromDec("ainj", math.LegacyNewDecFromInt(math.NewIntWithDecimal(15, 34))),
+		},
+		{
+			name:  "conversion above the max value is rejected",
+			coin:  sdk.NewDecCoinFromDec("aeth", math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 45))),
+			denom: "ainj",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, ethUSD).Return(ethPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, injUSD).Return(injPrice, nil)
+			},
+			expErr: types.ErrConversionOverflow,
+		},
+		{
+			name:  "fractional amounts are kept",
+			coin:  sdk.NewInt64DecCoin("uosmo", 1),
+			denom: "uatom",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, osmoUSD).Return(osmoPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(atomPrice, nil)
+			},
+			expected: sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.05")),
+		},
+		{
+			name:     "denom without price feed is rejected",
+			coin:     sdk.NewInt64DecCoin("ujuno", 100),
+			denom:    "uatom",
+			malleate: func(*mocks.PriceKeeper) {},
+			expErr:   types.ErrPriceUnavailable,
+		},
+		{
+			name:  "missing price is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(resolver.QuotePrice{}, fmt.Errorf("not found"))
+			},
+			expErr: types.ErrPriceUnavailable,
+		},
+		{
+			name:  "zero price is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.PriceKeeper) {
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(atomPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, osmoUSD).Return(resolver.QuotePrice{
+					Price:          math.ZeroInt(),
+					Decimals:       8,
+					BlockTimestamp: now,
+				}, nil)
+			},
+			expErr: types.ErrPriceUnavailable,
+		},
+		{
+			name:        "stale price is rejected",
+			coin:        sdk.NewInt64DecCoin("uatom", 100),
+			denom:       "uosmo",
+			maxPriceAge: time.Minute,
+			malleate: func(k *mocks.PriceKeeper) {
+				stalePrice := atomPrice
+				stalePrice.BlockTimestamp = now.Add(-2 * time.Minute)
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(stalePrice, nil)
+			},
+			expErr: types.ErrStalePrice,
+		},
+		{
+			name:        "price within the max age is accepted",
+			coin:        sdk.NewInt64DecCoin("uatom", 100),
+			denom:       "uosmo",
+			maxPriceAge: time.Minute,
+			malleate: func(k *mocks.PriceKeeper) {
+				recentPrice := atomPrice
+				recentPrice.BlockTimestamp = now.Add(-time.Minute)
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(recentPrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, osmoUSD).Return(osmoPrice, nil)
+			},
+			expected: sdk.NewInt64DecCoin("uosmo", 2000),
+		},
+		{
+			name:  "staleness is not checked without a max age",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.PriceKeeper) {
+				stalePrice := atomPrice
+				stalePrice.BlockTimestamp = now.Add(-time.Hour)
+				k.On("GetPriceForCurrencyPair", mock.Anything, atomUSD).Return(stalePrice, nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, osmoUSD).Return(osmoPrice, nil)
+			},
+			expected: sdk.NewInt64DecCoin("uosmo", 2000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priceKeeper := mocks.NewPriceKeeper(t)
+			tc.malleate(priceKeeper)
+
+			r, err := resolver.NewOracleDenomResolver(priceKeeper, "USD", tc.maxPriceAge, denoms...)
+			require.NoError(t, err)
+
+			got, err := r.ConvertToDenom(ctx, tc.coin, tc.denom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestOracleDenomResolverExtraDenoms(t *testing.T) {
+	r, err := resolver.NewOracleDenomResolver(mocks.NewPriceKeeper(t), "USD", 0,
+		resolver.OracleDenom{Denom: "uosmo", Ticker: "OSMO", Exponent: 6},
+		resolver.OracleDenom{Denom: "uatom", Ticker: "ATOM", Exponent: 6},
+	)
+	require.NoError(t, err)
+
+	denoms, err := r.ExtraDenoms(sdk.Context{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"uatom", "uosmo"}, denoms)
+}
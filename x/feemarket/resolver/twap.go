@@ -0,0 +1,213 @@
+package resolver
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+var _ types.DenomResolver = &TwapDenomResolver{}
+
+// TwapDenom configures the pool of a denom for the TwapDenomResolver.
+type TwapDenom struct {
+	// Denom is the denom that is priced, e.g. an IBC or tokenfactory denom.
+	Denom string
+	// PoolID is the id of the pool that pairs the denom with the quote denom.
+	PoolID uint64
+	// MinLiquidity is the minimum reserve of the quote denom in the pool. Pools with less
+	// liquidity are rejected.
+	MinLiquidity math.Int
+}
+
+// TwapDenomResolver is a DenomResolver that converts between denoms using the time-weighted
+// average prices of AMM pools. Every denom is paired with the quote denom, usually the fee
+// denom, in a configured pool. Conversions fail if a pool has less liquidity than required,
+// or if the converted value exceeds the max slippage, i.e. the share of the pool's reserve
+// of the quote denom that a conversion may move.
+type TwapDenomResolver struct {
+	twapKeeper  TwapKeeper
+	quoteDenom  string
+	lookback    time.Duration
+	maxSlippage math.LegacyDec
+	denoms      map[string]TwapDenom
+}
+
+// NewTwapDenomResolver returns a new TwapDenomResolver that prices the given denoms in the
+// quote denom with the TWAP over the lookback window.
+func NewTwapDenomResolver(
+	twapKeeper TwapKeeper,
+	quoteDenom string,
+	lookback time.Duration,
+	maxSlippage math.LegacyDec,
+	denoms ...TwapDenom,
+) (*TwapDenomResolver, error) {
+	if twapKeeper == nil {
+		return nil, fmt.Errorf("twap keeper cannot be nil")
+	}
+
+	if err := sdk.ValidateDenom(quoteDenom); err != nil {
+		return nil, err
+	}
+
+	if lookback <= 0 {
+		return nil, fmt.Errorf("lookback window must be positive: %s", lookback)
+	}
+
+	if maxSlippage.IsNil() || !maxSlippage.IsPositive() || maxSlippage.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("max slippage must be in (0, 1]")
+	}
+
+	r := &TwapDenomResolver{
+		twapKeeper:  twapKeeper,
+		quoteDenom:  quoteDenom,
+		lookback:    lookback,
+		maxSlippage: maxSlippage,
+		denoms:      make(map[string]TwapDenom, len(denoms)),
+	}
+
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom.Denom); err != nil {
+			return nil, err
+		}
+
+		if denom.Denom == quoteDenom {
+			return nil, fmt.Errorf("denom %s cannot be the quote denom", denom.Denom)
+		}
+
+		if denom.PoolID == 0 {
+			return nil, fmt.Errorf("pool id for denom %s cannot be zero", denom.Denom)
+		}
+
+		if denom.MinLiquidity.IsNil() {
+			denom.MinLiquidity = math.ZeroInt()
+		} else if denom.MinLiquidity.IsNegative() {
+			return nil, fmt.Errorf("min liquidity for denom %s cannot be negative", denom.Denom)
+		}
+
+		if _, ok := r.denoms[denom.Denom]; ok {
+			return nil, fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+
+		r.denoms[denom.Denom] = denom
+	}
+
+	return r, nil
+}
+
+// ConvertToDenom converts the coin into the equivalent amount of the given denom using the
+// TWAPs of both denoms in the quote denom.
+func (r *TwapDenomResolver) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	// value of the coin in the quote denom
+	value := coin.Amount
+	if coin.Denom != r.quoteDenom {
+		price, reserve, err := r.getPrice(ctx, coin.Denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+
+		value = value.Mul(price)
+		if err := r.checkSlippage(coin.Denom, value, reserve); err != nil {
+			return sdk.DecCoin{}, err
+		}
+	}
+
+	amount := value
+	if denom != r.quoteDenom {
+		price, reserve, err := r.getPrice(ctx, denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+
+		if err := r.checkSlippage(denom, value, reserve); err != nil {
+			return sdk.DecCoin{}, err
+		}
+		amount = value.Quo(price)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, amount), nil
+}
+
+// ExtraDenoms returns the configured denoms in sorted order.
+func (r *TwapDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	denoms := make([]string, 0, len(r.denoms))
+	for denom := range r.denoms {
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+
+	return denoms, nil
+}
+
+// getPrice returns the TWAP of the denom in the quote denom along with the reserve of the
+// quote denom in the pool of the denom, which must hold at least the min liquidity.
+func (r *TwapDenomResolver) getPrice(ctx sdk.Context, denom string) (math.LegacyDec, math.Int, error) {
+	config, ok := r.denoms[denom]
+	if !ok {
+		return math.LegacyDec{}, math.Int{}, types.ErrPriceUnavailable.Wrapf("no pool for denom %s", denom)
+	}
+
+	liquidity, err := r.twapKeeper.GetTotalPoolLiquidity(ctx, config.PoolID)
+	if err != nil {
+		return math.LegacyDec{}, math.Int{}, types.ErrPriceUnavailable.Wrapf(
+			"unable to get liquidity of pool %d: %s",
+			config.PoolID,
+			err,
+		)
+	}
+
+	reserve := liquidity.AmountOf(r.quoteDenom)
+	if !reserve.IsPositive() || !liquidity.AmountOf(denom).IsPositive() || reserve.LT(config.MinLiquidity) {
+		return math.LegacyDec{}, math.Int{}, types.ErrInsufficientLiquidity.Wrapf(
+			"pool %d has %s, min liquidity is %s%s",
+			config.PoolID,
+			liquidity,
+			config.MinLiquidity,
+			r.quoteDenom,
+		)
+	}
+
+	startTime := ctx.BlockTime().Add(-r.lookback)
+
+	price, err := r.twapKeeper.GetArithmeticTwapToNow(ctx, config.PoolID, denom, r.quoteDenom, startTime)
+	if err != nil {
+		return math.LegacyDec{}, math.Int{}, types.ErrPriceUnavailable.Wrapf(
+			"unable to get twap of pool %d: %s",
+			config.PoolID,
+			err,
+		)
+	}
+
+	if price.IsNil() || !price.IsPositive() {
+		return math.LegacyDec{}, math.Int{}, types.ErrPriceUnavailable.Wrapf("invalid twap of pool %d: %s", config.PoolID, price)
+	}
+
+	return price, reserve, nil
+}
+
+// checkSlippage checks that the value in the quote denom that is converted from or to the
+// denom does not exceed the max slippage of the quote reserve of the denom's pool.
+func (r *TwapDenomResolver) checkSlippage(denom string, value math.LegacyDec, reserve math.Int) error {
+	if slippage := value.QuoInt(reserve); slippage.GT(r.maxSlippage) {
+		return types.ErrSlippageExceeded.Wrapf(
+			"converting %s%s to %s moves %s of the pool reserve, max slippage is %s",
+			value,
+			r.quoteDenom,
+			denom,
+			slippage,
+			r.maxSlippage,
+		)
+	}
+
+	return nil
+}
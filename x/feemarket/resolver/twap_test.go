@@ -0,0 +1,346 @@
+package resolver_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/resolver"
+	"github.com/skip-mev/feemarket/x/feemarket/resolver/mocks"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestNewTwapDenomResolver(t *testing.T) {
+	twapKeeper := mocks.NewTwapKeeper(t)
+	atom := resolver.TwapDenom{Denom: "uatom", PoolID: 1, MinLiquidity: math.NewInt(1000)}
+	maxSlippage := math.LegacyMustNewDecFromStr("0.1")
+
+	testCases := []struct {
+		name        string
+		twapKeeper  resolver.TwapKeeper
+		quote       string
+		lookback    time.Duration
+		maxSlippage math.LegacyDec
+		denoms      []resolver.TwapDenom
+		expErr      bool
+	}{
+		{
+			name:        "valid resolver",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{atom},
+		},
+		{
+			name:        "valid resolver without min liquidity",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: math.LegacyOneDec(),
+			denoms:      []resolver.TwapDenom{{Denom: "uatom", PoolID: 1}},
+		},
+		{
+			name:        "nil twap keeper",
+			twapKeeper:  nil,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "invalid quote denom",
+			twapKeeper:  twapKeeper,
+			quote:       "",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "zero lookback",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    0,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:       "nil max slippage",
+			twapKeeper: twapKeeper,
+			quote:      "stake",
+			lookback:   time.Hour,
+			denoms:     []resolver.TwapDenom{atom},
+			expErr:     true,
+		},
+		{
+			name:        "zero max slippage",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: math.LegacyZeroDec(),
+			denoms:      []resolver.TwapDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "max slippage greater than one",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: math.LegacyMustNewDecFromStr("1.1"),
+			denoms:      []resolver.TwapDenom{atom},
+			expErr:      true,
+		},
+		{
+			name:        "invalid denom",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{{Denom: "1", PoolID: 1}},
+			expErr:      true,
+		},
+		{
+			name:        "quote denom",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{{Denom: "stake", PoolID: 1}},
+			expErr:      true,
+		},
+		{
+			name:        "zero pool id",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{{Denom: "uatom"}},
+			expErr:      true,
+		},
+		{
+			name:        "negative min liquidity",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{{Denom: "uatom", PoolID: 1, MinLiquidity: math.NewInt(-1)}},
+			expErr:      true,
+		},
+		{
+			name:        "duplicate denom",
+			twapKeeper:  twapKeeper,
+			quote:       "stake",
+			lookback:    time.Hour,
+			maxSlippage: maxSlippage,
+			denoms:      []resolver.TwapDenom{atom, atom},
+			expErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resolver.NewTwapDenomResolver(tc.twapKeeper, tc.quote, tc.lookback, tc.maxSlippage, tc.denoms...)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTwapDenomResolverConvertToDenom(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+	startTime := now.Add(-time.Hour)
+
+	// 1 uatom is worth 2 stake and 1 uosmo is worth 0.5 stake
+	atomTwap := math.LegacyNewDec(2)
+	osmoTwap := math.LegacyMustNewDecFromStr("0.5")
+	atomLiquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("stake", 2_000_000))
+	osmoLiquidity := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("stake", 500_000))
+
+	denoms := []resolver.TwapDenom{
+		{Denom: "uatom", PoolID: 1},
+		{Denom: "uosmo", PoolID: 2, MinLiquidity: math.NewInt(100_000)},
+	}
+
+	testCases := []struct {
+		name     string
+		coin     sdk.DecCoin
+		denom    string
+		malleate func(*mocks.TwapKeeper)
+		expected sdk.DecCoin
+		expErr   error
+	}{
+		{
+			name:     "same denom is not converted",
+			coin:     sdk.NewInt64DecCoin("uatom", 100),
+			denom:    "uatom",
+			malleate: func(*mocks.TwapKeeper) {},
+			expected: sdk.NewInt64DecCoin("uatom", 100),
+		},
+		{
+			name:  "convert to the quote denom",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(atomTwap, nil)
+			},
+			expected: sdk.NewInt64DecCoin("stake", 200),
+		},
+		{
+			name:  "convert from the quote denom",
+			coin:  sdk.NewInt64DecCoin("stake", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(2)).Return(osmoLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(2), "uosmo", "stake", startTime).Return(osmoTwap, nil)
+			},
+			expected: sdk.NewInt64DecCoin("uosmo", 200),
+		},
+		{
+			name:  "convert between denoms",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(atomTwap, nil)
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(2)).Return(osmoLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(2), "uosmo", "stake", startTime).Return(osmoTwap, nil)
+			},
+			expected: sdk.NewInt64DecCoin("uosmo", 400),
+		},
+		{
+			name:     "denom without pool is rejected",
+			coin:     sdk.NewInt64DecCoin("ujuno", 100),
+			denom:    "stake",
+			malleate: func(*mocks.TwapKeeper) {},
+			expErr:   types.ErrPriceUnavailable,
+		},
+		{
+			name:  "missing pool is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(nil, fmt.Errorf("not found"))
+			},
+			expErr: types.ErrPriceUnavailable,
+		},
+		{
+			name:  "missing twap is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).
+					Return(math.LegacyDec{}, fmt.Errorf("no twap records"))
+			},
+			expErr: types.ErrPriceUnavailable,
+		},
+		{
+			name:  "zero twap is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(math.LegacyZeroDec(), nil)
+			},
+			expErr: types.ErrPriceUnavailable,
+		},
+		{
+			name:  "pool without the denom is rejected",
+			coin:  sdk.NewInt64DecCoin("uatom", 100),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 2_000_000)), nil)
+			},
+			expErr: types.ErrInsufficientLiquidity,
+		},
+		{
+			name:  "pool below the min liquidity is rejected",
+			coin:  sdk.NewInt64DecCoin("stake", 100),
+			denom: "uosmo",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(2)).Return(sdk.NewCoins(
+					sdk.NewInt64Coin("uosmo", 1_000_000),
+					sdk.NewInt64Coin("stake", 50_000),
+				), nil)
+			},
+			expErr: types.ErrInsufficientLiquidity,
+		},
+		{
+			name:  "slippage of the source pool is bounded",
+			coin:  sdk.NewInt64DecCoin("uatom", 200_000),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(atomTwap, nil)
+			},
+			expErr: types.ErrSlippageExceeded,
+		},
+		{
+			name:  "slippage of the target pool is bounded",
+			coin:  sdk.NewInt64DecCoin("uatom", 50_000),
+			denom: "uosmo",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(atomTwap, nil)
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(2)).Return(osmoLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(2), "uosmo", "stake", startTime).Return(osmoTwap, nil)
+			},
+			expErr: types.ErrSlippageExceeded,
+		},
+		{
+			name:  "slippage up to the max is accepted",
+			coin:  sdk.NewInt64DecCoin("uatom", 100_000),
+			denom: "stake",
+			malleate: func(k *mocks.TwapKeeper) {
+				k.On("GetTotalPoolLiquidity", mock.Anything, uint64(1)).Return(atomLiquidity, nil)
+				k.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "stake", startTime).Return(atomTwap, nil)
+			},
+			expected: sdk.NewInt64DecCoin("stake", 200_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			twapKeeper := mocks.NewTwapKeeper(t)
+			tc.malleate(twapKeeper)
+
+			r, err := resolver.NewTwapDenomResolver(twapKeeper, "stake", time.Hour, math.LegacyMustNewDecFromStr("0.1"), denoms...)
+			require.NoError(t, err)
+
+			got, err := r.ConvertToDenom(ctx, tc.coin, tc.denom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestTwapDenomResolverExtraDenoms(t *testing.T) {
+	r, err := resolver.NewTwapDenomResolver(mocks.NewTwapKeeper(t), "stake", time.Hour, math.LegacyOneDec(),
+		resolver.TwapDenom{Denom: "uosmo", PoolID: 2},
+		resolver.TwapDenom{Denom: "uatom", PoolID: 1},
+	)
+	require.NoError(t, err)
+
+	denoms, err := r.ExtraDenoms(sdk.Context{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"uatom", "uosmo"}, denoms)
+}
@@ -6,12 +6,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/feemarket interfaces (messages) on the
 // provided LegacyAmino codec.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "feemarket/MsgParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParamsPartial{}, "feemarket/MsgUpdateParamsPartial")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleParams{}, "feemarket/MsgScheduleParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledParams{}, "feemarket/MsgCancelScheduledParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRampParams{}, "feemarket/MsgRampParams")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeBaseGasPrice{}, "feemarket/MsgFreezeBaseGasPrice")
+	legacy.RegisterAminoMsg(cdc, &MsgExtendFeeFreeze{}, "feemarket/MsgExtendFeeFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgLiftFeeFreeze{}, "feemarket/MsgLiftFeeFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomConversion{}, "feemarket/MsgSetDenomConversion")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDenomConversion{}, "feemarket/MsgRemoveDenomConversion")
 }
 
 // RegisterInterfaces registers the x/feemarket interfaces (messages + msg server) on the
@@ -19,6 +29,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgParams{},
+		&MsgUpdateParamsPartial{},
+		&MsgScheduleParams{},
+		&MsgCancelScheduledParams{},
+		&MsgRampParams{},
+		&MsgFreezeBaseGasPrice{},
+		&MsgExtendFeeFreeze{},
+		&MsgLiftFeeFreeze{},
+		&MsgSetDenomConversion{},
+		&MsgRemoveDenomConversion{},
+	)
+
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionDynamicFee{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomConversion returns a new conversion that accepts the given denom for fees at
+// the given rate.
+func NewDenomConversion(denom string, rate math.LegacyDec) DenomConversion {
+	return DenomConversion{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// ValidateBasic performs basic validation on the denom conversion.
+func (dc *DenomConversion) ValidateBasic() error {
+	if err := sdk.ValidateDenom(dc.Denom); err != nil {
+		return err
+	}
+
+	if dc.Rate.IsNil() || !dc.Rate.IsPositive() {
+		return fmt.Errorf("conversion rate for %s must be positive", dc.Denom)
+	}
+
+	return nil
+}
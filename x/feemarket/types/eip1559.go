@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,15 +33,102 @@ var (
 	// on Ethereum. This denominated in units of gas consumed in a block.
 	DefaultMaxBlockUtilization uint64 = 30_000_000
 
+	// DefaultTargetUtilizationRatio is the default fraction of the maximum block
+	// utilization that is targeted. This is the default on Ethereum.
+	DefaultTargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.5")
+
 	// DefaultMinBaseGasPrice is the default minimum base fee.
 	DefaultMinBaseGasPrice = math.LegacyOneDec()
 
+	// DefaultMaxBaseGasPrice is the default maximum base fee. A value of zero
+	// means that the base fee is not bounded from above.
+	DefaultMaxBaseGasPrice = math.LegacyZeroDec()
+
+	// DefaultMaxBaseGasPriceIncrease is the default maximum per-block increase
+	// of the base fee. A value of zero means that the increase is not limited.
+	DefaultMaxBaseGasPriceIncrease = math.LegacyZeroDec()
+
+	// DefaultMaxBaseGasPriceDecrease is the default maximum per-block decrease
+	// of the base fee. A value of zero means that the decrease is not limited.
+	DefaultMaxBaseGasPriceDecrease = math.LegacyZeroDec()
+
 	// DefaultMinLearningRate is not used in the base EIP-1559 implementation.
 	DefaultMinLearningRate = math.LegacyMustNewDecFromStr("0.125")
 
 	// DefaultMaxLearningRate is not used in the base EIP-1559 implementation.
 	DefaultMaxLearningRate = math.LegacyMustNewDecFromStr("0.125")
 
+	// DefaultExcessGasUpdateFraction is the default update fraction of the
+	// exponential pricing model. With the default target block utilization, a
+	// full block increases the base fee by 12.5%, matching EIP-1559.
+	DefaultExcessGasUpdateFraction uint64 = 127_352_805
+
+	// DefaultPIDProportionalGain is the default proportional gain of the pid
+	// pricing model. A full block increases the base fee by 12.5%, matching
+	// EIP-1559.
+	DefaultPIDProportionalGain = math.LegacyMustNewDecFromStr("0.125")
+
+	// DefaultPIDIntegralGain is the default integral gain of the pid pricing model.
+	DefaultPIDIntegralGain = math.LegacyMustNewDecFromStr("0.01")
+
+	// DefaultPIDDerivativeGain is the default derivative gain of the pid pricing
+	// model.
+	DefaultPIDDerivativeGain = math.LegacyMustNewDecFromStr("0.0")
+
+	// DefaultPIDIntegralLimit is the default bound on the integral term of the pid
+	// pricing model.
+	DefaultPIDIntegralLimit = math.LegacyMustNewDecFromStr("4.0")
+
+	// DefaultTargetBlockTime is the default target block time. A value of zero
+	// means that the base fee adjustment is not scaled by the block time.
+	DefaultTargetBlockTime time.Duration = 0
+
+	// DefaultDowntimeThreshold is the default downtime threshold. A value of zero
+	// means that the base fee does not decay after downtime.
+	DefaultDowntimeThreshold time.Duration = 0
+
+	// DefaultMaxBlockBytes is the default maximum number of tx bytes in a block.
+	// A value of zero means that transactions are not priced by their size.
+	DefaultMaxBlockBytes uint64 = 0
+
+	// DefaultTargetBlockBytes is the default target number of tx bytes in a block.
+	DefaultTargetBlockBytes uint64 = 0
+
+	// DefaultMinBaseBytePrice is the default minimum base byte price.
+	DefaultMinBaseBytePrice = math.LegacyZeroDec()
+
+	// DefaultByteLearningRate is the default learning rate of the base byte price.
+	DefaultByteLearningRate = math.LegacyMustNewDecFromStr("0.125")
+
+	// DefaultGuardian is the default guardian. An empty address means that the
+	// base gas price can only be frozen by the authority.
+	DefaultGuardian = ""
+
+	// DefaultMaxFreezeBlocks is the default maximum number of blocks for which the
+	// guardian can freeze the base gas price.
+	DefaultMaxFreezeBlocks uint64 = 0
+
+	// DefaultMaxBlockExemptGas is the default maximum amount of gas that fee
+	// exempt transactions can consume in a block.
+	DefaultMaxBlockExemptGas uint64 = 0
+
+	// DefaultBurnFees is the default setting for burning the base fee portion of
+	// the fees. By default, fees are kept in the fee collector account.
+	DefaultBurnFees = false
+
+	// DefaultDistributeTipsToDelegators is the default setting for sharing the tips of
+	// the block proposer with its delegators. By default, tips are sent to the operator
+	// account of the block proposer.
+	DefaultDistributeTipsToDelegators = false
+
+	// DefaultUnusedGasPolicy is the default unused gas policy. By default, the fee
+	// paid for unused gas is paid out as a tip.
+	DefaultUnusedGasPolicy = UnusedGasPolicy_UNUSED_GAS_POLICY_TIP
+
+	// DefaultUnusedGasPenalty is the default fraction of the fee paid for unused gas
+	// that is charged when the unused gas policy is UNUSED_GAS_POLICY_PENALTY.
+	DefaultUnusedGasPenalty = math.LegacyZeroDec()
+
 	// DefaultFeeDenom is the Cosmos SDK default bond denom.
 	DefaultFeeDenom = sdk.DefaultBondDenom
 )
@@ -55,13 +144,40 @@ func DefaultParams() Params {
 		DefaultGamma,
 		DefaultDelta,
 		DefaultMaxBlockUtilization,
+		DefaultTargetUtilizationRatio,
 		DefaultMinBaseGasPrice,
+		DefaultMaxBaseGasPrice,
+		DefaultMaxBaseGasPriceIncrease,
+		DefaultMaxBaseGasPriceDecrease,
 		DefaultMinLearningRate,
 		DefaultMaxLearningRate,
 		DefaultFeeDenom,
 		true,
 		false,
 		true,
+		PricingModelEIP1559,
+		DefaultExcessGasUpdateFraction,
+		DefaultPIDProportionalGain,
+		DefaultPIDIntegralGain,
+		DefaultPIDDerivativeGain,
+		DefaultPIDIntegralLimit,
+		DefaultTargetBlockTime,
+		DefaultDowntimeThreshold,
+		DefaultMaxBlockBytes,
+		DefaultTargetBlockBytes,
+		DefaultMinBaseBytePrice,
+		DefaultByteLearningRate,
+		nil,
+		DefaultGuardian,
+		DefaultMaxFreezeBlocks,
+		nil,
+		DefaultMaxBlockExemptGas,
+		DefaultBurnFees,
+		nil,
+		nil,
+		DefaultDistributeTipsToDelegators,
+		DefaultUnusedGasPolicy,
+		DefaultUnusedGasPenalty,
 	)
 }
 
@@ -38,6 +38,10 @@ var (
 	// consumed in a block.
 	DefaultAIMDMaxBlockSize uint64 = 30_000_000
 
+	// DefaultAIMDTargetUtilizationRatio is the default fraction of the maximum
+	// block utilization that is targeted.
+	DefaultAIMDTargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.5")
+
 	// DefaultAIMDMinBaseFee is the default minimum base fee.
 	DefaultAIMDMinBaseFee = math.LegacyMustNewDecFromStr("1000000000")
 
@@ -63,13 +67,40 @@ func DefaultAIMDParams() Params {
 		DefaultAIMDGamma,
 		DefaultAIMDDelta,
 		DefaultAIMDMaxBlockSize,
+		DefaultAIMDTargetUtilizationRatio,
 		DefaultAIMDMinBaseFee,
+		DefaultMaxBaseGasPrice,
+		DefaultMaxBaseGasPriceIncrease,
+		DefaultMaxBaseGasPriceDecrease,
 		DefaultAIMDMinLearningRate,
 		DefaultAIMDMaxLearningRate,
 		DefaultAIMDFeeDenom,
 		true,
 		false,
 		true,
+		PricingModelAIMD,
+		DefaultExcessGasUpdateFraction,
+		DefaultPIDProportionalGain,
+		DefaultPIDIntegralGain,
+		DefaultPIDDerivativeGain,
+		DefaultPIDIntegralLimit,
+		DefaultTargetBlockTime,
+		DefaultDowntimeThreshold,
+		DefaultMaxBlockBytes,
+		DefaultTargetBlockBytes,
+		DefaultMinBaseBytePrice,
+		DefaultByteLearningRate,
+		nil,
+		DefaultGuardian,
+		DefaultMaxFreezeBlocks,
+		nil,
+		DefaultMaxBlockExemptGas,
+		DefaultBurnFees,
+		nil,
+		nil,
+		DefaultDistributeTipsToDelegators,
+		DefaultUnusedGasPolicy,
+		DefaultUnusedGasPenalty,
 	)
 }
 
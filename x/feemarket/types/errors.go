@@ -5,7 +5,15 @@ import (
 )
 
 var (
-	ErrNoFeeCoins      = sdkerrors.New(ModuleName, 1, "no fee coin provided. Must provide one.")
-	ErrTooManyFeeCoins = sdkerrors.New(ModuleName, 2, "too many fee coins provided.  Only one fee coin may be provided")
-	ErrResolverNotSet  = sdkerrors.New(ModuleName, 3, "denom resolver interface not set.  Only the feemarket base fee denomination can be used")
+	ErrNoFeeCoins              = sdkerrors.New(ModuleName, 1, "no fee coin provided. Must provide one.")
+	ErrTooManyFeeCoins         = sdkerrors.New(ModuleName, 2, "too many fee coins provided.  Only one fee coin may be provided")
+	ErrResolverNotSet          = sdkerrors.New(ModuleName, 3, "denom resolver interface not set.  Only the feemarket base fee denomination can be used")
+	ErrPricingModelNotFound    = sdkerrors.New(ModuleName, 4, "pricing model not registered")
+	ErrInvalidDynamicFeeOption = sdkerrors.New(ModuleName, 5, "invalid dynamic fee extension option")
+	ErrPriceUnavailable        = sdkerrors.New(ModuleName, 6, "price unavailable")
+	ErrStalePrice              = sdkerrors.New(ModuleName, 7, "stale price")
+	ErrDenomNotAccepted        = sdkerrors.New(ModuleName, 8, "denom not accepted for fees")
+	ErrInsufficientLiquidity   = sdkerrors.New(ModuleName, 9, "insufficient pool liquidity")
+	ErrSlippageExceeded        = sdkerrors.New(ModuleName, 10, "max slippage exceeded")
+	ErrConversionOverflow      = sdkerrors.New(ModuleName, 11, "denom conversion overflow")
 )
@@ -0,0 +1,94 @@
+package types
+
+import (
+	fmt "fmt"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// NewExtensionOptionDynamicFee returns a new dynamic fee extension option.
+func NewExtensionOptionDynamicFee(maxFeePerGas, maxPriorityFeePerGas math.LegacyDec) *ExtensionOptionDynamicFee {
+	return &ExtensionOptionDynamicFee{
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+	}
+}
+
+// ValidateBasic performs basic validation on the dynamic fee extension option.
+func (e *ExtensionOptionDynamicFee) ValidateBasic() error {
+	if e.MaxFeePerGas.IsNil() || e.MaxFeePerGas.IsNegative() {
+		return fmt.Errorf("max fee per gas cannot be nil and must be between [0, inf)")
+	}
+
+	if e.MaxPriorityFeePerGas.IsNil() || e.MaxPriorityFeePerGas.IsNegative() {
+		return fmt.Errorf("max priority fee per gas cannot be nil and must be between [0, inf)")
+	}
+
+	if e.MaxPriorityFeePerGas.GT(e.MaxFeePerGas) {
+		return fmt.Errorf("max priority fee per gas %s cannot exceed max fee per gas %s", e.MaxPriorityFeePerGas, e.MaxFeePerGas)
+	}
+
+	return nil
+}
+
+// EffectiveGasPrice returns the gas price paid by the transaction given the base
+// gas price, which is min(max fee per gas, base gas price + max priority fee per gas).
+func (e *ExtensionOptionDynamicFee) EffectiveGasPrice(baseGasPrice math.LegacyDec) math.LegacyDec {
+	return math.LegacyMinDec(e.MaxFeePerGas, baseGasPrice.Add(e.MaxPriorityFeePerGas))
+}
+
+// EffectiveTipPerGas returns the tip per gas paid by the transaction on top of the
+// given base gas price. It is zero if the max fee per gas does not cover the base
+// gas price.
+func (e *ExtensionOptionDynamicFee) EffectiveTipPerGas(baseGasPrice math.LegacyDec) math.LegacyDec {
+	return math.LegacyMaxDec(e.EffectiveGasPrice(baseGasPrice).Sub(baseGasPrice), math.LegacyZeroDec())
+}
+
+// IsDynamicFeeExtensionOption returns true if the given extension option is a dynamic
+// fee extension option. It can be used as the ExtensionOptionChecker of the
+// ExtensionOptionsDecorator to accept the option.
+func IsDynamicFeeExtensionOption(opt *codectypes.Any) bool {
+	return opt.GetTypeUrl() == "/"+proto.MessageName(&ExtensionOptionDynamicFee{})
+}
+
+// GetDynamicFeeExtensionOption returns the dynamic fee extension option of the tx, or
+// nil if the tx does not have one. An error is returned if the tx has more than one
+// dynamic fee extension option or if the option is invalid.
+func GetDynamicFeeExtensionOption(tx sdk.Tx) (*ExtensionOptionDynamicFee, error) {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	var option *ExtensionOptionDynamicFee
+	for _, opt := range extTx.GetExtensionOptions() {
+		if !IsDynamicFeeExtensionOption(opt) {
+			continue
+		}
+
+		if option != nil {
+			return nil, ErrInvalidDynamicFeeOption.Wrap("only one dynamic fee extension option may be provided")
+		}
+
+		cached, ok := opt.GetCachedValue().(*ExtensionOptionDynamicFee)
+		if ok {
+			option = cached
+		} else {
+			option = &ExtensionOptionDynamicFee{}
+			if err := option.Unmarshal(opt.GetValue()); err != nil {
+				return nil, ErrInvalidDynamicFeeOption.Wrap(err.Error())
+			}
+		}
+
+		if err := option.ValidateBasic(); err != nil {
+			return nil, ErrInvalidDynamicFeeOption.Wrap(err.Error())
+		}
+	}
+
+	return option, nil
+}
@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/extension.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionDynamicFee is a tx extension option that splits the fee of a
+// transaction into a base fee and a tip, similar to EIP-1559. The prices are
+// denominated in the denom of the fee coin of the transaction.
+type ExtensionOptionDynamicFee struct {
+	// MaxFeePerGas is the maximum gas price, including the tip, that the
+	// transaction is willing to pay. It must cover the effective base gas price,
+	// which includes the byte fee and the fee multiplier.
+	MaxFeePerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_fee_per_gas"`
+	// MaxPriorityFeePerGas is the maximum tip per gas that the transaction is
+	// willing to pay on top of the base gas price.
+	MaxPriorityFeePerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_priority_fee_per_gas"`
+}
+
+func (m *ExtensionOptionDynamicFee) Reset()         { *m = ExtensionOptionDynamicFee{} }
+func (m *ExtensionOptionDynamicFee) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionDynamicFee) ProtoMessage()    {}
+func (*ExtensionOptionDynamicFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6532874dbab8c394, []int{0}
+}
+func (m *ExtensionOptionDynamicFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionDynamicFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionDynamicFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionDynamicFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionDynamicFee.Merge(m, src)
+}
+func (m *ExtensionOptionDynamicFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionDynamicFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionDynamicFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionDynamicFee proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExtensionOptionDynamicFee)(nil), "feemarket.feemarket.v1.ExtensionOptionDynamicFee")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/extension.proto", fileDescriptor_6532874dbab8c394)
+}
+
+var fileDescriptor_6532874dbab8c394 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0xff, 0xe2, 0x07, 0x83, 0x20, 0x94, 0x22, 0x6d, 0x85, 0xa9, 0xb8, 0x10, 0x37,
+	0xcd, 0x10, 0x7c, 0x83, 0x12, 0x2b, 0x82, 0x60, 0x71, 0x25, 0x6e, 0xc2, 0x74, 0xbc, 0x4d, 0x87,
+	0x30, 0xb9, 0xc3, 0xcc, 0x18, 0x92, 0xb7, 0xf0, 0x61, 0x7c, 0x88, 0x2e, 0x8b, 0x2b, 0x71, 0x51,
+	0x4a, 0xf2, 0x22, 0xd2, 0xa6, 0xb6, 0x75, 0xeb, 0xee, 0x5c, 0xce, 0xe5, 0xfb, 0xe0, 0xf8, 0x97,
+	0x53, 0x00, 0xc5, 0x4d, 0x0a, 0x8e, 0xed, 0x53, 0x1e, 0x32, 0x28, 0x1c, 0x64, 0x56, 0x62, 0x16,
+	0x68, 0x83, 0x0e, 0x5b, 0xa7, 0xbb, 0x36, 0xd8, 0xa7, 0x3c, 0xec, 0xb5, 0x13, 0x4c, 0x70, 0xf3,
+	0xc2, 0xd6, 0xa9, 0xf9, 0xee, 0x75, 0x05, 0x5a, 0x85, 0x36, 0x6e, 0x8a, 0xe6, 0x68, 0xaa, 0x8b,
+	0x15, 0xf1, 0xbb, 0x37, 0x3f, 0xf0, 0x07, 0xed, 0x24, 0x66, 0x51, 0x99, 0x71, 0x25, 0xc5, 0x08,
+	0xa0, 0xf5, 0xe4, 0x9f, 0x28, 0x5e, 0xc4, 0x53, 0x80, 0x58, 0x83, 0x89, 0x13, 0x6e, 0x3b, 0xe4,
+	0x9c, 0x5c, 0x1d, 0x0d, 0xc3, 0xf9, 0xb2, 0xef, 0x7d, 0x2d, 0xfb, 0x67, 0x0d, 0xcc, 0xbe, 0xa4,
+	0x81, 0x44, 0xa6, 0xb8, 0x9b, 0x05, 0xf7, 0x90, 0x70, 0x51, 0x46, 0x20, 0x3e, 0xde, 0x07, 0xfe,
+	0xd6, 0x15, 0x81, 0x78, 0x3c, 0x56, 0xbc, 0x18, 0x01, 0x8c, 0xc1, 0xdc, 0x72, 0xdb, 0x92, 0x7e,
+	0x67, 0x4d, 0xd6, 0x46, 0xa2, 0x91, 0xae, 0xfc, 0xa5, 0xf8, 0xf7, 0x57, 0x45, 0x5b, 0xf1, 0x62,
+	0xbc, 0x25, 0xee, 0x54, 0xc3, 0xbb, 0x79, 0x45, 0xc9, 0xa2, 0xa2, 0x64, 0x55, 0x51, 0xf2, 0x56,
+	0x53, 0x6f, 0x51, 0x53, 0xef, 0xb3, 0xa6, 0xde, 0x33, 0x4b, 0xa4, 0x9b, 0xbd, 0x4e, 0x02, 0x81,
+	0x8a, 0xd9, 0x54, 0xea, 0x81, 0x82, 0xfc, 0x60, 0xf7, 0xe2, 0x20, 0xbb, 0x52, 0x83, 0x9d, 0xfc,
+	0xdf, 0x8c, 0x76, 0xfd, 0x3d, 0x00, 0xcf, 0x62, 0x80, 0x9b, 0xa7, 0x01, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionDynamicFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionDynamicFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionDynamicFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriorityFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxPriorityFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionDynamicFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFeePerGas.Size()
+	n += 1 + l + sovExtension(uint64(l))
+	l = m.MaxPriorityFeePerGas.Size()
+	n += 1 + l + sovExtension(uint64(l))
+	return n
+}
+
+func sovExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtension(x uint64) (n int) {
+	return sovExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionDynamicFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionDynamicFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionDynamicFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriorityFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriorityFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// extensionTx is a tx with extension options.
+type extensionTx struct {
+	sdk.Tx
+	options []*codectypes.Any
+}
+
+func (tx extensionTx) GetExtensionOptions() []*codectypes.Any {
+	return tx.options
+}
+
+func TestExtensionOptionDynamicFee(t *testing.T) {
+	t.Run("validates the option", func(t *testing.T) {
+		option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(2), math.LegacyNewDec(1))
+		require.NoError(t, option.ValidateBasic())
+
+		option = types.NewExtensionOptionDynamicFee(math.LegacyNewDec(2), math.LegacyNewDec(3))
+		require.Error(t, option.ValidateBasic())
+
+		option = types.NewExtensionOptionDynamicFee(math.LegacyNewDec(-1), math.LegacyZeroDec())
+		require.Error(t, option.ValidateBasic())
+
+		option = &types.ExtensionOptionDynamicFee{MaxFeePerGas: math.LegacyNewDec(1)}
+		require.Error(t, option.ValidateBasic())
+	})
+
+	t.Run("effective gas price is capped at the max fee", func(t *testing.T) {
+		option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(10), math.LegacyNewDec(2))
+		require.Equal(t, math.LegacyNewDec(5), option.EffectiveGasPrice(math.LegacyNewDec(3)))
+		require.Equal(t, math.LegacyNewDec(2), option.EffectiveTipPerGas(math.LegacyNewDec(3)))
+
+		require.Equal(t, math.LegacyNewDec(10), option.EffectiveGasPrice(math.LegacyNewDec(9)))
+		require.Equal(t, math.LegacyNewDec(1), option.EffectiveTipPerGas(math.LegacyNewDec(9)))
+	})
+
+	t.Run("effective tip is zero if the max fee does not cover the base gas price", func(t *testing.T) {
+		option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(2), math.LegacyNewDec(1))
+		require.True(t, option.EffectiveTipPerGas(math.LegacyNewDec(3)).IsZero())
+	})
+}
+
+func TestGetDynamicFeeExtensionOption(t *testing.T) {
+	option := types.NewExtensionOptionDynamicFee(math.LegacyNewDec(2), math.LegacyNewDec(1))
+	opt, err := codectypes.NewAnyWithValue(option)
+	require.NoError(t, err)
+	require.True(t, types.IsDynamicFeeExtensionOption(opt))
+
+	t.Run("tx without the option", func(t *testing.T) {
+		got, err := types.GetDynamicFeeExtensionOption(extensionTx{})
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("tx with the option", func(t *testing.T) {
+		got, err := types.GetDynamicFeeExtensionOption(extensionTx{options: []*codectypes.Any{opt}})
+		require.NoError(t, err)
+		require.Equal(t, option.MaxFeePerGas, got.MaxFeePerGas)
+		require.Equal(t, option.MaxPriorityFeePerGas, got.MaxPriorityFeePerGas)
+	})
+
+	t.Run("option is decoded if it is not cached", func(t *testing.T) {
+		uncached := &codectypes.Any{TypeUrl: opt.TypeUrl, Value: opt.Value}
+
+		got, err := types.GetDynamicFeeExtensionOption(extensionTx{options: []*codectypes.Any{uncached}})
+		require.NoError(t, err)
+		require.Equal(t, option.MaxFeePerGas, got.MaxFeePerGas)
+	})
+
+	t.Run("tx with duplicate options", func(t *testing.T) {
+		_, err := types.GetDynamicFeeExtensionOption(extensionTx{options: []*codectypes.Any{opt, opt}})
+		require.ErrorIs(t, err, types.ErrInvalidDynamicFeeOption)
+	})
+
+	t.Run("tx with an invalid option", func(t *testing.T) {
+		invalid, err := codectypes.NewAnyWithValue(types.NewExtensionOptionDynamicFee(math.LegacyNewDec(1), math.LegacyNewDec(2)))
+		require.NoError(t, err)
+
+		_, err = types.GetDynamicFeeExtensionOption(extensionTx{options: []*codectypes.Any{invalid}})
+		require.ErrorIs(t, err, types.ErrInvalidDynamicFeeOption)
+	})
+}
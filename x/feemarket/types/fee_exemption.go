@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation on the fee exemption.
+func (e *FeeExemption) ValidateBasic() error {
+	if e.MsgTypeUrl == "" && e.Address == "" {
+		return fmt.Errorf("fee exemption must set a msg type url or an address")
+	}
+
+	if e.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+			return fmt.Errorf("invalid fee exemption address: %w", err)
+		}
+	}
+
+	if e.MaxTxGas == 0 {
+		return fmt.Errorf("fee exemption max tx gas must be positive")
+	}
+
+	return nil
+}
+
+// Matches returns true if the exemption covers a message of the given type URL in a
+// transaction paid by the given fee payer.
+func (e *FeeExemption) Matches(msgTypeURL, feePayer string) bool {
+	return (e.MsgTypeUrl == "" || e.MsgTypeUrl == msgTypeURL) &&
+		(e.Address == "" || e.Address == feePayer)
+}
+
+// GetTxFeeExemptionGasCap returns the gas cap of a transaction with the given fee payer
+// and messages, which is the lowest gas cap of the exemptions covering its messages. If
+// several exemptions cover a message, the one with the highest gas cap is used. False is
+// returned if a message is not covered by any exemption.
+func (p *Params) GetTxFeeExemptionGasCap(feePayer string, msgs []sdk.Msg) (uint64, bool) {
+	if len(msgs) == 0 || len(p.FeeExemptions) == 0 {
+		return 0, false
+	}
+
+	var txCap uint64
+	for i, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		var msgCap uint64
+		for _, e := range p.FeeExemptions {
+			if e.Matches(msgTypeURL, feePayer) && e.MaxTxGas > msgCap {
+				msgCap = e.MaxTxGas
+			}
+		}
+
+		if msgCap == 0 {
+			return 0, false
+		}
+
+		if i == 0 || msgCap < txCap {
+			txCap = msgCap
+		}
+	}
+
+	return txCap, true
+}
+
+// IsTxFeeExempt returns true if a transaction with the given fee payer, messages and gas
+// limit is exempt from the fee requirement. Covered transactions whose gas limit exceeds
+// their gas cap or the remaining exempt gas budget of the block are not exempt and pay the
+// regular fee.
+func (p *Params) IsTxFeeExempt(state State, feePayer string, msgs []sdk.Msg, gasLimit uint64) bool {
+	gasCap, ok := p.GetTxFeeExemptionGasCap(feePayer, msgs)
+	if !ok || gasLimit > gasCap {
+		return false
+	}
+
+	return state.ExemptGas <= p.MaxBlockExemptGas && gasLimit <= p.MaxBlockExemptGas-state.ExemptGas
+}
@@ -0,0 +1,134 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestParams_GetTxFeeExemptionGasCap(t *testing.T) {
+	relayer := sdk.AccAddress("relayer").String()
+	other := sdk.AccAddress("other").String()
+
+	params := types.DefaultParams()
+	params.FeeExemptions = []types.FeeExemption{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MaxTxGas: 100},
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Address: relayer, MaxTxGas: 300},
+		{Address: relayer, MaxTxGas: 200},
+	}
+	params.MaxBlockExemptGas = 1000
+
+	testCases := []struct {
+		name        string
+		params      types.Params
+		feePayer    string
+		msgs        []sdk.Msg
+		expectedCap uint64
+		expectedOk  bool
+	}{
+		{
+			name:       "no exemptions",
+			params:     types.DefaultParams(),
+			feePayer:   relayer,
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}},
+			expectedOk: false,
+		},
+		{
+			name:       "no messages",
+			params:     params,
+			feePayer:   relayer,
+			msgs:       nil,
+			expectedOk: false,
+		},
+		{
+			name:        "exempt msg type",
+			params:      params,
+			feePayer:    other,
+			msgs:        []sdk.Msg{&banktypes.MsgSend{}},
+			expectedCap: 100,
+			expectedOk:  true,
+		},
+		{
+			name:        "highest cap of matching exemptions",
+			params:      params,
+			feePayer:    relayer,
+			msgs:        []sdk.Msg{&banktypes.MsgSend{}},
+			expectedCap: 300,
+			expectedOk:  true,
+		},
+		{
+			name:        "exempt address",
+			params:      params,
+			feePayer:    relayer,
+			msgs:        []sdk.Msg{&banktypes.MsgMultiSend{}},
+			expectedCap: 200,
+			expectedOk:  true,
+		},
+		{
+			name:        "lowest cap across messages",
+			params:      params,
+			feePayer:    relayer,
+			msgs:        []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			expectedCap: 200,
+			expectedOk:  true,
+		},
+		{
+			name:       "message not covered",
+			params:     params,
+			feePayer:   other,
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			expectedOk: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasCap, ok := tc.params.GetTxFeeExemptionGasCap(tc.feePayer, tc.msgs)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expectedCap, gasCap)
+		})
+	}
+}
+
+func TestParams_IsTxFeeExempt(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeExemptions = []types.FeeExemption{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MaxTxGas: 100},
+	}
+	params.MaxBlockExemptGas = 250
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{}}
+	feePayer := sdk.AccAddress("payer").String()
+
+	t.Run("within gas cap and block budget", func(t *testing.T) {
+		state := types.DefaultState()
+		require.True(t, params.IsTxFeeExempt(state, feePayer, msgs, 100))
+	})
+
+	t.Run("exceeds gas cap", func(t *testing.T) {
+		state := types.DefaultState()
+		require.False(t, params.IsTxFeeExempt(state, feePayer, msgs, 101))
+	})
+
+	t.Run("exceeds block budget", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExemptGas = 200
+		require.True(t, params.IsTxFeeExempt(state, feePayer, msgs, 50))
+		require.False(t, params.IsTxFeeExempt(state, feePayer, msgs, 51))
+	})
+
+	t.Run("block budget already exceeded", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExemptGas = 300
+		require.False(t, params.IsTxFeeExempt(state, feePayer, msgs, 1))
+	})
+
+	t.Run("not covered", func(t *testing.T) {
+		state := types.DefaultState()
+		require.False(t, params.IsTxFeeExempt(state, feePayer, []sdk.Msg{&banktypes.MsgMultiSend{}}, 1))
+	})
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewFeeFreeze returns a new freeze of the base gas price at the given price that
+// is lifted at the given height.
+func NewFeeFreeze(baseGasPrice math.LegacyDec, endHeight int64) FeeFreeze {
+	return FeeFreeze{
+		BaseGasPrice: baseGasPrice,
+		EndHeight:    endHeight,
+	}
+}
+
+// ValidateBasic performs basic validation on the fee freeze.
+func (f *FeeFreeze) ValidateBasic() error {
+	if err := ValidateFrozenBaseGasPrice(f.BaseGasPrice); err != nil {
+		return err
+	}
+
+	if f.EndHeight <= 0 {
+		return fmt.Errorf("freeze end height must be positive; got %d", f.EndHeight)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the base gas price is frozen at the given height.
+func (f *FeeFreeze) IsActive(height int64) bool {
+	return height < f.EndHeight
+}
+
+// ValidateFrozenBaseGasPrice ensures that the base gas price of a freeze is
+// positive and does not exceed the saturation limit.
+func ValidateFrozenBaseGasPrice(price math.LegacyDec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return fmt.Errorf("frozen base gas price must be positive")
+	}
+
+	if price.GT(MaxDecValue) {
+		return fmt.Errorf("frozen base gas price cannot exceed %s", MaxDecValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BasisPoints is the number of basis points in a whole.
+const BasisPoints = 10_000
+
+// NewFeeSplit returns a new fee split.
+func NewFeeSplit(burnBps, communityPoolBps, recipientBps uint32) FeeSplit {
+	return FeeSplit{
+		BurnBps:          burnBps,
+		CommunityPoolBps: communityPoolBps,
+		RecipientBps:     recipientBps,
+	}
+}
+
+// ValidateBasic performs basic validation on the fee split.
+func (s *FeeSplit) ValidateBasic() error {
+	if total := uint64(s.BurnBps) + uint64(s.CommunityPoolBps) + uint64(s.RecipientBps); total != BasisPoints {
+		return fmt.Errorf("fee split must add up to %d basis points, got %d", BasisPoints, total)
+	}
+
+	return nil
+}
+
+// Split splits the given fee into the coins that are burned, sent to the community pool
+// and sent to the fee recipient module. Rounding remainders are sent to the fee recipient
+// module, so that the shares always add up to the fee.
+func (s *FeeSplit) Split(fee sdk.Coins) (burn, communityPool, recipient sdk.Coins) {
+	burn = mulBps(fee, s.BurnBps)
+	communityPool = mulBps(fee, s.CommunityPoolBps)
+	recipient = fee.Sub(burn...).Sub(communityPool...)
+
+	return burn, communityPool, recipient
+}
+
+// NewTipSplit returns a new tip split.
+func NewTipSplit(proposerBps, recipientBps uint32) TipSplit {
+	return TipSplit{
+		ProposerBps:  proposerBps,
+		RecipientBps: recipientBps,
+	}
+}
+
+// ValidateBasic performs basic validation on the tip split.
+func (s *TipSplit) ValidateBasic() error {
+	if total := uint64(s.ProposerBps) + uint64(s.RecipientBps); total != BasisPoints {
+		return fmt.Errorf("tip split must add up to %d basis points, got %d", BasisPoints, total)
+	}
+
+	return nil
+}
+
+// Split splits the given tip into the coins that are sent to the block proposer and to
+// the fee recipient module. Rounding remainders are sent to the fee recipient module.
+func (s *TipSplit) Split(tip sdk.Coins) (proposer, recipient sdk.Coins) {
+	proposer = mulBps(tip, s.ProposerBps)
+	recipient = tip.Sub(proposer...)
+
+	return proposer, recipient
+}
+
+// mulBps returns the given share of the coins, in basis points, rounded down.
+func mulBps(coins sdk.Coins, bps uint32) sdk.Coins {
+	share := sdk.NewCoins()
+	for _, coin := range coins {
+		share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(bps)).QuoRaw(BasisPoints)))
+	}
+
+	return share
+}
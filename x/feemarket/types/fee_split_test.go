@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestFeeSplit(t *testing.T) {
+	t.Run("shares must add up to a whole", func(t *testing.T) {
+		split := types.NewFeeSplit(5000, 3000, 2000)
+		require.NoError(t, split.ValidateBasic())
+
+		split = types.NewFeeSplit(5000, 3000, 1000)
+		require.Error(t, split.ValidateBasic())
+
+		split = types.NewFeeSplit(10_000, 10_000, 0)
+		require.Error(t, split.ValidateBasic())
+	})
+
+	t.Run("splits the fee", func(t *testing.T) {
+		split := types.NewFeeSplit(5000, 3000, 2000)
+
+		burn, communityPool, recipient := split.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), burn)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), communityPool)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), recipient)
+	})
+
+	t.Run("rounding remainders go to the recipient", func(t *testing.T) {
+		split := types.NewFeeSplit(3333, 3333, 3334)
+
+		burn, communityPool, recipient := split.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), burn)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), communityPool)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), recipient)
+	})
+
+	t.Run("zero shares are empty", func(t *testing.T) {
+		split := types.NewFeeSplit(10_000, 0, 0)
+
+		burn, communityPool, recipient := split.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), burn)
+		require.True(t, communityPool.IsZero())
+		require.True(t, recipient.IsZero())
+	})
+}
+
+func TestTipSplit(t *testing.T) {
+	t.Run("shares must add up to a whole", func(t *testing.T) {
+		split := types.NewTipSplit(7000, 3000)
+		require.NoError(t, split.ValidateBasic())
+
+		split = types.NewTipSplit(7000, 0)
+		require.Error(t, split.ValidateBasic())
+	})
+
+	t.Run("splits the tip", func(t *testing.T) {
+		split := types.NewTipSplit(7000, 3000)
+
+		proposer, recipient := split.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), proposer)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), recipient)
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -23,6 +24,51 @@ func (gs *GenesisState) ValidateBasic() error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	heights := make(map[int64]struct{}, len(gs.ScheduledParams))
+	for _, sp := range gs.ScheduledParams {
+		if err := sp.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := heights[sp.Height]; ok {
+			return fmt.Errorf("duplicate scheduled params at height %d", sp.Height)
+		}
+		heights[sp.Height] = struct{}{}
+	}
+
+	if gs.ParamsRamp != nil {
+		if err := gs.ParamsRamp.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if gs.FeeFreeze != nil {
+		if err := gs.FeeFreeze.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.BurnedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid burned fees: %w", err)
+	}
+
+	denoms := make(map[string]struct{}, len(gs.DenomConversions))
+	for _, dc := range gs.DenomConversions {
+		if err := dc.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if dc.Denom == gs.Params.FeeDenom {
+			return fmt.Errorf("denom conversion for the fee denom %s", dc.Denom)
+		}
+
+		if _, ok := denoms[dc.Denom]; ok {
+			return fmt.Errorf("duplicate denom conversion for %s", dc.Denom)
+		}
+		denoms[dc.Denom] = struct{}{}
+	}
+
 	return gs.State.ValidateBasic()
 }
 
@@ -7,17 +7,23 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +39,17 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// State contains the current state of the AIMD fee market.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// ScheduledParams are the pending parameter changes, ordered by height.
+	ScheduledParams []ScheduledParams `protobuf:"bytes,3,rep,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params"`
+	// ParamsRamp is the parameter ramp in progress, if any.
+	ParamsRamp *ParamsRamp `protobuf:"bytes,4,opt,name=params_ramp,json=paramsRamp,proto3" json:"params_ramp,omitempty"`
+	// FeeFreeze is the freeze of the base gas price in effect, if any.
+	FeeFreeze *FeeFreeze `protobuf:"bytes,5,opt,name=fee_freeze,json=feeFreeze,proto3" json:"fee_freeze,omitempty"`
+	// BurnedFees is the total amount of fees burned by the feemarket module.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+	// DenomConversions are the denoms accepted for fees in addition to the fee
+	// denom along with their conversion rates, ordered by denom.
+	DenomConversions []DenomConversion `protobuf:"bytes,7,rep,name=denom_conversions,json=denomConversions,proto3" json:"denom_conversions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +99,41 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetScheduledParams() []ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsRamp() *ParamsRamp {
+	if m != nil {
+		return m.ParamsRamp
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeFreeze() *FeeFreeze {
+	if m != nil {
+		return m.FeeFreeze
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomConversions() []DenomConversion {
+	if m != nil {
+		return m.DenomConversions
+	}
+	return nil
+}
+
 // State is utilized to track the current state of the fee market. This includes
 // the current base fee, learning rate, and block utilization within the
 // specified AIMD window.
@@ -97,6 +149,23 @@ type State struct {
 	Window []uint64 `protobuf:"varint,3,rep,packed,name=window,proto3" json:"window,omitempty"`
 	// Index is the index of the current block in the block utilization window.
 	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// ExcessGas is the accumulated gas consumed above the target block
+	// utilization. This is only used by the exponential pricing model, in which
+	// the base gas price is MinBaseGasPrice * e^(ExcessGas /
+	// ExcessGasUpdateFraction).
+	ExcessGas uint64 `protobuf:"varint,5,opt,name=excess_gas,json=excessGas,proto3" json:"excess_gas,omitempty"`
+	// LastBlockTime is the time of the last block in which the fee market was
+	// updated.
+	LastBlockTime time.Time `protobuf:"bytes,6,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time"`
+	// BaseBytePrice is the current base byte price. This is denominated in the fee
+	// per tx byte.
+	BaseBytePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=base_byte_price,json=baseBytePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_byte_price"`
+	// ByteWindow contains a list of the last blocks' tx bytes. It shares the
+	// index with the block utilization window.
+	ByteWindow []uint64 `protobuf:"varint,8,rep,packed,name=byte_window,json=byteWindow,proto3" json:"byte_window,omitempty"`
+	// ExemptGas is the gas consumed by fee exempt transactions in the current
+	// block. It is bounded by Params.MaxBlockExemptGas.
+	ExemptGas uint64 `protobuf:"varint,9,opt,name=exempt_gas,json=exemptGas,proto3" json:"exempt_gas,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -146,9 +215,141 @@ func (m *State) GetIndex() uint64 {
 	return 0
 }
 
+func (m *State) GetExcessGas() uint64 {
+	if m != nil {
+		return m.ExcessGas
+	}
+	return 0
+}
+
+func (m *State) GetLastBlockTime() time.Time {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return time.Time{}
+}
+
+func (m *State) GetByteWindow() []uint64 {
+	if m != nil {
+		return m.ByteWindow
+	}
+	return nil
+}
+
+func (m *State) GetExemptGas() uint64 {
+	if m != nil {
+		return m.ExemptGas
+	}
+	return 0
+}
+
+// FeeFreeze fixes the base gas price at a chosen value for a bounded number of
+// blocks. While the freeze is in effect, the fee market is not adjusted.
+type FeeFreeze struct {
+	// BaseGasPrice is the base gas price while the freeze is in effect.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// EndHeight is the block height at which the freeze is lifted, before the
+	// fee market is updated in EndBlock.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *FeeFreeze) Reset()         { *m = FeeFreeze{} }
+func (m *FeeFreeze) String() string { return proto.CompactTextString(m) }
+func (*FeeFreeze) ProtoMessage()    {}
+func (*FeeFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{2}
+}
+func (m *FeeFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeFreeze.Merge(m, src)
+}
+func (m *FeeFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeFreeze proto.InternalMessageInfo
+
+func (m *FeeFreeze) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// DenomConversion is an entry of the conversion table managed by the authority.
+// It accepts a denom for fees in addition to the fee denom at a fixed
+// conversion rate.
+type DenomConversion struct {
+	// Denom is the denom that is accepted for fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the value of one display unit of the denom in display units of
+	// the fee denom. The display units and their exponents are taken from the
+	// bank denom metadata; a denom without metadata has an exponent of zero.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *DenomConversion) Reset()         { *m = DenomConversion{} }
+func (m *DenomConversion) String() string { return proto.CompactTextString(m) }
+func (*DenomConversion) ProtoMessage()    {}
+func (*DenomConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{3}
+}
+func (m *DenomConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConversion.Merge(m, src)
+}
+func (m *DenomConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConversion proto.InternalMessageInfo
+
+func (m *DenomConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "feemarket.feemarket.v1.GenesisState")
 	proto.RegisterType((*State)(nil), "feemarket.feemarket.v1.State")
+	proto.RegisterType((*FeeFreeze)(nil), "feemarket.feemarket.v1.FeeFreeze")
+	proto.RegisterType((*DenomConversion)(nil), "feemarket.feemarket.v1.DenomConversion")
 }
 
 func init() {
@@ -156,30 +357,53 @@ func init() {
 }
 
 var fileDescriptor_2180652c84279298 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x4d, 0x4b, 0x3a, 0x41,
-	0x18, 0xdf, 0xf9, 0xbb, 0x0a, 0xff, 0xc9, 0x3a, 0x2c, 0x22, 0x9b, 0xd1, 0x2a, 0xd6, 0xc1, 0x8b,
-	0xbb, 0x58, 0xa7, 0xa0, 0x93, 0x08, 0x12, 0x74, 0x90, 0x0d, 0x0a, 0xba, 0xc8, 0xb8, 0x3e, 0xad,
-	0x83, 0xed, 0xce, 0xb2, 0x33, 0xf9, 0xf2, 0x09, 0xba, 0xf6, 0x61, 0xfa, 0x10, 0x1e, 0xa5, 0x53,
-	0x74, 0x90, 0x50, 0xe8, 0x73, 0xc4, 0xec, 0xac, 0xe9, 0x21, 0x2f, 0xdd, 0x7e, 0x0f, 0xcf, 0xef,
-	0x6d, 0xe6, 0xc1, 0xa7, 0x0f, 0x00, 0x01, 0x89, 0x87, 0x20, 0x9c, 0x0d, 0x1a, 0x35, 0x1c, 0x1f,
-	0x42, 0xe0, 0x94, 0xdb, 0x51, 0xcc, 0x04, 0x33, 0x8a, 0x3f, 0x3b, 0x7b, 0x83, 0x46, 0x8d, 0x52,
-	0xc1, 0x67, 0x3e, 0x4b, 0x28, 0x8e, 0x44, 0x8a, 0x5d, 0x3a, 0xf4, 0x18, 0x0f, 0x18, 0xef, 0xaa,
-	0x85, 0x1a, 0xd2, 0xd5, 0xc9, 0x8e, 0xb8, 0x88, 0xc4, 0x24, 0x48, 0x49, 0xd5, 0x67, 0x84, 0xf3,
-	0x6d, 0x95, 0x7f, 0x23, 0x88, 0x00, 0xe3, 0x12, 0xe7, 0x14, 0xc1, 0x44, 0x15, 0x54, 0xdb, 0x3b,
-	0xb3, 0xec, 0xdf, 0xfb, 0xd8, 0x9d, 0x84, 0xd5, 0xd4, 0x67, 0x8b, 0xb2, 0xe6, 0xa6, 0x1a, 0xe3,
-	0x02, 0x67, 0xb9, 0xb4, 0x31, 0xff, 0x25, 0xe2, 0xe3, 0x5d, 0xe2, 0x24, 0x2b, 0xd5, 0x2a, 0x45,
-	0xf5, 0x0b, 0xe1, 0xac, 0xaa, 0x70, 0x87, 0x0f, 0x7a, 0x84, 0x43, 0xd7, 0x27, 0xf2, 0x5d, 0xd4,
-	0x83, 0xa4, 0xca, 0xff, 0x66, 0x43, 0xd2, 0x3f, 0x16, 0xe5, 0x23, 0xf5, 0x4c, 0xde, 0x1f, 0xda,
-	0x94, 0x39, 0x01, 0x11, 0x03, 0xfb, 0x1a, 0x7c, 0xe2, 0x4d, 0x5b, 0xe0, 0xbd, 0xbd, 0xd6, 0x71,
-	0xfa, 0x0b, 0x2d, 0xf0, 0xdc, 0xbc, 0x34, 0x6a, 0x13, 0xde, 0x91, 0x36, 0xc6, 0x2d, 0xde, 0x7f,
-	0x04, 0x12, 0x87, 0x34, 0xf4, 0xbb, 0xf1, 0xba, 0xe5, 0xdf, 0x7c, 0xd7, 0x3e, 0xae, 0x2c, 0x5c,
-	0xc4, 0xb9, 0x31, 0x0d, 0xfb, 0x6c, 0x6c, 0x66, 0x2a, 0x99, 0x9a, 0xee, 0xa6, 0x93, 0x51, 0xc0,
-	0x59, 0x1a, 0xf6, 0x61, 0x62, 0xea, 0x15, 0x54, 0xd3, 0x5d, 0x35, 0x34, 0xaf, 0x66, 0x4b, 0x0b,
-	0xcd, 0x97, 0x16, 0xfa, 0x5c, 0x5a, 0xe8, 0x65, 0x65, 0x69, 0xf3, 0x95, 0xa5, 0xbd, 0xaf, 0x2c,
-	0xed, 0xde, 0xf1, 0xa9, 0x18, 0x3c, 0xf5, 0x6c, 0x8f, 0x05, 0x0e, 0x1f, 0xd2, 0xa8, 0x1e, 0xc0,
-	0x68, 0xeb, 0x76, 0x93, 0x2d, 0x2c, 0xa6, 0x11, 0xf0, 0x5e, 0x2e, 0x39, 0xe2, 0xf9, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xb3, 0xeb, 0x34, 0x5a, 0x02, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x4e, 0x6e, 0x7e, 0x20, 0x13, 0xb8, 0xe1, 0x5a, 0x08, 0x05, 0xae, 0x70, 0x68, 0x5a, 0xa9,
+	0x6c, 0xb0, 0x1b, 0xba, 0xaa, 0xd4, 0x45, 0x15, 0x28, 0x69, 0x25, 0x16, 0xc8, 0xad, 0x4a, 0xcb,
+	0xc6, 0x1a, 0xdb, 0x27, 0x8e, 0x95, 0x78, 0xc6, 0xf2, 0x4c, 0x02, 0xe9, 0xb6, 0x2f, 0xc0, 0x73,
+	0x74, 0x59, 0xf5, 0x21, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0xa0, 0x82, 0x7d, 0x9f, 0xa1, 0x9a, 0x1f,
+	0x07, 0xa8, 0x1a, 0x16, 0x54, 0x5d, 0xe5, 0xcc, 0x99, 0xf3, 0x7d, 0x3e, 0xdf, 0x9c, 0xef, 0x04,
+	0x3d, 0xe8, 0x02, 0xc4, 0x38, 0xed, 0x03, 0xb7, 0xaf, 0xa2, 0x51, 0xcb, 0x0e, 0x81, 0x00, 0x8b,
+	0x98, 0x95, 0xa4, 0x94, 0x53, 0x63, 0x69, 0x72, 0x67, 0x5d, 0x45, 0xa3, 0xd6, 0xca, 0x62, 0x48,
+	0x43, 0x2a, 0x4b, 0x6c, 0x11, 0xa9, 0xea, 0x95, 0x65, 0x9f, 0xb2, 0x98, 0x32, 0x57, 0x5d, 0xa8,
+	0x83, 0xbe, 0x6a, 0x84, 0x94, 0x86, 0x03, 0xb0, 0xe5, 0xc9, 0x1b, 0x76, 0x6d, 0x1e, 0xc5, 0xc0,
+	0x38, 0x8e, 0x13, 0x5d, 0x60, 0xaa, 0x72, 0xdb, 0xc3, 0x0c, 0xec, 0x51, 0xcb, 0x03, 0x8e, 0x5b,
+	0xb6, 0x4f, 0x23, 0xa2, 0xef, 0xef, 0x4f, 0xe9, 0x37, 0xc1, 0x29, 0x8e, 0xf5, 0x57, 0x9a, 0x9f,
+	0x8a, 0x68, 0xae, 0xa3, 0x04, 0xbc, 0xe2, 0x98, 0x83, 0xf1, 0x14, 0x95, 0x55, 0x41, 0x3d, 0xbf,
+	0x96, 0x5f, 0xaf, 0x6e, 0x9a, 0xd6, 0xef, 0x05, 0x59, 0x7b, 0xb2, 0xaa, 0x5d, 0x3c, 0x39, 0x6b,
+	0xe4, 0x1c, 0x8d, 0x31, 0x9e, 0xa0, 0x12, 0x13, 0x34, 0xf5, 0x7f, 0x24, 0x78, 0x75, 0x1a, 0x58,
+	0x7e, 0x4b, 0x63, 0x15, 0xc2, 0x78, 0x8b, 0x16, 0x98, 0xdf, 0x83, 0x60, 0x38, 0x80, 0xc0, 0xd5,
+	0x2d, 0x14, 0xd6, 0x0a, 0xeb, 0xd5, 0xcd, 0x87, 0x53, 0x59, 0xb2, 0xfa, 0x1b, 0xbd, 0xd4, 0xd8,
+	0xcd, 0xb4, 0xb1, 0x85, 0xaa, 0x8a, 0xcf, 0x4d, 0x71, 0x9c, 0xd4, 0x8b, 0xb2, 0xb5, 0xe6, 0xed,
+	0xba, 0x1c, 0x1c, 0x27, 0x0e, 0x4a, 0x26, 0xb1, 0xf1, 0x0c, 0xa1, 0x2e, 0x80, 0xdb, 0x4d, 0x01,
+	0xde, 0x43, 0xbd, 0x24, 0x39, 0xee, 0x4d, 0xe3, 0xd8, 0x01, 0xd8, 0x91, 0x85, 0x4e, 0xa5, 0x9b,
+	0x85, 0xc6, 0x00, 0x55, 0xbd, 0x61, 0x4a, 0x20, 0x70, 0xbb, 0x00, 0xac, 0x5e, 0x96, 0xda, 0x96,
+	0x2d, 0x3d, 0x74, 0x31, 0x45, 0x4b, 0x4f, 0xd1, 0xda, 0xa2, 0x11, 0x69, 0x3f, 0x12, 0x6a, 0x3e,
+	0x9e, 0x37, 0xd6, 0xc3, 0x88, 0xf7, 0x86, 0x9e, 0xe5, 0xd3, 0x58, 0x3b, 0x44, 0xff, 0x6c, 0xb0,
+	0xa0, 0x6f, 0xf3, 0x71, 0x02, 0x4c, 0x02, 0x98, 0x83, 0x14, 0xff, 0x0e, 0x00, 0x33, 0x0e, 0xd0,
+	0x7f, 0x01, 0x10, 0x1a, 0xbb, 0x3e, 0x25, 0x23, 0x48, 0x59, 0x44, 0x09, 0xab, 0xcf, 0xdc, 0xfe,
+	0x9e, 0xdb, 0x02, 0xb0, 0x35, 0xa9, 0xd7, 0xef, 0xb9, 0x10, 0xdc, 0x4c, 0xb3, 0xe6, 0x8f, 0x02,
+	0x2a, 0x29, 0xb7, 0xec, 0xa3, 0x7f, 0x45, 0xe3, 0x6e, 0x88, 0x85, 0x87, 0x23, 0x1f, 0xa4, 0x6b,
+	0x2a, 0xed, 0x96, 0x40, 0x7e, 0x3b, 0x6b, 0xfc, 0xaf, 0x3a, 0x65, 0x41, 0xdf, 0x8a, 0xa8, 0x1d,
+	0x63, 0xde, 0xb3, 0x76, 0x21, 0xc4, 0xfe, 0x78, 0x1b, 0xfc, 0x2f, 0x9f, 0x37, 0x90, 0x16, 0xbf,
+	0x0d, 0xbe, 0x33, 0x27, 0x88, 0x3a, 0x98, 0xed, 0x09, 0x1a, 0xe3, 0x0d, 0x9a, 0x1f, 0x00, 0x4e,
+	0x49, 0x44, 0x42, 0x37, 0xcd, 0x0c, 0x75, 0x37, 0xde, 0x8c, 0xc7, 0x11, 0x0d, 0x2f, 0xa1, 0xf2,
+	0x61, 0x44, 0x02, 0x7a, 0x28, 0xbd, 0x55, 0x74, 0xf4, 0xc9, 0x58, 0x44, 0xa5, 0x88, 0x04, 0x70,
+	0x24, 0xdd, 0x51, 0x74, 0xd4, 0xc1, 0x58, 0x45, 0x08, 0x8e, 0x7c, 0x60, 0x4c, 0x08, 0x94, 0x43,
+	0x2f, 0x3a, 0x15, 0x95, 0xe9, 0x60, 0x66, 0xec, 0xa2, 0xda, 0x00, 0x33, 0xee, 0x7a, 0x03, 0xea,
+	0xf7, 0x5d, 0xb1, 0x9f, 0xf5, 0xb2, 0x34, 0xc6, 0x8a, 0xa5, 0x96, 0xd7, 0xca, 0x96, 0xd7, 0x7a,
+	0x9d, 0x2d, 0x6f, 0x7b, 0x56, 0x48, 0x38, 0x3e, 0x6f, 0xe4, 0x9d, 0x79, 0x01, 0x6e, 0x0b, 0xac,
+	0xb8, 0x35, 0xde, 0xa1, 0x9a, 0x7c, 0x4b, 0x6f, 0xcc, 0x41, 0x3f, 0xe6, 0xcc, 0x5d, 0x45, 0xcf,
+	0x0b, 0xa6, 0xf6, 0x98, 0x83, 0x7a, 0xcd, 0x06, 0xaa, 0x4a, 0x56, 0x2d, 0x7d, 0x56, 0x4a, 0x47,
+	0x22, 0xb5, 0xaf, 0xe4, 0x4b, 0xa1, 0x10, 0x27, 0x5c, 0x0a, 0xad, 0x64, 0x42, 0x45, 0xa6, 0x83,
+	0x59, 0xf3, 0x43, 0x1e, 0x55, 0x26, 0x9e, 0xfe, 0x7b, 0x43, 0x17, 0x5d, 0x90, 0xc0, 0xed, 0x41,
+	0x14, 0xf6, 0xb8, 0x9c, 0x78, 0xc1, 0xa9, 0x00, 0x09, 0x5e, 0xc8, 0x44, 0x93, 0xa0, 0xda, 0x2f,
+	0x0e, 0x15, 0x63, 0x93, 0xee, 0x54, 0x1d, 0x38, 0xea, 0x60, 0x3c, 0x47, 0xc5, 0x3f, 0xf3, 0x8c,
+	0x84, 0xb7, 0x5f, 0x9e, 0x5c, 0x98, 0xf9, 0xd3, 0x0b, 0x33, 0xff, 0xfd, 0xc2, 0xcc, 0x1f, 0x5f,
+	0x9a, 0xb9, 0xd3, 0x4b, 0x33, 0xf7, 0xf5, 0xd2, 0xcc, 0x1d, 0xd8, 0xd7, 0x56, 0x92, 0xf5, 0xa3,
+	0x64, 0x23, 0x86, 0xd1, 0xb5, 0x3f, 0xd9, 0xa3, 0x6b, 0xb1, 0xdc, 0x4f, 0xaf, 0x2c, 0x8d, 0xf0,
+	0xf8, 0xe7, 0x00, 0xd0, 0xa4, 0xe0, 0xf7, 0x44, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -202,6 +426,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomConversions) > 0 {
+		for iNdEx := len(m.DenomConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.FeeFreeze != nil {
+		{
+			size, err := m.FeeFreeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ParamsRamp != nil {
+		{
+			size, err := m.ParamsRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScheduledParams) > 0 {
+		for iNdEx := len(m.ScheduledParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -245,26 +535,72 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExemptGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExemptGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ByteWindow) > 0 {
+		dAtA6 := make([]byte, len(m.ByteWindow)*10)
+		var j5 int
+		for _, num := range m.ByteWindow {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.BaseBytePrice.Size()
+		i -= size
+		if _, err := m.BaseBytePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if m.ExcessGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExcessGas))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Index != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
 		i--
 		dAtA[i] = 0x20
 	}
 	if len(m.Window) > 0 {
-		dAtA4 := make([]byte, len(m.Window)*10)
-		var j3 int
+		dAtA9 := make([]byte, len(m.Window)*10)
+		var j8 int
 		for _, num := range m.Window {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j8++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintGenesis(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -291,6 +627,84 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -312,6 +726,32 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledParams) > 0 {
+		for _, e := range m.ScheduledParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ParamsRamp != nil {
+		l = m.ParamsRamp.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FeeFreeze != nil {
+		l = m.FeeFreeze.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomConversions) > 0 {
+		for _, e := range m.DenomConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -335,6 +775,52 @@ func (m *State) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovGenesis(uint64(m.Index))
 	}
+	if m.ExcessGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExcessGas))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseBytePrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ByteWindow) > 0 {
+		l = 0
+		for _, e := range m.ByteWindow {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.ExemptGas != 0 {
+		n += 1 + sovGenesis(uint64(m.ExemptGas))
+	}
+	return n
+}
+
+func (m *FeeFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *DenomConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -439,24 +925,198 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParams = append(m.ScheduledParams, ScheduledParams{})
+			if err := m.ScheduledParams[len(m.ScheduledParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParamsRamp == nil {
+				m.ParamsRamp = &ParamsRamp{}
+			}
+			if err := m.ParamsRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeFreeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeFreeze == nil {
+				m.FeeFreeze = &FeeFreeze{}
+			}
+			if err := m.FeeFreeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomConversions = append(m.DenomConversions, DenomConversion{})
+			if err := m.DenomConversions[len(m.DenomConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -652,6 +1312,406 @@ func (m *State) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessGas", wireType)
+			}
+			m.ExcessGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseBytePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseBytePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ByteWindow = append(m.ByteWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ByteWindow) == 0 {
+					m.ByteWindow = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ByteWindow = append(m.ByteWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteWindow", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptGas", wireType)
+			}
+			m.ExemptGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExemptGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
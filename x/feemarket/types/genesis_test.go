@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -18,4 +20,91 @@ func TestGenesis(t *testing.T) {
 		gs := types.DefaultAIMDGenesisState()
 		require.NoError(t, gs.ValidateBasic())
 	})
+
+	t.Run("can accept scheduled params", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(10, types.DefaultParams(), false),
+			types.NewScheduledParams(20, types.DefaultAIMDParams(), true),
+		}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects scheduled params with a non-positive height", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(0, types.DefaultParams(), false),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects duplicate scheduled params", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(10, types.DefaultParams(), false),
+			types.NewScheduledParams(10, types.DefaultAIMDParams(), false),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects invalid scheduled params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.Window = 0
+
+		gs := types.DefaultGenesisState()
+		gs.ScheduledParams = []types.ScheduledParams{
+			types.NewScheduledParams(10, params, false),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects an invalid params ramp", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.DefaultAIMDParams(), 10, 0)
+		gs.ParamsRamp = &ramp
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects an invalid fee freeze", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		freeze := types.NewFeeFreeze(math.LegacyZeroDec(), 10)
+		gs.FeeFreeze = &freeze
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects invalid burned fees", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.BurnedFees = sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 10)}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("accepts denom conversions", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.DenomConversions = []types.DenomConversion{
+			types.NewDenomConversion("atom", math.LegacyNewDec(2)),
+			types.NewDenomConversion("osmo", math.LegacyMustNewDecFromStr("0.5")),
+		}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects an invalid denom conversion", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.DenomConversions = []types.DenomConversion{types.NewDenomConversion("atom", math.LegacyZeroDec())}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects a denom conversion for the fee denom", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.DenomConversions = []types.DenomConversion{types.NewDenomConversion(gs.Params.FeeDenom, math.OneInt().ToLegacyDec())}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects duplicate denom conversions", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.DenomConversions = []types.DenomConversion{
+			types.NewDenomConversion("atom", math.LegacyNewDec(2)),
+			types.NewDenomConversion("atom", math.LegacyNewDec(3)),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
 }
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the feemarket module.
 	ModuleName = "feemarket"
@@ -13,7 +17,12 @@ const (
 const (
 	prefixParams = iota + 1
 	prefixState
-	prefixEnableHeight = 3
+	prefixEnableHeight    = 3
+	prefixScheduledParams = 4
+	prefixParamsRamp      = 5
+	prefixFeeFreeze       = 6
+	prefixBurnedFees      = 7
+	prefixDenomConversion = 8
 )
 
 var (
@@ -26,9 +35,103 @@ var (
 	// KeyEnabledHeight is the store key for the feemarket module's enabled height.
 	KeyEnabledHeight = []byte{prefixEnableHeight}
 
+	// KeyScheduledParamsPrefix is the store key prefix for the feemarket module's
+	// scheduled parameter changes, keyed by height.
+	KeyScheduledParamsPrefix = []byte{prefixScheduledParams}
+
+	// KeyParamsRamp is the store key for the feemarket module's parameter ramp in progress.
+	KeyParamsRamp = []byte{prefixParamsRamp}
+
+	// KeyFeeFreeze is the store key for the feemarket module's freeze of the base gas price.
+	KeyFeeFreeze = []byte{prefixFeeFreeze}
+
+	// KeyBurnedFeesPrefix is the store key prefix for the feemarket module's total
+	// burned fees, keyed by denom.
+	KeyBurnedFeesPrefix = []byte{prefixBurnedFees}
+
+	// KeyDenomConversionPrefix is the store key prefix for the feemarket module's denom
+	// conversion table, keyed by denom.
+	KeyDenomConversionPrefix = []byte{prefixDenomConversion}
+
+	EventTypeFeeExempt    = "fee_exempt"
+	AttributeKeyExemptGas = "exempt_gas"
+
 	EventTypeFeePay      = "fee_pay"
+	EventTypeFeeBurn     = "fee_burn"
 	EventTypeTipPay      = "tip_pay"
 	AttributeKeyTip      = "tip"
 	AttributeKeyTipPayer = "tip_payer"
 	AttributeKeyTipPayee = "tip_payee"
+
+	EventTypeFeeRefund   = "fee_refund"
+	AttributeKeyRefund   = "refund"
+	AttributeKeyRefundee = "refundee"
+
+	EventTypeFeeDistribute    = "fee_distribute"
+	EventTypeFeeCommunityPool = "fee_community_pool"
+	AttributeKeyFeeRecipient  = "fee_recipient"
+
+	EventTypeFeeMarketUpdate      = "fee_market_update"
+	AttributeKeyBaseGasPrice      = "base_gas_price"
+	AttributeKeyLearningRate      = "learning_rate"
+	AttributeKeyBaseGasPriceBound = "base_gas_price_bound"
+	AttributeKeyBaseBytePrice     = "base_byte_price"
+
+	EventTypeFeeMarketSaturation = "fee_market_saturation"
+	AttributeKeySaturatedValue   = "saturated_value"
+	AttributeKeySaturationLimit  = "saturation_limit"
+
+	EventTypeParamsUpdate = "params_update"
+	AttributeKeyParam     = "param"
+	AttributeKeyOldValue  = "old_value"
+	AttributeKeyNewValue  = "new_value"
+
+	EventTypeScheduledParamsApplied = "scheduled_params_applied"
+	EventTypeScheduledParamsFailed  = "scheduled_params_failed"
+	EventTypeParamsRampCompleted    = "params_ramp_completed"
+	EventTypeParamsRampFailed       = "params_ramp_failed"
+	AttributeKeyHeight              = "height"
+	AttributeKeyError               = "error"
+
+	EventTypeFeeFreeze       = "fee_freeze"
+	EventTypeFeeFreezeLifted = "fee_freeze_lifted"
+	EventTypeFeeMarketFrozen = "fee_market_frozen"
+	AttributeKeyEndHeight    = "end_height"
+	AttributeKeySigner       = "signer"
+
+	EventTypeDenomConversionSet     = "denom_conversion_set"
+	EventTypeDenomConversionRemoved = "denom_conversion_removed"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyRate                = "rate"
+)
+
+// GetBurnedFeesKey returns the store key of the total burned fees of the given denom.
+func GetBurnedFeesKey(denom string) []byte {
+	return append(append([]byte{}, KeyBurnedFeesPrefix...), []byte(denom)...)
+}
+
+// GetDenomConversionKey returns the store key of the conversion of the given denom.
+func GetDenomConversionKey(denom string) []byte {
+	return append(append([]byte{}, KeyDenomConversionPrefix...), []byte(denom)...)
+}
+
+// GetScheduledParamsKey returns the store key of the scheduled parameter change at
+// the given height. Heights are big-endian encoded so that changes are iterated in
+// height order.
+func GetScheduledParamsKey(height int64) []byte {
+	return append(append([]byte{}, KeyScheduledParamsPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+const (
+	// BaseGasPriceBoundNone indicates that the base gas price update was not clamped.
+	BaseGasPriceBoundNone = "none"
+	// BaseGasPriceBoundMaxIncrease indicates that the base gas price update was
+	// clamped by the max per-block increase.
+	BaseGasPriceBoundMaxIncrease = "max_increase"
+	// BaseGasPriceBoundMaxDecrease indicates that the base gas price update was
+	// clamped by the max per-block decrease.
+	BaseGasPriceBoundMaxDecrease = "max_decrease"
+	// BaseGasPriceBoundMaxBaseGasPrice indicates that the base gas price update
+	// was clamped by the max base gas price.
+	BaseGasPriceBoundMaxBaseGasPrice = "max_base_gas_price"
 )
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// MaxExcessGasExponent is the maximum value of ExcessGas / ExcessGasUpdateFraction.
+// The excess gas is capped accordingly, which bounds the base gas price of the
+// exponential pricing model to MinBaseGasPrice * e^MaxExcessGasExponent.
+const MaxExcessGasExponent uint64 = 64
+
+// MaxExcessGasUpdateFraction is the maximum excess gas update fraction. Together
+// with the bound on the min base gas price of the exponential pricing model, this
+// keeps the intermediate values of FakeExponential within the range of
+// math.LegacyDec.
+const MaxExcessGasUpdateFraction uint64 = 1 << 40
+
+// MaxDecValue is the saturation limit of the fee market arithmetic. Base prices and
+// learning rates that would exceed it are capped at it instead of overflowing the
+// range of math.LegacyDec, which is roughly 1.3e77.
+var MaxDecValue = math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 50))
+
+// MaxBaseGasPriceIncreaseLimit is the upper bound of the MaxBaseGasPriceIncrease
+// param, i.e. the base gas price can grow at most 101-fold in a single block.
+var MaxBaseGasPriceIncreaseLimit = math.LegacyNewDec(100)
+
+// MaxBlockTimeScale is the maximum factor by which the base gas price adjustment
+// is scaled when the time between blocks exceeds the target block time.
+var MaxBlockTimeScale = math.LegacyNewDec(10)
+
+// FakeExponential approximates factor * e^(numerator / denominator) using a
+// Taylor expansion in fixed-point arithmetic. This is the decimal equivalent of
+// the fake_exponential function specified in EIP-4844 and is deterministic
+// across platforms. The denominator must be positive.
+func FakeExponential(factor math.LegacyDec, numerator, denominator math.Int) math.LegacyDec {
+	output := math.LegacyZeroDec()
+	accum := factor.MulInt(denominator)
+	for i := int64(1); accum.IsPositive(); i++ {
+		output = output.Add(accum)
+		accum = accum.MulInt(numerator).QuoInt(denominator.MulRaw(i))
+	}
+
+	return output.QuoInt(denominator)
+}
+
+// SaturatingAdd returns a + b bounded to [-MaxDecValue, MaxDecValue]. The bound is
+// checked before adding, so the sum never overflows.
+func SaturatingAdd(a, b math.LegacyDec) math.LegacyDec {
+	if a.IsNegative() == b.IsNegative() && a.Abs().GT(MaxDecValue.Sub(b.Abs())) {
+		if a.IsNegative() {
+			return MaxDecValue.Neg()
+		}
+		return MaxDecValue
+	}
+
+	return saturate(a.Add(b))
+}
+
+// SaturatingMul returns a * b bounded to [-MaxDecValue, MaxDecValue]. The bound is
+// checked before multiplying, so the product never overflows.
+func SaturatingMul(a, b math.LegacyDec) math.LegacyDec {
+	if a.IsZero() || b.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	if a.Abs().GT(MaxDecValue.Quo(b.Abs())) {
+		if a.IsNegative() != b.IsNegative() {
+			return MaxDecValue.Neg()
+		}
+		return MaxDecValue
+	}
+
+	return saturate(a.Mul(b))
+}
+
+// IsSaturated returns true if the given value is at the saturation limit.
+func IsSaturated(d math.LegacyDec) bool {
+	return !d.IsNil() && d.Abs().GTE(MaxDecValue)
+}
+
+// saturate bounds the given value to [-MaxDecValue, MaxDecValue].
+func saturate(d math.LegacyDec) math.LegacyDec {
+	switch {
+	case d.GT(MaxDecValue):
+		return MaxDecValue
+	case d.LT(MaxDecValue.Neg()):
+		return MaxDecValue.Neg()
+	default:
+		return d
+	}
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	gomath "math"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestFakeExponential(t *testing.T) {
+	testCases := []struct {
+		name        string
+		factor      math.LegacyDec
+		numerator   int64
+		denominator int64
+	}{
+		{
+			name:        "zero exponent",
+			factor:      math.LegacyNewDec(38493),
+			numerator:   0,
+			denominator: 1000,
+		},
+		{
+			name:        "exponent of one",
+			factor:      math.LegacyOneDec(),
+			numerator:   1,
+			denominator: 1,
+		},
+		{
+			name:        "fractional exponent",
+			factor:      math.LegacyMustNewDecFromStr("0.0025"),
+			numerator:   15_000_000,
+			denominator: 127_352_805,
+		},
+		{
+			name:        "large exponent",
+			factor:      math.LegacyNewDec(1_000_000_000),
+			numerator:   int64(types.MaxExcessGasExponent) * 127_352_805,
+			denominator: 127_352_805,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := types.FakeExponential(tc.factor, math.NewInt(tc.numerator), math.NewInt(tc.denominator))
+
+			expected := tc.factor.MustFloat64() * gomath.Exp(float64(tc.numerator)/float64(tc.denominator))
+			require.InEpsilon(t, expected, result.MustFloat64(), 1e-9)
+		})
+	}
+
+	t.Run("zero factor", func(t *testing.T) {
+		result := types.FakeExponential(math.LegacyZeroDec(), math.NewInt(10), math.NewInt(1))
+		require.True(t, result.IsZero())
+	})
+
+	t.Run("monotonic in the numerator", func(t *testing.T) {
+		prev := math.LegacyZeroDec()
+		for i := int64(0); i < 100; i++ {
+			result := types.FakeExponential(math.LegacyOneDec(), math.NewInt(i), math.NewInt(10))
+			require.True(t, result.GT(prev))
+			prev = result
+		}
+	})
+}
+
+func TestSaturatingArithmetic(t *testing.T) {
+	limit := types.MaxDecValue
+
+	t.Run("add within bounds", func(t *testing.T) {
+		require.Equal(t, math.LegacyNewDec(3), types.SaturatingAdd(math.LegacyOneDec(), math.LegacyNewDec(2)))
+	})
+
+	t.Run("add saturates at the limit", func(t *testing.T) {
+		require.Equal(t, limit, types.SaturatingAdd(limit, limit))
+		require.Equal(t, limit.Neg(), types.SaturatingAdd(limit.Neg(), limit.Neg()))
+	})
+
+	t.Run("mul within bounds", func(t *testing.T) {
+		require.Equal(t, math.LegacyNewDec(6), types.SaturatingMul(math.LegacyNewDec(2), math.LegacyNewDec(3)))
+		require.True(t, types.SaturatingMul(limit, math.LegacyZeroDec()).IsZero())
+	})
+
+	t.Run("mul saturates at the limit", func(t *testing.T) {
+		require.Equal(t, limit, types.SaturatingMul(limit, math.LegacyNewDec(2)))
+		require.Equal(t, limit, types.SaturatingMul(limit.Neg(), math.LegacyNewDec(-2)))
+		require.Equal(t, limit.Neg(), types.SaturatingMul(limit, math.LegacyNewDec(-2)))
+	})
+
+	t.Run("mul does not overflow the range of math.LegacyDec", func(t *testing.T) {
+		huge := math.LegacyNewDec(10).Power(70)
+		require.NotPanics(t, func() {
+			require.Equal(t, limit, types.SaturatingMul(huge, huge))
+		})
+	})
+
+	t.Run("is saturated", func(t *testing.T) {
+		require.True(t, types.IsSaturated(limit))
+		require.True(t, types.IsSaturated(limit.Neg()))
+		require.False(t, types.IsSaturated(limit.Sub(math.LegacyOneDec())))
+		require.False(t, types.IsSaturated(math.LegacyDec{}))
+	})
+}
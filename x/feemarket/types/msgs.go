@@ -1,10 +1,24 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgParams{}
+var (
+	_ sdk.Msg = &MsgParams{}
+	_ sdk.Msg = &MsgUpdateParamsPartial{}
+	_ sdk.Msg = &MsgScheduleParams{}
+	_ sdk.Msg = &MsgCancelScheduledParams{}
+	_ sdk.Msg = &MsgRampParams{}
+	_ sdk.Msg = &MsgFreezeBaseGasPrice{}
+	_ sdk.Msg = &MsgExtendFeeFreeze{}
+	_ sdk.Msg = &MsgLiftFeeFreeze{}
+	_ sdk.Msg = &MsgSetDenomConversion{}
+	_ sdk.Msg = &MsgRemoveDenomConversion{}
+)
 
 // NewMsgParams returns a new message to update the x/feemarket module's parameters.
 func NewMsgParams(authority string, params Params) MsgParams {
@@ -31,3 +45,265 @@ func (m *MsgParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParamsPartial returns a new message to update the x/feemarket module's parameters
+// listed in the update mask.
+func NewMsgUpdateParamsPartial(authority string, params Params, updateMask []string) MsgUpdateParamsPartial {
+	return MsgUpdateParamsPartial{
+		Authority:  authority,
+		Params:     params,
+		UpdateMask: updateMask,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgUpdateParamsPartial) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the update mask is not empty.
+func (m *MsgUpdateParamsPartial) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if len(m.UpdateMask) == 0 {
+		return fmt.Errorf("update mask cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgScheduleParams returns a new message to schedule the given x/feemarket module parameters
+// to take effect at the given height.
+func NewMsgScheduleParams(authority string, height int64, params Params, resetState bool) MsgScheduleParams {
+	return MsgScheduleParams{
+		Authority:       authority,
+		ScheduledParams: NewScheduledParams(height, params, resetState),
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgScheduleParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the scheduled params are valid.
+func (m *MsgScheduleParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.ScheduledParams.ValidateBasic()
+}
+
+// NewMsgCancelScheduledParams returns a new message to cancel the x/feemarket module parameters
+// scheduled at the given height.
+func NewMsgCancelScheduledParams(authority string, height int64) MsgCancelScheduledParams {
+	return MsgCancelScheduledParams{
+		Authority: authority,
+		Height:    height,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgCancelScheduledParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the height is positive.
+func (m *MsgCancelScheduledParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("height must be positive; got %d", m.Height)
+	}
+
+	return nil
+}
+
+// NewMsgRampParams returns a new message to ramp the x/feemarket module's parameters to the
+// target parameters over the given number of blocks.
+func NewMsgRampParams(authority string, target Params, blocks uint64) MsgRampParams {
+	return MsgRampParams{
+		Authority:    authority,
+		TargetParams: target,
+		Blocks:       blocks,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgRampParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, the number of blocks is positive and the target params
+// are valid.
+func (m *MsgRampParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Blocks == 0 {
+		return fmt.Errorf("ramp blocks must be positive")
+	}
+
+	return m.TargetParams.ValidateBasic()
+}
+
+// NewMsgFreezeBaseGasPrice returns a new message to freeze the x/feemarket module's base gas
+// price at the given price for the given number of blocks.
+func NewMsgFreezeBaseGasPrice(signer string, baseGasPrice math.LegacyDec, blocks uint64) MsgFreezeBaseGasPrice {
+	return MsgFreezeBaseGasPrice{
+		Signer:       signer,
+		BaseGasPrice: baseGasPrice,
+		Blocks:       blocks,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgFreezeBaseGasPrice) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the number of blocks is positive and the base gas price is
+// valid.
+func (m *MsgFreezeBaseGasPrice) ValidateBasic() error {
+	// validate signer address
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return err
+	}
+
+	if m.Blocks == 0 {
+		return fmt.Errorf("freeze blocks must be positive")
+	}
+
+	return ValidateFrozenBaseGasPrice(m.BaseGasPrice)
+}
+
+// NewMsgExtendFeeFreeze returns a new message to extend the freeze of the x/feemarket module's
+// base gas price by the given number of blocks.
+func NewMsgExtendFeeFreeze(authority string, blocks uint64) MsgExtendFeeFreeze {
+	return MsgExtendFeeFreeze{
+		Authority: authority,
+		Blocks:    blocks,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgExtendFeeFreeze) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the number of blocks is positive.
+func (m *MsgExtendFeeFreeze) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Blocks == 0 {
+		return fmt.Errorf("freeze blocks must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgLiftFeeFreeze returns a new message to lift the freeze of the x/feemarket module's base
+// gas price.
+func NewMsgLiftFeeFreeze(authority string) MsgLiftFeeFreeze {
+	return MsgLiftFeeFreeze{
+		Authority: authority,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgLiftFeeFreeze) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address.
+func (m *MsgLiftFeeFreeze) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
+
+// NewMsgSetDenomConversion returns a new message to accept the given denom for fees at the
+// given conversion rate.
+func NewMsgSetDenomConversion(authority string, denomConversion DenomConversion) MsgSetDenomConversion {
+	return MsgSetDenomConversion{
+		Authority:       authority,
+		DenomConversion: denomConversion,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetDenomConversion) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the denom conversion is valid.
+func (m *MsgSetDenomConversion) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.DenomConversion.ValidateBasic()
+}
+
+// NewMsgRemoveDenomConversion returns a new message to no longer accept the given denom for fees.
+func NewMsgRemoveDenomConversion(authority string, denom string) MsgRemoveDenomConversion {
+	return MsgRemoveDenomConversion{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgRemoveDenomConversion) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the denom is valid.
+func (m *MsgRemoveDenomConversion) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -22,3 +23,203 @@ func TestMsgParams(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestMsgUpdateParamsPartial(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial("invalid", types.DefaultParams(), []string{"fee_denom"})
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an empty update mask", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial(sdk.AccAddress("test").String(), types.DefaultParams(), nil)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address and update mask", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial(sdk.AccAddress("test").String(), types.DefaultParams(), []string{"fee_denom"})
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgScheduleParams(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams("invalid", 10, types.DefaultParams(), false)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive height", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 0, types.DefaultParams(), false)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with invalid params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.Window = 0
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 10, params, false)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with invalid params that disable the fee market", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 10, types.Params{}, false)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address, height and params", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 10, types.DefaultParams(), false)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgCancelScheduledParams(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams("invalid", 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive height", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams(sdk.AccAddress("test").String(), 0)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address and height", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams(sdk.AccAddress("test").String(), 10)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgRampParams(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgRampParams("invalid", types.DefaultAIMDParams(), 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message without blocks", func(t *testing.T) {
+		msg := types.NewMsgRampParams(sdk.AccAddress("test").String(), types.DefaultAIMDParams(), 0)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address, blocks and params", func(t *testing.T) {
+		msg := types.NewMsgRampParams(sdk.AccAddress("test").String(), types.DefaultAIMDParams(), 10)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgFreezeBaseGasPrice(t *testing.T) {
+	t.Run("should reject a message with an invalid signer address", func(t *testing.T) {
+		msg := types.NewMsgFreezeBaseGasPrice("invalid", math.LegacyOneDec(), 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message without blocks", func(t *testing.T) {
+		msg := types.NewMsgFreezeBaseGasPrice(sdk.AccAddress("test").String(), math.LegacyOneDec(), 0)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive base gas price", func(t *testing.T) {
+		msg := types.NewMsgFreezeBaseGasPrice(sdk.AccAddress("test").String(), math.LegacyZeroDec(), 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid signer address, base gas price and blocks", func(t *testing.T) {
+		msg := types.NewMsgFreezeBaseGasPrice(sdk.AccAddress("test").String(), math.LegacyOneDec(), 10)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgExtendFeeFreeze(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgExtendFeeFreeze("invalid", 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message without blocks", func(t *testing.T) {
+		msg := types.NewMsgExtendFeeFreeze(sdk.AccAddress("test").String(), 0)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address and blocks", func(t *testing.T) {
+		msg := types.NewMsgExtendFeeFreeze(sdk.AccAddress("test").String(), 10)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgLiftFeeFreeze(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgLiftFeeFreeze("invalid")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address", func(t *testing.T) {
+		msg := types.NewMsgLiftFeeFreeze(sdk.AccAddress("test").String())
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgSetDenomConversion(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetDenomConversion("invalid", types.NewDenomConversion("atom", math.LegacyNewDec(2)))
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an invalid denom", func(t *testing.T) {
+		msg := types.NewMsgSetDenomConversion(sdk.AccAddress("test").String(), types.NewDenomConversion("1", math.LegacyNewDec(2)))
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message without a positive rate", func(t *testing.T) {
+		msg := types.NewMsgSetDenomConversion(sdk.AccAddress("test").String(), types.NewDenomConversion("atom", math.LegacyZeroDec()))
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address and conversion", func(t *testing.T) {
+		msg := types.NewMsgSetDenomConversion(sdk.AccAddress("test").String(), types.NewDenomConversion("atom", math.LegacyNewDec(2)))
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgRemoveDenomConversion(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenomConversion("invalid", "atom")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an invalid denom", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenomConversion(sdk.AccAddress("test").String(), "")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address and denom", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenomConversion(sdk.AccAddress("test").String(), "atom")
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewParams instantiates a new EIP-1559 Params object. This params object is utilized
@@ -15,28 +17,82 @@ func NewParams(
 	gamma math.LegacyDec,
 	delta math.LegacyDec,
 	maxBlockSize uint64,
+	targetUtilizationRatio math.LegacyDec,
 	minBaseGasPrice math.LegacyDec,
+	maxBaseGasPrice math.LegacyDec,
+	maxBaseGasPriceIncrease math.LegacyDec,
+	maxBaseGasPriceDecrease math.LegacyDec,
 	minLearingRate math.LegacyDec,
 	maxLearningRate math.LegacyDec,
 	feeDenom string,
 	enabled bool,
 	distributeFees bool,
 	sendTipToProposer bool,
+	pricingModel string,
+	excessGasUpdateFraction uint64,
+	pidProportionalGain math.LegacyDec,
+	pidIntegralGain math.LegacyDec,
+	pidDerivativeGain math.LegacyDec,
+	pidIntegralLimit math.LegacyDec,
+	targetBlockTime time.Duration,
+	downtimeThreshold time.Duration,
+	maxBlockBytes uint64,
+	targetBlockBytes uint64,
+	minBaseBytePrice math.LegacyDec,
+	byteLearningRate math.LegacyDec,
+	msgFeeMultipliers []MsgFeeMultiplier,
+	guardian string,
+	maxFreezeBlocks uint64,
+	feeExemptions []FeeExemption,
+	maxBlockExemptGas uint64,
+	burnFees bool,
+	feeSplit *FeeSplit,
+	tipSplit *TipSplit,
+	distributeTipsToDelegators bool,
+	unusedGasPolicy UnusedGasPolicy,
+	unusedGasPenalty math.LegacyDec,
 ) Params {
 	return Params{
-		Alpha:               alpha,
-		Beta:                beta,
-		Gamma:               gamma,
-		Delta:               delta,
-		MinBaseGasPrice:     minBaseGasPrice,
-		MinLearningRate:     minLearingRate,
-		MaxLearningRate:     maxLearningRate,
-		MaxBlockUtilization: maxBlockSize,
-		Window:              window,
-		FeeDenom:            feeDenom,
-		Enabled:             enabled,
-		DistributeFees:      distributeFees,
-		SendTipToProposer:   sendTipToProposer,
+		Alpha:                      alpha,
+		Beta:                       beta,
+		Gamma:                      gamma,
+		Delta:                      delta,
+		MinBaseGasPrice:            minBaseGasPrice,
+		MaxBaseGasPrice:            maxBaseGasPrice,
+		MaxBaseGasPriceIncrease:    maxBaseGasPriceIncrease,
+		MaxBaseGasPriceDecrease:    maxBaseGasPriceDecrease,
+		MinLearningRate:            minLearingRate,
+		MaxLearningRate:            maxLearningRate,
+		MaxBlockUtilization:        maxBlockSize,
+		TargetUtilizationRatio:     targetUtilizationRatio,
+		Window:                     window,
+		FeeDenom:                   feeDenom,
+		Enabled:                    enabled,
+		DistributeFees:             distributeFees,
+		SendTipToProposer:          sendTipToProposer,
+		PricingModel:               pricingModel,
+		ExcessGasUpdateFraction:    excessGasUpdateFraction,
+		PidProportionalGain:        pidProportionalGain,
+		PidIntegralGain:            pidIntegralGain,
+		PidDerivativeGain:          pidDerivativeGain,
+		PidIntegralLimit:           pidIntegralLimit,
+		TargetBlockTime:            targetBlockTime,
+		DowntimeThreshold:          downtimeThreshold,
+		MaxBlockBytes:              maxBlockBytes,
+		TargetBlockBytes:           targetBlockBytes,
+		MinBaseBytePrice:           minBaseBytePrice,
+		ByteLearningRate:           byteLearningRate,
+		MsgFeeMultipliers:          msgFeeMultipliers,
+		Guardian:                   guardian,
+		MaxFreezeBlocks:            maxFreezeBlocks,
+		FeeExemptions:              feeExemptions,
+		MaxBlockExemptGas:          maxBlockExemptGas,
+		BurnFees:                   burnFees,
+		FeeSplit:                   feeSplit,
+		TipSplit:                   tipSplit,
+		DistributeTipsToDelegators: distributeTipsToDelegators,
+		UnusedGasPolicy:            unusedGasPolicy,
+		UnusedGasPenalty:           unusedGasPenalty,
 	}
 }
 
@@ -62,18 +118,34 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("delta cannot be nil and must be between [0, inf)")
 	}
 
-	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.GTE(math.LegacyZeroDec()) {
-		return fmt.Errorf("min base gas price cannot be nil and must be greater than or equal to zero")
+	// every pricing model can lower the base gas price to its minimum, so a zero minimum
+	// would allow a state with a zero base gas price, which is invalid
+	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.IsPositive() {
+		return fmt.Errorf("min base gas price cannot be nil and must be positive")
 	}
 
-	if p.MaxLearningRate.IsNil() || p.MinLearningRate.IsNegative() {
-		return fmt.Errorf("min learning rate cannot be negative or nil")
+	if p.MinBaseGasPrice.GT(MaxDecValue) {
+		return fmt.Errorf("min base gas price cannot exceed %s", MaxDecValue)
+	}
+
+	// the learning rate can decrease to its minimum, so a zero minimum would allow a state
+	// with a zero learning rate, which is invalid
+	if p.MinLearningRate.IsNil() || !p.MinLearningRate.IsPositive() {
+		return fmt.Errorf("min learning rate must be positive")
 	}
 
 	if p.MaxBlockUtilization < 2 {
 		return fmt.Errorf("max block utilization cannot be less than 2")
 	}
 
+	if p.TargetUtilizationRatio.IsNil() || !p.TargetUtilizationRatio.IsPositive() || p.TargetUtilizationRatio.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("target utilization ratio cannot be nil and must be between (0, 1)")
+	}
+
+	if p.TargetBlockUtilization() == 0 {
+		return fmt.Errorf("target block utilization cannot be zero")
+	}
+
 	if p.MaxLearningRate.IsNil() || p.MaxLearningRate.IsNegative() {
 		return fmt.Errorf("max learning rate cannot be negative or nil")
 	}
@@ -86,10 +158,255 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("fee denom must be set")
 	}
 
+	if !p.MaxBaseGasPrice.IsNil() {
+		if p.MaxBaseGasPrice.IsNegative() {
+			return fmt.Errorf("max base gas price cannot be negative")
+		}
+
+		if p.MaxBaseGasPrice.IsPositive() && p.MaxBaseGasPrice.LT(p.MinBaseGasPrice) {
+			return fmt.Errorf("max base gas price cannot be less than min base gas price")
+		}
+	}
+
+	if !p.MaxBaseGasPriceIncrease.IsNil() && (p.MaxBaseGasPriceIncrease.IsNegative() || p.MaxBaseGasPriceIncrease.GT(MaxBaseGasPriceIncreaseLimit)) {
+		return fmt.Errorf("max base gas price increase must be between [0, %s]", MaxBaseGasPriceIncreaseLimit)
+	}
+
+	if !p.MaxBaseGasPriceDecrease.IsNil() && (p.MaxBaseGasPriceDecrease.IsNegative() || p.MaxBaseGasPriceDecrease.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("max base gas price decrease must be between [0, 1]")
+	}
+
+	if !p.PidProportionalGain.IsNil() && p.PidProportionalGain.IsNegative() {
+		return fmt.Errorf("pid proportional gain must be between [0, inf)")
+	}
+
+	if !p.PidIntegralGain.IsNil() && p.PidIntegralGain.IsNegative() {
+		return fmt.Errorf("pid integral gain must be between [0, inf)")
+	}
+
+	if !p.PidDerivativeGain.IsNil() && p.PidDerivativeGain.IsNegative() {
+		return fmt.Errorf("pid derivative gain must be between [0, inf)")
+	}
+
+	if !p.PidIntegralLimit.IsNil() && p.PidIntegralLimit.IsNegative() {
+		return fmt.Errorf("pid integral limit must be between [0, inf)")
+	}
+
+	if p.TargetBlockTime < 0 {
+		return fmt.Errorf("target block time cannot be negative")
+	}
+
+	if p.DowntimeThreshold < 0 {
+		return fmt.Errorf("downtime threshold cannot be negative")
+	}
+
+	if p.TargetBlockTime > 0 && p.DowntimeThreshold > 0 && p.DowntimeThreshold < p.TargetBlockTime {
+		return fmt.Errorf("downtime threshold cannot be less than target block time")
+	}
+
+	if !p.MinBaseBytePrice.IsNil() && p.MinBaseBytePrice.IsNegative() {
+		return fmt.Errorf("min base byte price cannot be negative")
+	}
+
+	if !p.MinBaseBytePrice.IsNil() && p.MinBaseBytePrice.GT(MaxDecValue) {
+		return fmt.Errorf("min base byte price cannot exceed %s", MaxDecValue)
+	}
+
+	if !p.ByteLearningRate.IsNil() && p.ByteLearningRate.IsNegative() {
+		return fmt.Errorf("byte learning rate cannot be negative")
+	}
+
+	if p.ByteDimensionEnabled() {
+		if p.TargetBlockBytes == 0 || p.TargetBlockBytes > p.MaxBlockBytes {
+			return fmt.Errorf("target block bytes must be between (0, max block bytes]")
+		}
+
+		if p.MinBaseBytePrice.IsNil() || !p.MinBaseBytePrice.IsPositive() {
+			return fmt.Errorf("min base byte price must be positive when the byte dimension is enabled")
+		}
+
+		if p.ByteLearningRate.IsNil() {
+			return fmt.Errorf("byte learning rate must be set when the byte dimension is enabled")
+		}
+	}
+
+	seenMsgTypeURLs := make(map[string]struct{}, len(p.MsgFeeMultipliers))
+	for _, m := range p.MsgFeeMultipliers {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seenMsgTypeURLs[m.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate fee multiplier for msg type %s", m.MsgTypeUrl)
+		}
+		seenMsgTypeURLs[m.MsgTypeUrl] = struct{}{}
+	}
+
+	if _, ok := UtilizationMode_name[int32(p.UtilizationMode)]; !ok {
+		return fmt.Errorf("unknown utilization mode %d", p.UtilizationMode)
+	}
+
+	if p.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %w", err)
+		}
+
+		if p.MaxFreezeBlocks == 0 {
+			return fmt.Errorf("max freeze blocks must be positive when the guardian is set")
+		}
+	}
+
+	type exemptionKey struct{ msgTypeURL, address string }
+	seenExemptions := make(map[exemptionKey]struct{}, len(p.FeeExemptions))
+	for _, e := range p.FeeExemptions {
+		if err := e.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := exemptionKey{e.MsgTypeUrl, e.Address}
+		if _, ok := seenExemptions[key]; ok {
+			return fmt.Errorf("duplicate fee exemption for msg type %q and address %q", e.MsgTypeUrl, e.Address)
+		}
+		seenExemptions[key] = struct{}{}
+	}
+
+	if len(p.FeeExemptions) > 0 && p.MaxBlockExemptGas == 0 {
+		return fmt.Errorf("max block exempt gas must be positive when fee exemptions are set")
+	}
+
+	if p.DistributeFees && p.BurnFees {
+		return fmt.Errorf("fees cannot be both distributed and burned")
+	}
+
+	if p.FeeSplit != nil {
+		if p.DistributeFees || p.BurnFees {
+			return fmt.Errorf("fee split cannot be set when fees are distributed or burned")
+		}
+
+		if err := p.FeeSplit.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if p.TipSplit != nil {
+		if err := p.TipSplit.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := UnusedGasPolicy_name[int32(p.UnusedGasPolicy)]; !ok {
+		return fmt.Errorf("unknown unused gas policy %d", p.UnusedGasPolicy)
+	}
+
+	if !p.UnusedGasPenalty.IsNil() && (p.UnusedGasPenalty.IsNegative() || p.UnusedGasPenalty.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("unused gas penalty must be between [0, 1]")
+	}
+
+	return nil
+}
+
+// GetMsgFeeMultiplier returns the fee multiplier of the given message type URL.
+// Message types without a multiplier have a multiplier of one.
+func (p *Params) GetMsgFeeMultiplier(msgTypeURL string) math.LegacyDec {
+	for _, m := range p.MsgFeeMultipliers {
+		if m.MsgTypeUrl == msgTypeURL {
+			return m.Multiplier
+		}
+	}
+
+	return math.LegacyOneDec()
+}
+
+// GetTxFeeMultiplier returns the fee multiplier of a transaction with the given
+// messages, which is the highest multiplier of its messages. The gas consumed by
+// each message is not known before execution, so the multipliers cannot be
+// weighted by gas in the ante handler.
+func (p *Params) GetTxFeeMultiplier(msgs []sdk.Msg) math.LegacyDec {
+	if len(msgs) == 0 {
+		return math.LegacyOneDec()
+	}
+
+	multiplier := p.GetMsgFeeMultiplier(sdk.MsgTypeURL(msgs[0]))
+	for _, msg := range msgs[1:] {
+		multiplier = math.LegacyMaxDec(multiplier, p.GetMsgFeeMultiplier(sdk.MsgTypeURL(msg)))
+	}
+
+	return multiplier
+}
+
+// ValidateBasic performs basic validation on the fee multiplier.
+func (m *MsgFeeMultiplier) ValidateBasic() error {
+	if m.MsgTypeUrl == "" {
+		return fmt.Errorf("fee multiplier msg type url cannot be empty")
+	}
+
+	if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() {
+		return fmt.Errorf("fee multiplier for msg type %s must be positive", m.MsgTypeUrl)
+	}
+
 	return nil
 }
 
-// TargetBlockUtilization returns 0.5 * MaxBlockUtilization.
+// ByteDimensionEnabled returns true if transactions are also priced by their size
+// in bytes.
+func (p *Params) ByteDimensionEnabled() bool {
+	return p.MaxBlockBytes > 0
+}
+
+// TargetBlockUtilization returns TargetUtilizationRatio * MaxBlockUtilization,
+// truncated to an integer.
 func (p *Params) TargetBlockUtilization() uint64 {
-	return p.MaxBlockUtilization / 2
+	ratio := p.GetTargetUtilizationRatio()
+	return ratio.MulInt(math.NewIntFromUint64(p.MaxBlockUtilization)).TruncateInt().Uint64()
+}
+
+// GetTargetUtilizationRatio returns the target utilization ratio. Parameters that
+// were stored before the ratio became configurable default to a ratio of 0.5.
+func (p *Params) GetTargetUtilizationRatio() math.LegacyDec {
+	if p.TargetUtilizationRatio.IsNil() || p.TargetUtilizationRatio.IsZero() {
+		return DefaultTargetUtilizationRatio
+	}
+
+	return p.TargetUtilizationRatio
+}
+
+// ClampBaseGasPrice bounds the base gas price computed for the next block by the
+// maximum per-block increase and decrease relative to the previous base gas
+// price, and then by the maximum base gas price. The min base gas price always
+// takes precedence. The returned string identifies the bound that was applied,
+// if any. The arithmetic saturates at MaxDecValue instead of overflowing.
+func (p *Params) ClampBaseGasPrice(previous, next math.LegacyDec) (math.LegacyDec, string) {
+	bound := BaseGasPriceBoundNone
+
+	if isSet(p.MaxBaseGasPriceIncrease) {
+		upper := SaturatingMul(previous, math.LegacyOneDec().Add(p.MaxBaseGasPriceIncrease))
+		if next.GT(upper) {
+			next = upper
+			bound = BaseGasPriceBoundMaxIncrease
+		}
+	}
+
+	if isSet(p.MaxBaseGasPriceDecrease) {
+		lower := previous.Mul(math.LegacyOneDec().Sub(p.MaxBaseGasPriceDecrease))
+		if next.LT(lower) {
+			next = lower
+			bound = BaseGasPriceBoundMaxDecrease
+		}
+	}
+
+	if isSet(p.MaxBaseGasPrice) && next.GT(p.MaxBaseGasPrice) {
+		next = p.MaxBaseGasPrice
+		bound = BaseGasPriceBoundMaxBaseGasPrice
+	}
+
+	if next.LT(p.MinBaseGasPrice) {
+		next = p.MinBaseGasPrice
+	}
+
+	return next, bound
+}
+
+// isSet returns true if the optional decimal parameter is set to a non-zero value.
+func isSet(d math.LegacyDec) bool {
+	return !d.IsNil() && !d.IsZero()
 }
@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UtilizationMode defines how the block utilization that drives the base gas
+// price adjustment is derived from the utilization window.
+type UtilizationMode int32
+
+const (
+	// UTILIZATION_MODE_UNSPECIFIED defaults to the utilization of the current
+	// block.
+	UtilizationMode_UTILIZATION_MODE_UNSPECIFIED UtilizationMode = 0
+	// UTILIZATION_MODE_CURRENT_BLOCK uses the utilization of the current block.
+	UtilizationMode_UTILIZATION_MODE_CURRENT_BLOCK UtilizationMode = 1
+	// UTILIZATION_MODE_WINDOW_AVERAGE uses the plain average utilization of all
+	// blocks in the window.
+	UtilizationMode_UTILIZATION_MODE_WINDOW_AVERAGE UtilizationMode = 2
+	// UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE uses a linearly weighted average
+	// utilization of all blocks in the window, where more recent blocks are
+	// weighted more heavily.
+	UtilizationMode_UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE UtilizationMode = 3
+)
+
+var UtilizationMode_name = map[int32]string{
+	0: "UTILIZATION_MODE_UNSPECIFIED",
+	1: "UTILIZATION_MODE_CURRENT_BLOCK",
+	2: "UTILIZATION_MODE_WINDOW_AVERAGE",
+	3: "UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE",
+}
+
+var UtilizationMode_value = map[string]int32{
+	"UTILIZATION_MODE_UNSPECIFIED":             0,
+	"UTILIZATION_MODE_CURRENT_BLOCK":           1,
+	"UTILIZATION_MODE_WINDOW_AVERAGE":          2,
+	"UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE": 3,
+}
+
+func (x UtilizationMode) String() string {
+	return proto.EnumName(UtilizationMode_name, int32(x))
+}
+
+func (UtilizationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{0}
+}
+
+// UnusedGasPolicy defines how the fee paid for the gas limit of a transaction
+// in excess of the gas it consumed is handled.
+type UnusedGasPolicy int32
+
+const (
+	// UNUSED_GAS_POLICY_UNSPECIFIED defaults to paying out the fee for unused gas
+	// as a tip.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_UNSPECIFIED UnusedGasPolicy = 0
+	// UNUSED_GAS_POLICY_TIP pays out the fee for unused gas as a tip.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_TIP UnusedGasPolicy = 1
+	// UNUSED_GAS_POLICY_REFUND refunds the fee for unused gas to the fee granter
+	// or, if there is none, to the fee payer.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND UnusedGasPolicy = 2
+	// UNUSED_GAS_POLICY_PENALTY charges UnusedGasPenalty of the fee for unused
+	// gas as a fee and refunds the rest.
+	UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY UnusedGasPolicy = 3
+)
+
+var UnusedGasPolicy_name = map[int32]string{
+	0: "UNUSED_GAS_POLICY_UNSPECIFIED",
+	1: "UNUSED_GAS_POLICY_TIP",
+	2: "UNUSED_GAS_POLICY_REFUND",
+	3: "UNUSED_GAS_POLICY_PENALTY",
+}
+
+var UnusedGasPolicy_value = map[string]int32{
+	"UNUSED_GAS_POLICY_UNSPECIFIED": 0,
+	"UNUSED_GAS_POLICY_TIP":         1,
+	"UNUSED_GAS_POLICY_REFUND":      2,
+	"UNUSED_GAS_POLICY_PENALTY":     3,
+}
+
+func (x UnusedGasPolicy) String() string {
+	return proto.EnumName(UnusedGasPolicy_name, int32(x))
+}
+
+func (UnusedGasPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{1}
+}
+
 // Params contains the required set of parameters for the EIP1559 fee market
 // plugin implementation.
 type Params struct {
@@ -41,7 +126,8 @@ type Params struct {
 	// Gamma is the threshold for the learning rate. If the learning rate is
 	// above or below the target +/- threshold, we additively increase the
 	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
-	// learning rate by Beta.
+	// learning rate by Beta. The thresholds are scaled on either side of the
+	// TargetUtilizationRatio.
 	//
 	// Must be [0, 0.5].
 	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
@@ -69,9 +155,150 @@ type Params struct {
 	// DistributeFees is a boolean that determines whether the fees are burned or
 	// distributed to all stakers.
 	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
-	// SendTipToProposer is a boolean that determines whether the tip is sent to a
-	// proposer or to a module account.
+	// SendTipToProposer is a boolean that determines whether the tip is sent to
+	// the operator account of the block proposer or to a module account.
 	SendTipToProposer bool `protobuf:"varint,13,opt,name=send_tip_to_proposer,json=sendTipToProposer,proto3" json:"send_tip_to_proposer,omitempty"`
+	// PricingModel is the name of the pricing model that is used to update the
+	// base gas price at the end of every block. The built-in models are
+	// "eip1559", "aimd", "exponential" and "pid". Chains may register additional
+	// models with the keeper. If unset, the AIMD model is used.
+	PricingModel string `protobuf:"bytes,14,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	// UtilizationMode determines which block utilization is used to adjust the
+	// base gas price. By default, only the utilization of the current block is
+	// considered.
+	UtilizationMode UtilizationMode `protobuf:"varint,15,opt,name=utilization_mode,json=utilizationMode,proto3,enum=feemarket.feemarket.v1.UtilizationMode" json:"utilization_mode,omitempty"`
+	// TargetUtilizationRatio is the fraction of MaxBlockUtilization that the fee
+	// market targets. The base gas price increases when blocks are fuller than
+	// the target and decreases otherwise.
+	//
+	// Must be (0, 1).
+	TargetUtilizationRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=target_utilization_ratio,json=targetUtilizationRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_utilization_ratio"`
+	// MaxBaseGasPrice is the upper bound for the base gas price. A value of zero
+	// disables the upper bound.
+	//
+	// Must be zero or >= MinBaseGasPrice.
+	MaxBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price"`
+	// MaxBaseGasPriceIncrease is the maximum fraction by which the base gas price
+	// can increase in a single block, e.g. 0.1 for 10%. A value of zero disables
+	// the limit.
+	//
+	// Must be between [0, 100].
+	MaxBaseGasPriceIncrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=max_base_gas_price_increase,json=maxBaseGasPriceIncrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price_increase"`
+	// MaxBaseGasPriceDecrease is the maximum fraction by which the base gas price
+	// can decrease in a single block, e.g. 0.1 for 10%. A value of zero disables
+	// the limit.
+	//
+	// Must be [0, 1].
+	MaxBaseGasPriceDecrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=max_base_gas_price_decrease,json=maxBaseGasPriceDecrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price_decrease"`
+	// ExcessGasUpdateFraction controls how quickly the base gas price responds to
+	// accumulated excess gas in the exponential pricing model. A block that
+	// consumes x gas above the target multiplies the base gas price by
+	// e^(x / ExcessGasUpdateFraction).
+	//
+	// Must be > 0 when the exponential pricing model is selected.
+	ExcessGasUpdateFraction uint64 `protobuf:"varint,20,opt,name=excess_gas_update_fraction,json=excessGasUpdateFraction,proto3" json:"excess_gas_update_fraction,omitempty"`
+	// PidProportionalGain is the gain applied to the utilization error of the
+	// current block in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidProportionalGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=pid_proportional_gain,json=pidProportionalGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pid_proportional_gain"`
+	// PidIntegralGain is the gain applied to the sum of the utilization errors
+	// across the window in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidIntegralGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=pid_integral_gain,json=pidIntegralGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pid_integral_gain"`
+	// PidDerivativeGain is the gain applied to the change in utilization error
+	// between the previous and the current block in the pid pricing model.
+	//
+	// Must be >= 0.
+	PidDerivativeGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,23,opt,name=pid_derivative_gain,json=pidDerivativeGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pid_derivative_gain"`
+	// PidIntegralLimit bounds the absolute value of the integral term in the pid
+	// pricing model to prevent integral windup.
+	//
+	// Must be >= 0.
+	PidIntegralLimit cosmossdk_io_math.LegacyDec `protobuf:"bytes,24,opt,name=pid_integral_limit,json=pidIntegralLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pid_integral_limit"`
+	// TargetBlockTime is the expected time between blocks. If set, the change in
+	// base gas price computed by the pricing model is scaled by the time elapsed
+	// since the previous block relative to the target block time. A value of zero
+	// disables the scaling.
+	TargetBlockTime time.Duration `protobuf:"bytes,25,opt,name=target_block_time,json=targetBlockTime,proto3,stdduration" json:"target_block_time"`
+	// DowntimeThreshold is the time between blocks after which the chain is
+	// considered to have been down. The difference between the base gas price and
+	// MinBaseGasPrice is halved for every DowntimeThreshold that elapsed since the
+	// previous block. A value of zero disables the decay.
+	DowntimeThreshold time.Duration `protobuf:"bytes,26,opt,name=downtime_threshold,json=downtimeThreshold,proto3,stdduration" json:"downtime_threshold"`
+	// MaxBlockBytes is the maximum number of tx bytes in a block for the byte
+	// dimension of the fee market. A value of zero disables the byte dimension,
+	// in which case transactions only pay for gas.
+	MaxBlockBytes uint64 `protobuf:"varint,27,opt,name=max_block_bytes,json=maxBlockBytes,proto3" json:"max_block_bytes,omitempty"`
+	// TargetBlockBytes is the target number of tx bytes in a block. The base byte
+	// price increases when blocks contain more bytes than the target, and
+	// decreases otherwise.
+	//
+	// Must be (0, MaxBlockBytes] when the byte dimension is enabled.
+	TargetBlockBytes uint64 `protobuf:"varint,28,opt,name=target_block_bytes,json=targetBlockBytes,proto3" json:"target_block_bytes,omitempty"`
+	// MinBaseBytePrice is the minimum base byte price. This is denominated in fee
+	// per tx byte in the FeeDenom.
+	//
+	// Must be > 0 when the byte dimension is enabled.
+	MinBaseBytePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,29,opt,name=min_base_byte_price,json=minBaseBytePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_byte_price"`
+	// ByteLearningRate is the learning rate used to adjust the base byte price.
+	//
+	// Must be >= 0.
+	ByteLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,30,opt,name=byte_learning_rate,json=byteLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"byte_learning_rate"`
+	// MsgFeeMultipliers scale the required fee of transactions that contain the
+	// given message types. For transactions with multiple messages, the highest
+	// multiplier is used. Message types without a multiplier use a multiplier of
+	// one.
+	MsgFeeMultipliers []MsgFeeMultiplier `protobuf:"bytes,31,rep,name=msg_fee_multipliers,json=msgFeeMultipliers,proto3" json:"msg_fee_multipliers"`
+	// Guardian is the address that can freeze the base gas price in an
+	// emergency, without a governance proposal. An empty address disables the
+	// guardian.
+	Guardian string `protobuf:"bytes,32,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// MaxFreezeBlocks is the maximum number of blocks for which the guardian can
+	// freeze the base gas price.
+	//
+	// Must be > 0 when Guardian is set.
+	MaxFreezeBlocks uint64 `protobuf:"varint,33,opt,name=max_freeze_blocks,json=maxFreezeBlocks,proto3" json:"max_freeze_blocks,omitempty"`
+	// FeeExemptions exempt transactions from the fee requirement by message type
+	// and fee payer. A transaction is exempt if every message in it is covered
+	// by an exemption, its gas limit does not exceed the gas cap of the covering
+	// exemptions and it fits in the remaining exempt gas budget of the block.
+	// The gas of exempt transactions still counts towards the block utilization.
+	FeeExemptions []FeeExemption `protobuf:"bytes,34,rep,name=fee_exemptions,json=feeExemptions,proto3" json:"fee_exemptions"`
+	// MaxBlockExemptGas is the maximum amount of gas that fee exempt
+	// transactions can consume in a block.
+	//
+	// Must be > 0 when FeeExemptions are set.
+	MaxBlockExemptGas uint64 `protobuf:"varint,35,opt,name=max_block_exempt_gas,json=maxBlockExemptGas,proto3" json:"max_block_exempt_gas,omitempty"`
+	// BurnFees is a boolean that determines whether the base fee portion of the
+	// fees is burned. If both DistributeFees and BurnFees are false, the fees
+	// are kept in the fee collector account.
+	//
+	// Must be false when DistributeFees is set.
+	BurnFees bool `protobuf:"varint,36,opt,name=burn_fees,json=burnFees,proto3" json:"burn_fees,omitempty"`
+	// FeeSplit splits the base fee portion of the fees between burning, the
+	// community pool and the fee recipient module. Must be unset when
+	// DistributeFees or BurnFees is set.
+	FeeSplit *FeeSplit `protobuf:"bytes,37,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split,omitempty"`
+	// TipSplit splits the tips between the block proposer and the fee recipient
+	// module. If set, it takes precedence over SendTipToProposer.
+	TipSplit *TipSplit `protobuf:"bytes,38,opt,name=tip_split,json=tipSplit,proto3" json:"tip_split,omitempty"`
+	// DistributeTipsToDelegators is a boolean that determines whether the tips
+	// of the block proposer are allocated to its validator through the
+	// distribution module, so that they are shared with its delegators, instead
+	// of being sent to the operator account of the validator.
+	DistributeTipsToDelegators bool `protobuf:"varint,39,opt,name=distribute_tips_to_delegators,json=distributeTipsToDelegators,proto3" json:"distribute_tips_to_delegators,omitempty"`
+	// UnusedGasPolicy determines how the fee paid for gas that was provided but
+	// not consumed by a transaction is handled. By default, it is paid out as a
+	// tip.
+	UnusedGasPolicy UnusedGasPolicy `protobuf:"varint,40,opt,name=unused_gas_policy,json=unusedGasPolicy,proto3,enum=feemarket.feemarket.v1.UnusedGasPolicy" json:"unused_gas_policy,omitempty"`
+	// UnusedGasPenalty is the fraction of the fee paid for unused gas that is
+	// charged as a fee when the unused gas policy is
+	// UNUSED_GAS_POLICY_PENALTY. The rest is refunded.
+	//
+	// Must be between [0, 1].
+	UnusedGasPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,41,opt,name=unused_gas_penalty,json=unusedGasPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"unused_gas_penalty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -149,248 +376,2931 @@ func (m *Params) GetSendTipToProposer() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "feemarket.feemarket.v1.Params")
+func (m *Params) GetPricingModel() string {
+	if m != nil {
+		return m.PricingModel
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("feemarket/feemarket/v1/params.proto", fileDescriptor_3907de4df2e1c66e)
+func (m *Params) GetUtilizationMode() UtilizationMode {
+	if m != nil {
+		return m.UtilizationMode
+	}
+	return UtilizationMode_UTILIZATION_MODE_UNSPECIFIED
 }
 
-var fileDescriptor_3907de4df2e1c66e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xef, 0x4b, 0xdd, 0x64, 0xf8, 0x53, 0x75, 0x28, 0xd5, 0xd0, 0x4a, 0x6e, 0x04,
-	0x0b, 0xb2, 0xa9, 0xad, 0xc0, 0x1b, 0x44, 0x81, 0x0a, 0xa9, 0x8b, 0xc8, 0x2a, 0x1b, 0x24, 0xb0,
-	0xae, 0xed, 0x1b, 0x67, 0x14, 0x8f, 0xc7, 0xf2, 0x4c, 0xd2, 0x94, 0xa7, 0xe0, 0x25, 0x78, 0x03,
-	0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0x62, 0x51, 0xa1, 0xe4, 0x45, 0xd0, 0x8c, 0x03, 0x29, 0x2c, 0xcd,
-	0xee, 0xde, 0x7b, 0xce, 0xf9, 0xcd, 0xd5, 0x48, 0x97, 0x3c, 0x9b, 0x20, 0x0a, 0xa8, 0x66, 0xa8,
-	0x83, 0x6d, 0xb5, 0x18, 0x04, 0x25, 0x54, 0x20, 0x94, 0x5f, 0x56, 0x52, 0x4b, 0x7a, 0xf8, 0x5b,
-	0xf2, 0xb7, 0xd5, 0x62, 0x70, 0xf4, 0x24, 0x91, 0x4a, 0x48, 0x15, 0x59, 0x57, 0x50, 0x37, 0x75,
-	0xe4, 0xe8, 0x20, 0x93, 0x99, 0xac, 0xe7, 0xa6, 0xaa, 0xa7, 0x4f, 0x3f, 0xbb, 0xc4, 0x1d, 0x5b,
-	0x32, 0x3d, 0x23, 0x3b, 0x90, 0x97, 0x53, 0x60, 0x4e, 0xcf, 0xe9, 0x77, 0x87, 0x83, 0xeb, 0xdb,
-	0x93, 0xd6, 0xf7, 0xdb, 0x93, 0xe3, 0x9a, 0xa2, 0xd2, 0x99, 0xcf, 0x65, 0x20, 0x40, 0x4f, 0xfd,
-	0x73, 0xcc, 0x20, 0xb9, 0x1a, 0x61, 0xf2, 0xf5, 0xcb, 0x29, 0xd9, 0x3c, 0x32, 0xc2, 0x24, 0xac,
-	0xf3, 0xf4, 0x15, 0x69, 0xc7, 0xa8, 0x81, 0xfd, 0xd7, 0x94, 0x63, 0xe3, 0x66, 0x9f, 0x0c, 0x84,
-	0x00, 0xf6, 0x7f, 0xe3, 0x7d, 0x6c, 0xde, 0x80, 0x52, 0xcc, 0x35, 0xb0, 0x76, 0x63, 0x90, 0xcd,
-	0xd3, 0x0f, 0x84, 0x0a, 0x5e, 0x44, 0x31, 0x28, 0x8c, 0x32, 0x30, 0xbf, 0xcc, 0x13, 0x64, 0x3b,
-	0x4d, 0xa9, 0x7b, 0x82, 0x17, 0x43, 0x50, 0x78, 0x06, 0x6a, 0x6c, 0x48, 0xf4, 0x3d, 0xd9, 0x37,
-	0xfc, 0x1c, 0xa1, 0x2a, 0x78, 0x91, 0x45, 0x15, 0x68, 0x64, 0xee, 0xbf, 0xe0, 0xcf, 0x37, 0xa8,
-	0x10, 0x74, 0x8d, 0x87, 0xe5, 0x5f, 0xf8, 0xdd, 0xe6, 0x78, 0x58, 0xfe, 0x81, 0x7f, 0x41, 0x1e,
-	0x1b, 0x7c, 0x9c, 0xcb, 0x64, 0x16, 0xcd, 0x35, 0xcf, 0xf9, 0x47, 0xd0, 0x5c, 0x16, 0xac, 0xd3,
-	0x73, 0xfa, 0xed, 0xf0, 0x91, 0x80, 0xe5, 0xd0, 0x68, 0x6f, 0xb7, 0x12, 0x3d, 0x24, 0xee, 0x25,
-	0x2f, 0x52, 0x79, 0xc9, 0xba, 0xd6, 0xb4, 0xe9, 0xe8, 0x31, 0xe9, 0x4e, 0x10, 0xa3, 0x14, 0x0b,
-	0x29, 0x18, 0x31, 0x2b, 0x86, 0x9d, 0x09, 0xe2, 0xc8, 0xf4, 0x94, 0x91, 0x5d, 0x2c, 0x20, 0xce,
-	0x31, 0x65, 0xf7, 0x7a, 0x4e, 0xbf, 0x13, 0xfe, 0x6a, 0xe9, 0x73, 0xb2, 0x97, 0x72, 0xa5, 0x2b,
-	0x1e, 0xcf, 0x35, 0x46, 0x13, 0x44, 0xc5, 0xee, 0x5b, 0xc7, 0xc3, 0xed, 0xf8, 0x35, 0xa2, 0xa2,
-	0x01, 0x39, 0x50, 0x58, 0xa4, 0x91, 0xe6, 0x65, 0xa4, 0xa5, 0x39, 0x97, 0x52, 0x2a, 0xac, 0xd8,
-	0x03, 0xeb, 0xde, 0x37, 0xda, 0x05, 0x2f, 0x2f, 0xe4, 0x78, 0x23, 0x0c, 0xdf, 0x5c, 0xaf, 0x3c,
-	0xe7, 0x66, 0xe5, 0x39, 0x3f, 0x56, 0x9e, 0xf3, 0x69, 0xed, 0xb5, 0x6e, 0xd6, 0x5e, 0xeb, 0xdb,
-	0xda, 0x6b, 0xbd, 0x0b, 0x32, 0xae, 0xa7, 0xf3, 0xd8, 0x4f, 0xa4, 0x08, 0xd4, 0x8c, 0x97, 0xa7,
-	0x02, 0x17, 0x77, 0x2e, 0x77, 0x79, 0xa7, 0xd6, 0x57, 0x25, 0xaa, 0xd8, 0xb5, 0x97, 0xf7, 0xf2,
-	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xb2, 0x79, 0x06, 0xe9, 0x03, 0x00, 0x00,
+func (m *Params) GetExcessGasUpdateFraction() uint64 {
+	if m != nil {
+		return m.ExcessGasUpdateFraction
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetTargetBlockTime() time.Duration {
+	if m != nil {
+		return m.TargetBlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetDowntimeThreshold() time.Duration {
+	if m != nil {
+		return m.DowntimeThreshold
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SendTipToProposer {
-		i--
-		if m.SendTipToProposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+func (m *Params) GetMaxBlockBytes() uint64 {
+	if m != nil {
+		return m.MaxBlockBytes
 	}
-	if m.DistributeFees {
-		i--
-		if m.DistributeFees {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+	return 0
+}
+
+func (m *Params) GetTargetBlockBytes() uint64 {
+	if m != nil {
+		return m.TargetBlockBytes
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *Params) GetMsgFeeMultipliers() []MsgFeeMultiplier {
+	if m != nil {
+		return m.MsgFeeMultipliers
 	}
-	if len(m.FeeDenom) > 0 {
-		i -= len(m.FeeDenom)
-		copy(dAtA[i:], m.FeeDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDenom)))
-		i--
-		dAtA[i] = 0x52
+	return nil
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	if m.Window != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.Window))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *Params) GetMaxFreezeBlocks() uint64 {
+	if m != nil {
+		return m.MaxFreezeBlocks
 	}
-	if m.MaxBlockUtilization != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockUtilization))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *Params) GetFeeExemptions() []FeeExemption {
+	if m != nil {
+		return m.FeeExemptions
 	}
-	{
-		size := m.MaxLearningRate.Size()
-		i -= size
-		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Params) GetMaxBlockExemptGas() uint64 {
+	if m != nil {
+		return m.MaxBlockExemptGas
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.MinLearningRate.Size()
-		i -= size
-		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Params) GetBurnFees() bool {
+	if m != nil {
+		return m.BurnFees
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MinBaseGasPrice.Size()
-		i -= size
-		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *Params) GetFeeSplit() *FeeSplit {
+	if m != nil {
+		return m.FeeSplit
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Delta.Size()
-		i -= size
-		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Params) GetTipSplit() *TipSplit {
+	if m != nil {
+		return m.TipSplit
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Gamma.Size()
-		i -= size
-		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Params) GetDistributeTipsToDelegators() bool {
+	if m != nil {
+		return m.DistributeTipsToDelegators
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Beta.Size()
-		i -= size
-		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *Params) GetUnusedGasPolicy() UnusedGasPolicy {
+	if m != nil {
+		return m.UnusedGasPolicy
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Alpha.Size()
-		i -= size
-		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return UnusedGasPolicy_UNUSED_GAS_POLICY_UNSPECIFIED
+}
+
+// MsgFeeMultiplier scales the required fee of transactions containing messages
+// of the given type.
+type MsgFeeMultiplier struct {
+	// MsgTypeUrl is the type URL of the message, e.g.
+	// /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is the factor by which the required fee is scaled.
+	//
+	// Must be > 0.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *MsgFeeMultiplier) Reset()         { *m = MsgFeeMultiplier{} }
+func (m *MsgFeeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeMultiplier) ProtoMessage()    {}
+func (*MsgFeeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{1}
+}
+func (m *MsgFeeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgFeeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeMultiplier.Merge(m, src)
+}
+func (m *MsgFeeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeMultiplier.DiscardUnknown(m)
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgFeeMultiplier proto.InternalMessageInfo
+
+func (m *MsgFeeMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// FeeExemption exempts messages of the given type in transactions paid by the
+// given fee payer from the fee requirement. At least one of the message type
+// and the address must be set. An empty field matches any value.
+type FeeExemption struct {
+	// MsgTypeUrl is the type URL of the message, e.g.
+	// /ibc.core.client.v1.MsgUpdateClient.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Address is the fee payer of the transaction, which is its first signer
+	// unless the fee payer is set explicitly.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// MaxTxGas is the maximum gas limit of an exempt transaction.
+	//
+	// Must be > 0.
+	MaxTxGas uint64 `protobuf:"varint,3,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
+}
+
+func (m *FeeExemption) Reset()         { *m = FeeExemption{} }
+func (m *FeeExemption) String() string { return proto.CompactTextString(m) }
+func (*FeeExemption) ProtoMessage()    {}
+func (*FeeExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{2}
+}
+func (m *FeeExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemption.Merge(m, src)
+}
+func (m *FeeExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExemption proto.InternalMessageInfo
+
+func (m *FeeExemption) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *FeeExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeExemption) GetMaxTxGas() uint64 {
+	if m != nil {
+		return m.MaxTxGas
+	}
+	return 0
+}
+
+// FeeSplit defines the shares of the base fee, in basis points, that are
+// burned, sent to the community pool and sent to the fee recipient module.
+// Rounding remainders are sent to the fee recipient module.
+//
+// The shares must add up to 10000.
+type FeeSplit struct {
+	// BurnBps is the share of the base fee that is burned.
+	BurnBps uint32 `protobuf:"varint,1,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+	// CommunityPoolBps is the share of the base fee that is sent to the
+	// community pool.
+	CommunityPoolBps uint32 `protobuf:"varint,2,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	// RecipientBps is the share of the base fee that is sent to the fee
+	// recipient module.
+	RecipientBps uint32 `protobuf:"varint,3,opt,name=recipient_bps,json=recipientBps,proto3" json:"recipient_bps,omitempty"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{3}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+func (m *FeeSplit) GetBurnBps() uint32 {
+	if m != nil {
+		return m.BurnBps
+	}
+	return 0
+}
+
+func (m *FeeSplit) GetCommunityPoolBps() uint32 {
+	if m != nil {
+		return m.CommunityPoolBps
+	}
+	return 0
+}
+
+func (m *FeeSplit) GetRecipientBps() uint32 {
+	if m != nil {
+		return m.RecipientBps
+	}
+	return 0
+}
+
+// TipSplit defines the shares of the tip, in basis points, that are sent to
+// the block proposer and to the fee recipient module. Rounding remainders are
+// sent to the fee recipient module.
+//
+// The shares must add up to 10000.
+type TipSplit struct {
+	// ProposerBps is the share of the tip that is sent to the block proposer.
+	ProposerBps uint32 `protobuf:"varint,1,opt,name=proposer_bps,json=proposerBps,proto3" json:"proposer_bps,omitempty"`
+	// RecipientBps is the share of the tip that is sent to the fee recipient
+	// module.
+	RecipientBps uint32 `protobuf:"varint,2,opt,name=recipient_bps,json=recipientBps,proto3" json:"recipient_bps,omitempty"`
+}
+
+func (m *TipSplit) Reset()         { *m = TipSplit{} }
+func (m *TipSplit) String() string { return proto.CompactTextString(m) }
+func (*TipSplit) ProtoMessage()    {}
+func (*TipSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{4}
+}
+func (m *TipSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TipSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TipSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TipSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TipSplit.Merge(m, src)
+}
+func (m *TipSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TipSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TipSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TipSplit proto.InternalMessageInfo
+
+func (m *TipSplit) GetProposerBps() uint32 {
+	if m != nil {
+		return m.ProposerBps
+	}
+	return 0
+}
+
+func (m *TipSplit) GetRecipientBps() uint32 {
+	if m != nil {
+		return m.RecipientBps
+	}
+	return 0
+}
+
+// ScheduledParams is a parameter set that takes effect at the given block
+// height.
+type ScheduledParams struct {
+	// Height is the block height at which the params are applied, before the fee
+	// market is updated in EndBlock.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Params are the new parameters for the feemarket module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// ResetState reinitializes the fee market state from the new parameters
+	// once they are applied.
+	ResetState bool `protobuf:"varint,3,opt,name=reset_state,json=resetState,proto3" json:"reset_state,omitempty"`
+}
+
+func (m *ScheduledParams) Reset()         { *m = ScheduledParams{} }
+func (m *ScheduledParams) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParams) ProtoMessage()    {}
+func (*ScheduledParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{5}
+}
+func (m *ScheduledParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParams.Merge(m, src)
+}
+func (m *ScheduledParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParams proto.InternalMessageInfo
+
+func (m *ScheduledParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ScheduledParams) GetResetState() bool {
+	if m != nil {
+		return m.ResetState
+	}
+	return false
+}
+
+// ParamsRamp is a gradual transition from one parameter set to another. The
+// numeric parameters that are set in both parameter sets are interpolated
+// linearly over the given number of blocks. All other parameters take their
+// target value from the start of the ramp.
+type ParamsRamp struct {
+	// StartParams are the parameters at the start of the ramp.
+	StartParams Params `protobuf:"bytes,1,opt,name=start_params,json=startParams,proto3" json:"start_params"`
+	// TargetParams are the parameters at the end of the ramp.
+	TargetParams Params `protobuf:"bytes,2,opt,name=target_params,json=targetParams,proto3" json:"target_params"`
+	// StartHeight is the block height at which the ramp started.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Blocks is the number of blocks over which the parameters are
+	// interpolated.
+	Blocks uint64 `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *ParamsRamp) Reset()         { *m = ParamsRamp{} }
+func (m *ParamsRamp) String() string { return proto.CompactTextString(m) }
+func (*ParamsRamp) ProtoMessage()    {}
+func (*ParamsRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{6}
+}
+func (m *ParamsRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRamp.Merge(m, src)
+}
+func (m *ParamsRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRamp proto.InternalMessageInfo
+
+func (m *ParamsRamp) GetStartParams() Params {
+	if m != nil {
+		return m.StartParams
+	}
+	return Params{}
+}
+
+func (m *ParamsRamp) GetTargetParams() Params {
+	if m != nil {
+		return m.TargetParams
+	}
+	return Params{}
+}
+
+func (m *ParamsRamp) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ParamsRamp) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("feemarket.feemarket.v1.UtilizationMode", UtilizationMode_name, UtilizationMode_value)
+	proto.RegisterEnum("feemarket.feemarket.v1.UnusedGasPolicy", UnusedGasPolicy_name, UnusedGasPolicy_value)
+	proto.RegisterType((*Params)(nil), "feemarket.feemarket.v1.Params")
+	proto.RegisterType((*MsgFeeMultiplier)(nil), "feemarket.feemarket.v1.MsgFeeMultiplier")
+	proto.RegisterType((*FeeExemption)(nil), "feemarket.feemarket.v1.FeeExemption")
+	proto.RegisterType((*FeeSplit)(nil), "feemarket.feemarket.v1.FeeSplit")
+	proto.RegisterType((*TipSplit)(nil), "feemarket.feemarket.v1.TipSplit")
+	proto.RegisterType((*ScheduledParams)(nil), "feemarket.feemarket.v1.ScheduledParams")
+	proto.RegisterType((*ParamsRamp)(nil), "feemarket.feemarket.v1.ParamsRamp")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/params.proto", fileDescriptor_3907de4df2e1c66e)
+}
+
+var fileDescriptor_3907de4df2e1c66e = []byte{
+	// 1616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0x1b, 0xc7,
+	0x11, 0xe6, 0x12, 0x34, 0x05, 0x35, 0x49, 0x01, 0x1c, 0x4a, 0xd4, 0x90, 0x92, 0x40, 0x88, 0x74,
+	0x2c, 0x44, 0x65, 0x01, 0x25, 0x26, 0xb7, 0x24, 0x07, 0x42, 0x00, 0x61, 0x54, 0x28, 0x12, 0x5e,
+	0x02, 0x51, 0xd9, 0x55, 0xf1, 0x66, 0x80, 0x6d, 0x2e, 0xa7, 0xb8, 0x7f, 0xb5, 0x33, 0xa0, 0x40,
+	0x1f, 0x73, 0x49, 0x0e, 0x39, 0xe4, 0x98, 0x67, 0x48, 0xe5, 0xe8, 0x87, 0xf0, 0xd1, 0xe5, 0x53,
+	0x2a, 0xa9, 0x72, 0x52, 0xd2, 0x8b, 0xa4, 0x66, 0x66, 0xf1, 0x43, 0x52, 0xb4, 0x9d, 0x95, 0x2f,
+	0xac, 0x9d, 0xee, 0xaf, 0xbf, 0xee, 0x9d, 0xf9, 0xa6, 0x7b, 0x41, 0xd8, 0x39, 0x41, 0x0c, 0x58,
+	0x72, 0x86, 0xb2, 0x36, 0x7d, 0x3a, 0x7f, 0x5e, 0x8b, 0x59, 0xc2, 0x02, 0x51, 0x8d, 0x93, 0x48,
+	0x46, 0x64, 0x7d, 0xe2, 0xaa, 0x4e, 0x9f, 0xce, 0x9f, 0x6f, 0x6e, 0x0c, 0x22, 0x11, 0x44, 0xc2,
+	0xd1, 0xa8, 0x9a, 0x59, 0x98, 0x90, 0xcd, 0xbb, 0x5e, 0xe4, 0x45, 0xc6, 0xae, 0x9e, 0x52, 0x6b,
+	0xc9, 0x8b, 0x22, 0xcf, 0xc7, 0x9a, 0x5e, 0xf5, 0x87, 0x27, 0x35, 0x77, 0x98, 0x30, 0xc9, 0xa3,
+	0xd0, 0xf8, 0xb7, 0xff, 0x71, 0x1f, 0x16, 0x3b, 0x3a, 0x33, 0x69, 0xc1, 0x07, 0xcc, 0x8f, 0x4f,
+	0x19, 0xb5, 0xca, 0x56, 0xe5, 0x76, 0xfd, 0xf9, 0xd7, 0xdf, 0x6d, 0xcd, 0xfd, 0xeb, 0xbb, 0xad,
+	0x07, 0x26, 0x8b, 0x70, 0xcf, 0xaa, 0x3c, 0xaa, 0x05, 0x4c, 0x9e, 0x56, 0x0f, 0xd0, 0x63, 0x83,
+	0x8b, 0x06, 0x0e, 0xbe, 0xfd, 0xea, 0x19, 0xa4, 0x45, 0x34, 0x70, 0x60, 0x9b, 0x78, 0xd2, 0x84,
+	0x85, 0x3e, 0x4a, 0x46, 0xe7, 0xb3, 0xf2, 0xe8, 0x70, 0x55, 0x8f, 0xc7, 0x82, 0x80, 0xd1, 0x5c,
+	0xe6, 0x7a, 0x74, 0xbc, 0x22, 0x72, 0xd1, 0x97, 0x8c, 0x2e, 0x64, 0x26, 0xd2, 0xf1, 0xe4, 0x0b,
+	0x20, 0x01, 0x0f, 0x9d, 0x3e, 0x13, 0xe8, 0x78, 0x4c, 0x9d, 0x02, 0x1f, 0x20, 0xfd, 0x20, 0x2b,
+	0x6b, 0x21, 0xe0, 0x61, 0x9d, 0x09, 0x6c, 0x31, 0xd1, 0x51, 0x4c, 0xe4, 0xf7, 0xb0, 0xaa, 0xf8,
+	0x7d, 0x64, 0x49, 0xc8, 0x43, 0xcf, 0x49, 0x98, 0x44, 0xba, 0xf8, 0x3e, 0xf4, 0x07, 0x29, 0x95,
+	0xcd, 0xa4, 0xa1, 0x67, 0xa3, 0x2b, 0xf4, 0xb7, 0xb2, 0xd3, 0xb3, 0xd1, 0x25, 0xfa, 0x5d, 0xb8,
+	0xa7, 0xe8, 0xfb, 0x7e, 0x34, 0x38, 0x73, 0x86, 0x92, 0xfb, 0xfc, 0x4b, 0xad, 0x34, 0x9a, 0x2f,
+	0x5b, 0x95, 0x05, 0x7b, 0x2d, 0x60, 0xa3, 0xba, 0xf2, 0xf5, 0xa6, 0x2e, 0xb2, 0x0e, 0x8b, 0xaf,
+	0x79, 0xe8, 0x46, 0xaf, 0xe9, 0x6d, 0x0d, 0x4a, 0x57, 0xe4, 0x01, 0xdc, 0x3e, 0x41, 0x74, 0x5c,
+	0x0c, 0xa3, 0x80, 0x82, 0x2a, 0xd1, 0xce, 0x9f, 0x20, 0x36, 0xd4, 0x9a, 0x50, 0xb8, 0x85, 0x21,
+	0xeb, 0xfb, 0xe8, 0xd2, 0xa5, 0xb2, 0x55, 0xc9, 0xdb, 0xe3, 0x25, 0x79, 0x02, 0x05, 0x97, 0x0b,
+	0x99, 0xf0, 0xfe, 0x50, 0xa2, 0x73, 0x82, 0x28, 0xe8, 0xb2, 0x46, 0xdc, 0x99, 0x9a, 0xf7, 0x11,
+	0x05, 0xa9, 0xc1, 0x5d, 0x81, 0xa1, 0xeb, 0x48, 0x1e, 0x3b, 0x32, 0x52, 0xd7, 0x29, 0x8e, 0x04,
+	0x26, 0x74, 0x45, 0xa3, 0x57, 0x95, 0xaf, 0xcb, 0xe3, 0x6e, 0xd4, 0x49, 0x1d, 0x64, 0x07, 0x56,
+	0xd4, 0x69, 0xab, 0x6d, 0x0b, 0x22, 0x17, 0x7d, 0x7a, 0x47, 0x17, 0xb5, 0x9c, 0x1a, 0x5f, 0x2a,
+	0x1b, 0xb1, 0xa1, 0x38, 0xf3, 0xde, 0x1a, 0x48, 0x0b, 0x65, 0xab, 0x72, 0x67, 0xf7, 0x49, 0xf5,
+	0xdd, 0x17, 0xba, 0x3a, 0xb3, 0x19, 0x8a, 0xc3, 0x2e, 0x0c, 0x2f, 0x1b, 0xc8, 0x19, 0x50, 0xc9,
+	0x12, 0x0f, 0xe5, 0xec, 0x96, 0x3a, 0xfa, 0x0e, 0xd3, 0x62, 0xd6, 0xb3, 0x5b, 0x37, 0x94, 0x33,
+	0xc9, 0x6d, 0xf5, 0x57, 0x0b, 0x9c, 0x8d, 0xae, 0x0a, 0x7c, 0xf5, 0x7d, 0x24, 0x72, 0x49, 0xe0,
+	0x11, 0x3c, 0xb8, 0xce, 0xef, 0xf0, 0x70, 0x90, 0x20, 0x13, 0x48, 0x49, 0xd6, 0x44, 0xf7, 0xaf,
+	0x24, 0x6a, 0xa7, 0x8c, 0x37, 0x24, 0x74, 0x31, 0x4d, 0xb8, 0xf6, 0x53, 0x25, 0x6c, 0xa4, 0x8c,
+	0xe4, 0x57, 0xb0, 0x89, 0xa3, 0x01, 0x0a, 0xa1, 0xd3, 0x0d, 0x63, 0x97, 0x29, 0x21, 0x26, 0x6c,
+	0xa0, 0x6f, 0xc2, 0x5d, 0x2d, 0xf2, 0xfb, 0x06, 0xd1, 0x62, 0xa2, 0xa7, 0xfd, 0xfb, 0xa9, 0x9b,
+	0x20, 0xdc, 0x8b, 0xb9, 0x6b, 0xd4, 0x98, 0x28, 0x0b, 0xf3, 0x1d, 0x8f, 0xf1, 0x90, 0xde, 0xcb,
+	0x5a, 0xe7, 0x5a, 0xcc, 0xdd, 0xce, 0x0c, 0x5d, 0x8b, 0xf1, 0x50, 0xf5, 0x01, 0x95, 0x86, 0x87,
+	0x12, 0xbd, 0x64, 0x9c, 0x62, 0x3d, 0xf3, 0x21, 0xc7, 0xdc, 0x6d, 0xa7, 0x54, 0x9a, 0x9e, 0x81,
+	0xca, 0xea, 0xb8, 0x98, 0xf0, 0x73, 0x26, 0xf9, 0x39, 0x9a, 0x04, 0xf7, 0xb3, 0x26, 0x50, 0xc5,
+	0x36, 0x26, 0x64, 0x3a, 0x85, 0x03, 0xe4, 0xd2, 0x1b, 0xf8, 0x3c, 0xe0, 0x92, 0xd2, 0xac, 0x19,
+	0x8a, 0x33, 0xaf, 0x70, 0xa0, 0xa8, 0xc8, 0x11, 0xac, 0xa6, 0xb7, 0xce, 0xb4, 0x33, 0xc9, 0x03,
+	0xa4, 0x1b, 0x65, 0xab, 0xb2, 0xb4, 0xbb, 0x51, 0x35, 0x23, 0xb5, 0x3a, 0x1e, 0xa9, 0xd5, 0x46,
+	0x3a, 0x52, 0xeb, 0x79, 0x95, 0xfa, 0x6f, 0xff, 0xd9, 0xb2, 0xec, 0x82, 0x89, 0xd6, 0xfd, 0xae,
+	0xcb, 0x03, 0x24, 0x36, 0x10, 0x37, 0x7a, 0x1d, 0x2a, 0x1e, 0x47, 0x9e, 0x26, 0x28, 0x4e, 0x23,
+	0xdf, 0xa5, 0x9b, 0x3f, 0x9e, 0x71, 0x75, 0x1c, 0xde, 0x1d, 0x47, 0x93, 0x8f, 0xa0, 0x30, 0x6d,
+	0xb8, 0xfd, 0x0b, 0x89, 0x82, 0x3e, 0xd0, 0x02, 0x5b, 0x19, 0xb7, 0xda, 0xba, 0x32, 0x92, 0x8f,
+	0x81, 0x5c, 0x7a, 0x19, 0x03, 0x7d, 0xa8, 0xa1, 0xc5, 0x99, 0x42, 0x0d, 0xfa, 0x0f, 0xb0, 0x36,
+	0x19, 0x72, 0x0a, 0x99, 0x36, 0x81, 0x47, 0x99, 0x37, 0x37, 0x9d, 0x72, 0x8a, 0xdd, 0x74, 0x01,
+	0x07, 0x88, 0x26, 0xbe, 0x3c, 0x88, 0x4a, 0x99, 0x13, 0x28, 0xb2, 0x4b, 0x93, 0xe8, 0x0b, 0x58,
+	0x0b, 0x84, 0xa7, 0xfa, 0xbf, 0x13, 0x0c, 0x7d, 0xc9, 0x63, 0x9f, 0x63, 0x22, 0xe8, 0x56, 0x39,
+	0x57, 0x59, 0xda, 0xad, 0xdc, 0xd4, 0x8a, 0x5f, 0x0a, 0x6f, 0x1f, 0xf1, 0xe5, 0x24, 0xa0, 0xbe,
+	0xa0, 0x6a, 0xb1, 0x57, 0x83, 0x2b, 0x76, 0x41, 0x7e, 0x09, 0x79, 0x6f, 0xc8, 0x12, 0x97, 0xb3,
+	0x90, 0x96, 0x75, 0xd9, 0xf4, 0xdb, 0xaf, 0x9e, 0xdd, 0x4d, 0x6b, 0xda, 0x73, 0xdd, 0x04, 0x85,
+	0x38, 0x96, 0x89, 0xaa, 0x67, 0x82, 0x24, 0x4f, 0xcd, 0xf8, 0x3d, 0x49, 0x10, 0xbf, 0x44, 0x73,
+	0x14, 0x82, 0x3e, 0xd6, 0xa7, 0xa0, 0xce, 0x71, 0x5f, 0xdb, 0xf5, 0x41, 0x08, 0xf2, 0x29, 0xdc,
+	0x51, 0xd5, 0xe3, 0x08, 0x83, 0x58, 0x09, 0x41, 0xd0, 0x6d, 0x5d, 0xfc, 0x87, 0x37, 0x15, 0xbf,
+	0x8f, 0xd8, 0x1c, 0x83, 0xd3, 0xc2, 0x57, 0x4e, 0x66, 0x6c, 0x7a, 0xe4, 0x4d, 0xd5, 0x62, 0x88,
+	0x55, 0x8f, 0xa2, 0x3b, 0xba, 0x82, 0xd5, 0xb1, 0x64, 0x4c, 0x44, 0x8b, 0x09, 0x35, 0x83, 0xfb,
+	0xc3, 0x24, 0x34, 0x63, 0xf4, 0x43, 0x3d, 0x18, 0xf3, 0xca, 0xa0, 0x07, 0xe8, 0x6f, 0xcc, 0x80,
+	0x16, 0xb1, 0xcf, 0x25, 0xfd, 0x99, 0x96, 0x71, 0xf9, 0x7b, 0x6a, 0x3b, 0x56, 0x38, 0x3d, 0xc2,
+	0xf5, 0x93, 0x0a, 0x57, 0xa3, 0xd7, 0x84, 0x7f, 0xf4, 0xfd, 0xe1, 0x5d, 0x1e, 0xa7, 0xe1, 0x32,
+	0x7d, 0x22, 0x7b, 0xf0, 0x68, 0x66, 0xce, 0x4b, 0x1e, 0x0b, 0x35, 0xc5, 0x5d, 0xf4, 0xd1, 0x63,
+	0x32, 0x4a, 0x04, 0x7d, 0xa2, 0xcb, 0xdd, 0x9c, 0x82, 0xba, 0x3c, 0x16, 0xdd, 0xa8, 0x31, 0x41,
+	0x90, 0x63, 0x58, 0x1d, 0x86, 0x43, 0x81, 0xae, 0x99, 0x0b, 0x91, 0xcf, 0x07, 0x17, 0xb4, 0xf2,
+	0x03, 0xc3, 0x5a, 0x07, 0xa8, 0x9e, 0xaf, 0xe1, 0x76, 0x61, 0x78, 0xd9, 0xa0, 0x94, 0x3d, 0x4b,
+	0x8a, 0x21, 0xf3, 0xe5, 0x05, 0xfd, 0x79, 0x66, 0x65, 0x4f, 0xf9, 0x0d, 0xd5, 0xf6, 0x9f, 0x2c,
+	0x28, 0x5e, 0xd5, 0x29, 0x29, 0xc3, 0xb2, 0x92, 0xbb, 0xbc, 0x88, 0xd1, 0x19, 0x26, 0xbe, 0xf9,
+	0x7e, 0xb7, 0x21, 0x10, 0x5e, 0xf7, 0x22, 0xc6, 0x5e, 0xe2, 0x93, 0x4f, 0x01, 0xa6, 0x17, 0x21,
+	0xfb, 0x77, 0xf9, 0x0c, 0xc9, 0xf6, 0x1f, 0x2d, 0x58, 0x9e, 0x15, 0xdd, 0x8f, 0xa8, 0x62, 0x17,
+	0x6e, 0x31, 0x73, 0x37, 0xe8, 0xfc, 0x0f, 0xdc, 0x9a, 0x31, 0x90, 0x3c, 0x04, 0x50, 0xaa, 0x95,
+	0x23, 0xad, 0xd5, 0x9c, 0xd6, 0x6a, 0x3e, 0x60, 0xa3, 0xee, 0xa8, 0xc5, 0xc4, 0xf6, 0x08, 0xf2,
+	0x63, 0x71, 0x91, 0x0d, 0xd0, 0xea, 0x74, 0xfa, 0xb1, 0xd0, 0xb9, 0x57, 0xec, 0x5b, 0x6a, 0x5d,
+	0x8f, 0x75, 0x03, 0x1c, 0x44, 0x41, 0x30, 0x0c, 0xb9, 0xbc, 0x70, 0xe2, 0x28, 0xf2, 0x35, 0x68,
+	0x5e, 0x83, 0x8a, 0x13, 0x4f, 0x27, 0x8a, 0x7c, 0x85, 0xde, 0x81, 0x95, 0x04, 0x07, 0x3c, 0xe6,
+	0x18, 0x4a, 0x0d, 0xcc, 0x69, 0xe0, 0xf2, 0xc4, 0x58, 0x8f, 0xc5, 0xb6, 0x0d, 0xf9, 0xb1, 0x2e,
+	0xc9, 0x63, 0x58, 0x1e, 0x7f, 0x40, 0xce, 0x64, 0x5f, 0x1a, 0xdb, 0xde, 0xc9, 0x39, 0xff, 0x0e,
+	0xce, 0x3f, 0x5b, 0x50, 0x38, 0x1e, 0x9c, 0xa2, 0x3b, 0xf4, 0xd1, 0x4d, 0x7f, 0x94, 0xad, 0xc3,
+	0xe2, 0x29, 0x72, 0xef, 0x54, 0x6a, 0xd6, 0x9c, 0x9d, 0xae, 0xc8, 0xaf, 0x61, 0xd1, 0xfc, 0x60,
+	0xd4, 0x4c, 0x4b, 0xbb, 0xa5, 0x9b, 0x34, 0x6b, 0x78, 0xd2, 0x96, 0x90, 0xc6, 0x90, 0x2d, 0x58,
+	0x4a, 0x50, 0xa0, 0x74, 0x84, 0x54, 0xad, 0x37, 0xa7, 0x6f, 0x0b, 0x68, 0xd3, 0xb1, 0xb2, 0x6c,
+	0xff, 0xdb, 0x02, 0x30, 0x91, 0x36, 0x0b, 0x62, 0xd2, 0x82, 0x65, 0x21, 0x59, 0x22, 0x9d, 0x34,
+	0xa7, 0xf5, 0x7f, 0xe4, 0x5c, 0xd2, 0x91, 0xe9, 0xeb, 0xb4, 0x61, 0x25, 0x1d, 0x45, 0x19, 0xaa,
+	0x5f, 0x36, 0xa1, 0x29, 0xd5, 0xe3, 0x71, 0x4d, 0xe9, 0xfe, 0xe4, 0xf4, 0xfe, 0x98, 0x6c, 0x9f,
+	0x98, 0x4d, 0x5a, 0x87, 0xc5, 0xb4, 0xcd, 0x2e, 0x98, 0x5f, 0x17, 0x66, 0xf5, 0xf4, 0xef, 0x16,
+	0x14, 0xae, 0x7c, 0x78, 0x93, 0x32, 0x3c, 0xec, 0x75, 0xdb, 0x07, 0xed, 0xcf, 0xf7, 0xba, 0xed,
+	0xa3, 0x43, 0xe7, 0xe5, 0x51, 0xa3, 0xe9, 0xf4, 0x0e, 0x8f, 0x3b, 0xcd, 0x17, 0xed, 0xfd, 0x76,
+	0xb3, 0x51, 0x9c, 0x23, 0xdb, 0x50, 0xba, 0x86, 0x78, 0xd1, 0xb3, 0xed, 0xe6, 0x61, 0xd7, 0xa9,
+	0x1f, 0x1c, 0xbd, 0xf8, 0x6d, 0xd1, 0x22, 0x3b, 0xb0, 0x75, 0x0d, 0xf3, 0xaa, 0x7d, 0xd8, 0x38,
+	0x7a, 0xe5, 0xec, 0xfd, 0xae, 0x69, 0xef, 0xb5, 0x9a, 0xc5, 0x79, 0xf2, 0x31, 0x54, 0x6e, 0x02,
+	0xbd, 0x6a, 0xb6, 0x5b, 0x9f, 0x74, 0x9b, 0x8d, 0x09, 0x3a, 0xf7, 0xf4, 0x2f, 0xaa, 0xd8, 0x2b,
+	0x7d, 0xe6, 0x31, 0x3c, 0xea, 0x1d, 0xf6, 0x8e, 0x9b, 0x0d, 0xa7, 0xb5, 0x77, 0xec, 0x74, 0x8e,
+	0x0e, 0xda, 0x2f, 0x3e, 0xbb, 0x52, 0xed, 0x06, 0xdc, 0xbb, 0x0e, 0xe9, 0xb6, 0x3b, 0x45, 0x8b,
+	0x3c, 0x04, 0x7a, 0xdd, 0x65, 0x37, 0xf7, 0x7b, 0x87, 0x8d, 0xe2, 0x3c, 0x79, 0x04, 0x1b, 0xd7,
+	0xbd, 0x9d, 0xe6, 0xe1, 0xde, 0x41, 0xf7, 0xb3, 0x62, 0xae, 0xde, 0xfe, 0xfa, 0x4d, 0xc9, 0xfa,
+	0xe6, 0x4d, 0xc9, 0xfa, 0xef, 0x9b, 0x92, 0xf5, 0xd7, 0xb7, 0xa5, 0xb9, 0x6f, 0xde, 0x96, 0xe6,
+	0xfe, 0xf9, 0xb6, 0x34, 0xf7, 0x79, 0xcd, 0xe3, 0xf2, 0x74, 0xd8, 0xaf, 0x0e, 0xa2, 0xa0, 0x26,
+	0xce, 0x78, 0xfc, 0x2c, 0xc0, 0xf3, 0x99, 0x7f, 0x71, 0x8c, 0x66, 0x9e, 0x55, 0x93, 0x10, 0xfd,
+	0x45, 0xfd, 0xbd, 0xf3, 0x8b, 0xff, 0x0d, 0x00, 0xc7, 0x78, 0xd3, 0xf6, 0x12, 0x11, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Alpha.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.Beta.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.Gamma.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.Delta.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MinBaseGasPrice.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MinLearningRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MaxLearningRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.MaxBlockUtilization != 0 {
-		n += 1 + sovParams(uint64(m.MaxBlockUtilization))
-	}
-	if m.Window != 0 {
-		n += 1 + sovParams(uint64(m.Window))
+	{
+		size := m.UnusedGasPenalty.Size()
+		i -= size
+		if _, err := m.UnusedGasPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	l = len(m.FeeDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if m.UnusedGasPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnusedGasPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.Enabled {
-		n += 2
+	if m.DistributeTipsToDelegators {
+		i--
+		if m.DistributeTipsToDelegators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.DistributeFees {
-		n += 2
+	if m.TipSplit != nil {
+		{
+			size, err := m.TipSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.FeeSplit != nil {
+		{
+			size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.BurnFees {
+		i--
+		if m.BurnFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxBlockExemptGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockExemptGas))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.FeeExemptions) > 0 {
+		for iNdEx := len(m.FeeExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.MaxFreezeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFreezeBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.MsgFeeMultipliers) > 0 {
+		for iNdEx := len(m.MsgFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	{
+		size := m.ByteLearningRate.Size()
+		i -= size
+		if _, err := m.ByteLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.MinBaseBytePrice.Size()
+		i -= size
+		if _, err := m.MinBaseBytePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.TargetBlockBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetBlockBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxBlockBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeThreshold):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TargetBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetBlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.PidIntegralLimit.Size()
+		i -= size
+		if _, err := m.PidIntegralLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.PidDerivativeGain.Size()
+		i -= size
+		if _, err := m.PidDerivativeGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.PidIntegralGain.Size()
+		i -= size
+		if _, err := m.PidIntegralGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.PidProportionalGain.Size()
+		i -= size
+		if _, err := m.PidProportionalGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.ExcessGasUpdateFraction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExcessGasUpdateFraction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.MaxBaseGasPriceDecrease.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPriceDecrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MaxBaseGasPriceIncrease.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPriceIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.TargetUtilizationRatio.Size()
+		i -= size
+		if _, err := m.TargetUtilizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UtilizationMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtilizationMode))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.PricingModel) > 0 {
+		i -= len(m.PricingModel)
+		copy(dAtA[i:], m.PricingModel)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PricingModel)))
+		i--
+		dAtA[i] = 0x72
 	}
 	if m.SendTipToProposer {
-		n += 2
+		i--
+		if m.SendTipToProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DistributeFees {
+		i--
+		if m.DistributeFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxBlockUtilization != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockUtilization))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecipientBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecipientBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommunityPoolBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommunityPoolBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BurnBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BurnBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TipSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TipSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TipSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecipientBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecipientBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposerBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposerBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetState {
+		i--
+		if m.ResetState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.TargetParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StartParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Alpha.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBlockUtilization != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockUtilization))
+	}
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.DistributeFees {
+		n += 2
+	}
+	if m.SendTipToProposer {
+		n += 2
+	}
+	l = len(m.PricingModel)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.UtilizationMode != 0 {
+		n += 1 + sovParams(uint64(m.UtilizationMode))
+	}
+	l = m.TargetUtilizationRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxBaseGasPriceIncrease.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxBaseGasPriceDecrease.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ExcessGasUpdateFraction != 0 {
+		n += 2 + sovParams(uint64(m.ExcessGasUpdateFraction))
+	}
+	l = m.PidProportionalGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PidIntegralGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PidDerivativeGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PidIntegralLimit.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TargetBlockTime)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeThreshold)
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxBlockBytes != 0 {
+		n += 2 + sovParams(uint64(m.MaxBlockBytes))
+	}
+	if m.TargetBlockBytes != 0 {
+		n += 2 + sovParams(uint64(m.TargetBlockBytes))
+	}
+	l = m.MinBaseBytePrice.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ByteLearningRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.MsgFeeMultipliers) > 0 {
+		for _, e := range m.MsgFeeMultipliers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MaxFreezeBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MaxFreezeBlocks))
+	}
+	if len(m.FeeExemptions) > 0 {
+		for _, e := range m.FeeExemptions {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxBlockExemptGas != 0 {
+		n += 2 + sovParams(uint64(m.MaxBlockExemptGas))
+	}
+	if m.BurnFees {
+		n += 3
+	}
+	if m.FeeSplit != nil {
+		l = m.FeeSplit.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.TipSplit != nil {
+		l = m.TipSplit.Size()
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.DistributeTipsToDelegators {
+		n += 3
+	}
+	if m.UnusedGasPolicy != 0 {
+		n += 2 + sovParams(uint64(m.UnusedGasPolicy))
+	}
+	l = m.UnusedGasPenalty.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgFeeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxTxGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxTxGas))
+	}
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnBps != 0 {
+		n += 1 + sovParams(uint64(m.BurnBps))
+	}
+	if m.CommunityPoolBps != 0 {
+		n += 1 + sovParams(uint64(m.CommunityPoolBps))
+	}
+	if m.RecipientBps != 0 {
+		n += 1 + sovParams(uint64(m.RecipientBps))
+	}
+	return n
+}
+
+func (m *TipSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposerBps != 0 {
+		n += 1 + sovParams(uint64(m.ProposerBps))
+	}
+	if m.RecipientBps != 0 {
+		n += 1 + sovParams(uint64(m.RecipientBps))
+	}
+	return n
+}
+
+func (m *ScheduledParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ResetState {
+		n += 2
+	}
+	return n
+}
+
+func (m *ParamsRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetParams.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovParams(uint64(m.StartHeight))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovParams(uint64(m.Blocks))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockUtilization", wireType)
+			}
+			m.MaxBlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistributeFees = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTipToProposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendTipToProposer = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricingModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationMode", wireType)
+			}
+			m.UtilizationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtilizationMode |= UtilizationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilizationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetUtilizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPriceIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPriceIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPriceDecrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPriceDecrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessGasUpdateFraction", wireType)
+			}
+			m.ExcessGasUpdateFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessGasUpdateFraction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidProportionalGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidProportionalGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidIntegralGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidIntegralGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidDerivativeGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidDerivativeGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidIntegralLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidIntegralLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TargetBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockBytes", wireType)
+			}
+			m.MaxBlockBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockBytes", wireType)
+			}
+			m.TargetBlockBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseBytePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseBytePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ByteLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeMultipliers = append(m.MsgFeeMultipliers, MsgFeeMultiplier{})
+			if err := m.MsgFeeMultipliers[len(m.MsgFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFreezeBlocks", wireType)
+			}
+			m.MaxFreezeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFreezeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptions = append(m.FeeExemptions, FeeExemption{})
+			if err := m.FeeExemptions[len(m.FeeExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockExemptGas", wireType)
+			}
+			m.MaxBlockExemptGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockExemptGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnFees = bool(v != 0)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSplit == nil {
+				m.FeeSplit = &FeeSplit{}
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TipSplit == nil {
+				m.TipSplit = &TipSplit{}
+			}
+			if err := m.TipSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTipsToDelegators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistributeTipsToDelegators = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnusedGasPolicy", wireType)
+			}
+			m.UnusedGasPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnusedGasPolicy |= UnusedGasPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnusedGasPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnusedGasPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeExemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -413,15 +3323,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeExemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -449,15 +3359,221 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+			}
+			m.MaxTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+			}
+			m.BurnBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+			}
+			m.CommunityPoolBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBps", wireType)
+			}
+			m.RecipientBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TipSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TipSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TipSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBps", wireType)
 			}
-			var stringLen uint64
+			m.ProposerBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -467,31 +3583,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposerBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBps", wireType)
 			}
-			var stringLen uint64
+			m.RecipientBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -501,31 +3602,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RecipientBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -535,31 +3671,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -569,31 +3690,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetState", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -603,31 +3723,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+			m.ResetState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -637,69 +3793,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockUtilization", wireType)
-			}
-			m.MaxBlockUtilization = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxBlockUtilization |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
-			}
-			m.Window = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Window |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -709,49 +3826,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Enabled = bool(v != 0)
-		case 12:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributeFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var v int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -761,17 +3859,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DistributeFees = bool(v != 0)
-		case 13:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendTipToProposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var v int
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -781,12 +3878,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Blocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SendTipToProposer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"cosmossdk.io/math"
+)
+
+// NewParamsRamp returns a new ramp from the start params to the target params over
+// the given number of blocks, starting at the given height.
+func NewParamsRamp(start, target Params, startHeight int64, blocks uint64) ParamsRamp {
+	return ParamsRamp{
+		StartParams:  start,
+		TargetParams: target,
+		StartHeight:  startHeight,
+		Blocks:       blocks,
+	}
+}
+
+// ValidateBasic performs basic validation on the parameter ramp.
+func (r *ParamsRamp) ValidateBasic() error {
+	if r.Blocks == 0 {
+		return fmt.Errorf("ramp blocks must be positive")
+	}
+
+	if r.StartHeight < 0 {
+		return fmt.Errorf("ramp start height cannot be negative; got %d", r.StartHeight)
+	}
+
+	if err := r.StartParams.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ramp start params: %w", err)
+	}
+
+	if err := r.TargetParams.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ramp target params: %w", err)
+	}
+
+	return nil
+}
+
+// ElapsedBlocks returns the number of blocks of the ramp that have elapsed at the
+// given height, capped at the length of the ramp.
+func (r *ParamsRamp) ElapsedBlocks(height int64) uint64 {
+	if height <= r.StartHeight {
+		return 0
+	}
+
+	if elapsed := uint64(height - r.StartHeight); elapsed < r.Blocks {
+		return elapsed
+	}
+
+	return r.Blocks
+}
+
+// IsComplete returns true if the ramp has reached the target params at the given
+// height.
+func (r *ParamsRamp) IsComplete(height int64) bool {
+	return r.ElapsedBlocks(height) == r.Blocks
+}
+
+// Progress returns the fraction of the ramp that has been applied at the given
+// height, between 0 and 1.
+func (r *ParamsRamp) Progress(height int64) math.LegacyDec {
+	if r.Blocks == 0 {
+		return math.LegacyOneDec()
+	}
+
+	elapsed := math.LegacyNewDecFromInt(math.NewIntFromUint64(r.ElapsedBlocks(height)))
+	return elapsed.QuoInt(math.NewIntFromUint64(r.Blocks))
+}
+
+// ParamsAt returns the params at the given height. The numeric params that are set,
+// i.e. non-nil and non-zero, in both the start and target params are interpolated
+// linearly between their start and target value. Since zero typically disables a
+// param, all other params take their target value from the start of the ramp. As a
+// result, the params at every height lie between the params at the start height and
+// the target params.
+func (r *ParamsRamp) ParamsAt(height int64) Params {
+	elapsed := r.ElapsedBlocks(height)
+	if elapsed == r.Blocks {
+		return r.TargetParams
+	}
+
+	params := r.TargetParams
+	start := r.StartParams
+
+	decs := []struct {
+		value *math.LegacyDec
+		start math.LegacyDec
+	}{
+		{&params.Alpha, start.Alpha},
+		{&params.Beta, start.Beta},
+		{&params.Gamma, start.Gamma},
+		{&params.Delta, start.Delta},
+		{&params.MinBaseGasPrice, start.MinBaseGasPrice},
+		{&params.MinLearningRate, start.MinLearningRate},
+		{&params.MaxLearningRate, start.MaxLearningRate},
+		{&params.TargetUtilizationRatio, start.TargetUtilizationRatio},
+		{&params.MaxBaseGasPrice, start.MaxBaseGasPrice},
+		{&params.MaxBaseGasPriceIncrease, start.MaxBaseGasPriceIncrease},
+		{&params.MaxBaseGasPriceDecrease, start.MaxBaseGasPriceDecrease},
+		{&params.PidProportionalGain, start.PidProportionalGain},
+		{&params.PidIntegralGain, start.PidIntegralGain},
+		{&params.PidDerivativeGain, start.PidDerivativeGain},
+		{&params.PidIntegralLimit, start.PidIntegralLimit},
+		{&params.MinBaseBytePrice, start.MinBaseBytePrice},
+		{&params.ByteLearningRate, start.ByteLearningRate},
+	}
+	for _, d := range decs {
+		*d.value = interpolateDec(d.start, *d.value, elapsed, r.Blocks)
+	}
+
+	uints := []struct {
+		value *uint64
+		start uint64
+	}{
+		{&params.MaxBlockUtilization, start.MaxBlockUtilization},
+		{&params.ExcessGasUpdateFraction, start.ExcessGasUpdateFraction},
+		{&params.MaxBlockBytes, start.MaxBlockBytes},
+		{&params.TargetBlockBytes, start.TargetBlockBytes},
+	}
+	for _, u := range uints {
+		*u.value = interpolateUint64(u.start, *u.value, elapsed, r.Blocks)
+	}
+
+	return params
+}
+
+// interpolateDec returns start + (target - start) * elapsed / blocks, or target if
+// either value is unset. The result is computed exactly and truncated.
+func interpolateDec(start, target math.LegacyDec, elapsed, blocks uint64) math.LegacyDec {
+	if start.IsNil() || target.IsNil() || start.IsZero() || target.IsZero() {
+		return target
+	}
+
+	delta := new(big.Int).Sub(target.BigInt(), start.BigInt())
+	delta.Mul(delta, new(big.Int).SetUint64(elapsed))
+	delta.Quo(delta, new(big.Int).SetUint64(blocks))
+
+	return start.Add(math.LegacyNewDecFromBigIntWithPrec(delta, math.LegacyPrecision))
+}
+
+// interpolateUint64 returns start + (target - start) * elapsed / blocks, or target
+// if either value is zero. The elapsed blocks must be less than blocks.
+func interpolateUint64(start, target, elapsed, blocks uint64) uint64 {
+	if start == 0 || target == 0 {
+		return target
+	}
+
+	if target >= start {
+		return start + mulDiv(target-start, elapsed, blocks)
+	}
+
+	return start - mulDiv(start-target, elapsed, blocks)
+}
+
+// mulDiv returns a * b / c without intermediate overflow. b must be less than c.
+func mulDiv(a, b, c uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	quo, _ := bits.Div64(hi, lo, c)
+	return quo
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestParamsRamp_ParamsAt(t *testing.T) {
+	start := types.DefaultParams()
+	target := types.DefaultAIMDParams()
+	ramp := types.NewParamsRamp(start, target, 100, 4)
+	require.NoError(t, ramp.ValidateBasic())
+
+	t.Run("starts from the start values of the interpolated params", func(t *testing.T) {
+		params := ramp.ParamsAt(100)
+		require.Equal(t, start.MinBaseGasPrice, params.MinBaseGasPrice)
+		require.Equal(t, start.MinLearningRate, params.MinLearningRate)
+		require.Equal(t, start.MaxLearningRate, params.MaxLearningRate)
+		require.Equal(t, params, ramp.ParamsAt(50))
+		require.True(t, ramp.Progress(100).IsZero())
+	})
+
+	t.Run("interpolates params that are set in both params", func(t *testing.T) {
+		params := ramp.ParamsAt(101)
+
+		// min base gas price: 1 + (1e9 - 1) / 4
+		expected := start.MinBaseGasPrice.Add(target.MinBaseGasPrice.Sub(start.MinBaseGasPrice).QuoInt64(4))
+		require.Equal(t, expected, params.MinBaseGasPrice)
+
+		// learning rate bounds move towards the target bounds
+		require.True(t, params.MaxLearningRate.GT(start.MaxLearningRate))
+		require.True(t, params.MaxLearningRate.LT(target.MaxLearningRate))
+		require.True(t, params.MinLearningRate.LT(start.MinLearningRate))
+		require.True(t, params.MinLearningRate.GT(target.MinLearningRate))
+		require.True(t, params.MinLearningRate.LTE(params.MaxLearningRate))
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), ramp.Progress(101))
+		require.NoError(t, params.ValidateBasic())
+	})
+
+	t.Run("takes the target value of all other params from the start", func(t *testing.T) {
+		params := ramp.ParamsAt(101)
+
+		// alpha is zero in the start params
+		require.Equal(t, target.Alpha, params.Alpha)
+		require.Equal(t, target.Window, params.Window)
+		require.Equal(t, target.PricingModel, params.PricingModel)
+		require.Equal(t, target.FeeDenom, params.FeeDenom)
+		require.False(t, ramp.IsComplete(103))
+	})
+
+	t.Run("returns the target params once the ramp completes", func(t *testing.T) {
+		require.Equal(t, target, ramp.ParamsAt(104))
+		require.Equal(t, target, ramp.ParamsAt(1000))
+		require.True(t, ramp.IsComplete(104))
+		require.Equal(t, math.LegacyOneDec(), ramp.Progress(1000))
+	})
+
+	t.Run("interpolates decreasing integer params", func(t *testing.T) {
+		start := types.DefaultParams()
+		start.MaxBlockUtilization = 100
+		target := types.DefaultParams()
+		target.MaxBlockUtilization = 10
+		ramp := types.NewParamsRamp(start, target, 0, 3)
+
+		require.Equal(t, uint64(70), ramp.ParamsAt(1).MaxBlockUtilization)
+		require.Equal(t, uint64(40), ramp.ParamsAt(2).MaxBlockUtilization)
+		require.Equal(t, uint64(10), ramp.ParamsAt(3).MaxBlockUtilization)
+	})
+}
+
+func TestParamsRamp_ValidateBasic(t *testing.T) {
+	t.Run("rejects a ramp without blocks", func(t *testing.T) {
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.DefaultAIMDParams(), 0, 0)
+		require.Error(t, ramp.ValidateBasic())
+	})
+
+	t.Run("rejects a negative start height", func(t *testing.T) {
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.DefaultAIMDParams(), -1, 10)
+		require.Error(t, ramp.ValidateBasic())
+	})
+
+	t.Run("rejects invalid target params", func(t *testing.T) {
+		ramp := types.NewParamsRamp(types.DefaultParams(), types.Params{}, 0, 10)
+		require.Error(t, ramp.ValidateBasic())
+	})
+}
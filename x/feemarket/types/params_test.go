@@ -2,8 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -182,6 +185,24 @@ func TestParams(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "min base gas price is zero",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MinBaseGasPrice = math.LegacyZeroDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "min learning rate is zero",
+			p: func() types.Params {
+				p := types.DefaultAIMDParams()
+				p.MinLearningRate = math.LegacyZeroDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
 		{
 			name: "min learning rate is nil",
 			p: types.Params{
@@ -273,6 +294,570 @@ func TestParams(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "window weighted average utilization mode",
+			p: func() types.Params {
+				p := types.DefaultAIMDParams()
+				p.UtilizationMode = types.UtilizationMode_UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "target utilization ratio is nil",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetUtilizationRatio = math.LegacyDec{}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "target utilization ratio is zero",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetUtilizationRatio = math.LegacyZeroDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "target utilization ratio is one",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetUtilizationRatio = math.LegacyOneDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "target utilization ratio results in zero target block utilization",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBlockUtilization = 2
+				p.TargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.3")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "custom target utilization ratio",
+			p: func() types.Params {
+				p := types.DefaultAIMDParams()
+				p.TargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.35")
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "max base gas price is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPrice = math.LegacyMustNewDecFromStr("-1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price is less than min base gas price",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPrice = p.MinBaseGasPrice.QuoInt64(2)
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price is equal to min base gas price",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPrice = p.MinBaseGasPrice
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "max base gas price increase is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price increase is too large",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPriceIncrease = types.MaxBaseGasPriceIncreaseLimit.Add(math.LegacySmallestDec())
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price increase at the limit",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPriceIncrease = types.MaxBaseGasPriceIncreaseLimit
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "max base gas price decrease is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price decrease is greater than one",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("1.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "custom max base gas price and per-block limits",
+			p: func() types.Params {
+				p := types.DefaultAIMDParams()
+				p.MaxBaseGasPrice = p.MinBaseGasPrice.MulInt64(100)
+				p.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.5")
+				p.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("0.1")
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "pid proportional gain is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PidProportionalGain = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid integral gain is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PidIntegralGain = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid derivative gain is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PidDerivativeGain = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid integral limit is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PidIntegralLimit = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid params are nil",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PidProportionalGain = math.LegacyDec{}
+				p.PidIntegralGain = math.LegacyDec{}
+				p.PidDerivativeGain = math.LegacyDec{}
+				p.PidIntegralLimit = math.LegacyDec{}
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "target block time is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetBlockTime = -time.Second
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "downtime threshold is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.DowntimeThreshold = -time.Second
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "downtime threshold is less than target block time",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetBlockTime = 5 * time.Second
+				p.DowntimeThreshold = time.Second
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "custom target block time and downtime threshold",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetBlockTime = 5 * time.Second
+				p.DowntimeThreshold = time.Minute
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "min base byte price is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MinBaseBytePrice = math.LegacyMustNewDecFromStr("-1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "byte learning rate is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.ByteLearningRate = math.LegacyMustNewDecFromStr("-1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "byte dimension with zero target block bytes",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBlockBytes = 1000
+				p.MinBaseBytePrice = math.LegacyOneDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "byte dimension with target block bytes above max",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBlockBytes = 1000
+				p.TargetBlockBytes = 1001
+				p.MinBaseBytePrice = math.LegacyOneDec()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "byte dimension with zero min base byte price",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBlockBytes = 1000
+				p.TargetBlockBytes = 500
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid byte dimension",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxBlockBytes = 1000
+				p.TargetBlockBytes = 500
+				p.MinBaseBytePrice = math.LegacyOneDec()
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "min base gas price above the saturation limit",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MinBaseGasPrice = types.MaxDecValue.Add(math.LegacyOneDec())
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid fee multipliers",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+					{MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal", Multiplier: math.LegacyNewDec(10)},
+				}
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "fee multiplier with empty msg type url",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+					{Multiplier: math.LegacyOneDec()},
+				}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee multiplier is nil",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+				}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee multiplier is zero",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyZeroDec()},
+				}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "duplicate fee multiplier",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyOneDec()},
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+				}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid unused gas penalty",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.UnusedGasPolicy = types.UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY
+				p.UnusedGasPenalty = math.LegacyMustNewDecFromStr("0.5")
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "unknown unused gas policy",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.UnusedGasPolicy = types.UnusedGasPolicy(100)
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "negative unused gas penalty",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.UnusedGasPenalty = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "unused gas penalty greater than one",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.UnusedGasPenalty = math.LegacyMustNewDecFromStr("1.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "unknown utilization mode",
+			p: func() types.Params {
+				p := types.DefaultAIMDParams()
+				p.UtilizationMode = types.UtilizationMode(100)
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid guardian",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Guardian = sdk.AccAddress("guardian").String()
+				p.MaxFreezeBlocks = 100
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "invalid guardian address",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Guardian = "invalid"
+				p.MaxFreezeBlocks = 100
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "guardian without max freeze blocks",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Guardian = sdk.AccAddress("guardian").String()
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "burn fees",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BurnFees = true
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "burn and distribute fees",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BurnFees = true
+				p.DistributeFees = true
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid fee and tip split",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				feeSplit := types.NewFeeSplit(5000, 3000, 2000)
+				tipSplit := types.NewTipSplit(5000, 5000)
+				p.FeeSplit = &feeSplit
+				p.TipSplit = &tipSplit
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "fee split with distributed fees",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				feeSplit := types.NewFeeSplit(5000, 3000, 2000)
+				p.FeeSplit = &feeSplit
+				p.DistributeFees = true
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee split with burned fees",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				feeSplit := types.NewFeeSplit(5000, 3000, 2000)
+				p.FeeSplit = &feeSplit
+				p.BurnFees = true
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "invalid fee split",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				feeSplit := types.NewFeeSplit(5000, 3000, 3000)
+				p.FeeSplit = &feeSplit
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "invalid tip split",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				tipSplit := types.NewTipSplit(0, 0)
+				p.TipSplit = &tipSplit
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid fee exemptions",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{
+					{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", MaxTxGas: 1_000_000},
+					{Address: sdk.AccAddress("relayer").String(), MaxTxGas: 1_000_000},
+					{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", Address: sdk.AccAddress("relayer").String(), MaxTxGas: 2_000_000},
+				}
+				p.MaxBlockExemptGas = 5_000_000
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "fee exemption without msg type url or address",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{{MaxTxGas: 1_000_000}}
+				p.MaxBlockExemptGas = 5_000_000
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee exemption with invalid address",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{{Address: "invalid", MaxTxGas: 1_000_000}}
+				p.MaxBlockExemptGas = 5_000_000
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee exemption without max tx gas",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient"}}
+				p.MaxBlockExemptGas = 5_000_000
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "duplicate fee exemption",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{
+					{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", MaxTxGas: 1_000_000},
+					{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", MaxTxGas: 2_000_000},
+				}
+				p.MaxBlockExemptGas = 5_000_000
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "fee exemptions without max block exempt gas",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeExemptions = []types.FeeExemption{{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", MaxTxGas: 1_000_000}}
+				return p
+			}(),
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -286,3 +871,134 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ClampBaseGasPrice(t *testing.T) {
+	previous := math.LegacyNewDec(10)
+
+	params := types.DefaultParams()
+	params.MaxBaseGasPrice = math.LegacyNewDec(12)
+	params.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.5")
+	params.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("0.1")
+
+	testCases := []struct {
+		name          string
+		params        types.Params
+		next          math.LegacyDec
+		expectedPrice math.LegacyDec
+		expectedBound string
+	}{
+		{
+			name:          "no bounds configured",
+			params:        types.DefaultParams(),
+			next:          math.LegacyNewDec(1000),
+			expectedPrice: math.LegacyNewDec(1000),
+			expectedBound: types.BaseGasPriceBoundNone,
+		},
+		{
+			name:          "within bounds",
+			params:        params,
+			next:          math.LegacyNewDec(11),
+			expectedPrice: math.LegacyNewDec(11),
+			expectedBound: types.BaseGasPriceBoundNone,
+		},
+		{
+			name: "max increase",
+			params: func() types.Params {
+				p := params
+				p.MaxBaseGasPrice = math.LegacyZeroDec()
+				return p
+			}(),
+			next:          math.LegacyNewDec(20),
+			expectedPrice: math.LegacyNewDec(15),
+			expectedBound: types.BaseGasPriceBoundMaxIncrease,
+		},
+		{
+			name:          "max base gas price takes precedence over max increase",
+			params:        params,
+			next:          math.LegacyNewDec(20),
+			expectedPrice: math.LegacyNewDec(12),
+			expectedBound: types.BaseGasPriceBoundMaxBaseGasPrice,
+		},
+		{
+			name:          "max decrease",
+			params:        params,
+			next:          math.LegacyNewDec(1),
+			expectedPrice: math.LegacyNewDec(9),
+			expectedBound: types.BaseGasPriceBoundMaxDecrease,
+		},
+		{
+			name: "min base gas price takes precedence",
+			params: func() types.Params {
+				p := params
+				p.MinBaseGasPrice = math.LegacyNewDec(12)
+				p.MaxBaseGasPrice = math.LegacyZeroDec()
+				return p
+			}(),
+			next:          math.LegacyNewDec(12),
+			expectedPrice: math.LegacyNewDec(12),
+			expectedBound: types.BaseGasPriceBoundNone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, bound := tc.params.ClampBaseGasPrice(previous, tc.next)
+			require.Equal(t, tc.expectedPrice, price)
+			require.Equal(t, tc.expectedBound, bound)
+		})
+	}
+
+	t.Run("max increase saturates instead of overflowing", func(t *testing.T) {
+		p := types.DefaultParams()
+		p.MaxBaseGasPriceIncrease = types.MaxBaseGasPriceIncreaseLimit
+
+		price, bound := p.ClampBaseGasPrice(types.MaxDecValue, types.MaxDecValue)
+		require.Equal(t, types.MaxDecValue, price)
+		require.Equal(t, types.BaseGasPriceBoundNone, bound)
+	})
+}
+
+func TestParams_GetTxFeeMultiplier(t *testing.T) {
+	params := types.DefaultParams()
+	params.MsgFeeMultipliers = []types.MsgFeeMultiplier{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), Multiplier: math.LegacyNewDec(3)},
+	}
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected math.LegacyDec
+	}{
+		{
+			name:     "no messages",
+			expected: math.LegacyOneDec(),
+		},
+		{
+			name:     "message without multiplier",
+			msgs:     []sdk.Msg{&types.MsgParams{}},
+			expected: math.LegacyOneDec(),
+		},
+		{
+			name:     "discounted message",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}},
+			expected: math.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			name:     "highest multiplier of mixed messages",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			expected: math.LegacyNewDec(3),
+		},
+		{
+			name:     "message without multiplier outweighs discount",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}, &types.MsgParams{}},
+			expected: math.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.GetTxFeeMultiplier(tc.msgs))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ParamChange records the old and new value of a parameter changed by a partial
+// parameter update.
+type ParamChange struct {
+	// Field is the proto field name of the parameter.
+	Field string
+	// OldValue is the value of the parameter before the update.
+	OldValue string
+	// NewValue is the value of the parameter after the update.
+	NewValue string
+}
+
+// ApplyUpdateMask returns a copy of the params with the fields listed in the
+// update mask set to their value in the given update. The update mask lists proto
+// field names, e.g. "fee_denom". The changes are returned in the order of the
+// update mask. An error is returned if the mask is empty or lists an unknown or
+// duplicate field. Note that the merged params are not validated.
+func (p Params) ApplyUpdateMask(update Params, mask []string) (Params, []ParamChange, error) {
+	if len(mask) == 0 {
+		return Params{}, nil, fmt.Errorf("update mask cannot be empty")
+	}
+
+	merged := p
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	updateValue := reflect.ValueOf(update)
+
+	fields := paramsFieldIndices()
+	changes := make([]ParamChange, 0, len(mask))
+	seen := make(map[string]struct{}, len(mask))
+	for _, field := range mask {
+		index, ok := fields[field]
+		if !ok {
+			return Params{}, nil, fmt.Errorf("unknown params field %q in update mask", field)
+		}
+
+		if _, ok := seen[field]; ok {
+			return Params{}, nil, fmt.Errorf("duplicate params field %q in update mask", field)
+		}
+		seen[field] = struct{}{}
+
+		oldValue := mergedValue.Field(index)
+		newValue := updateValue.Field(index)
+		changes = append(changes, ParamChange{
+			Field:    field,
+			OldValue: fmt.Sprint(oldValue.Interface()),
+			NewValue: fmt.Sprint(newValue.Interface()),
+		})
+		oldValue.Set(newValue)
+	}
+
+	return merged, changes, nil
+}
+
+// paramsFieldIndices returns the struct field index of each params field keyed
+// by its proto field name.
+func paramsFieldIndices() map[string]int {
+	t := reflect.TypeOf(Params{})
+	indices := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		for _, option := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if name, ok := strings.CutPrefix(option, "name="); ok {
+				indices[name] = i
+			}
+		}
+	}
+
+	return indices
+}
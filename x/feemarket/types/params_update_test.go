@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestParams_ApplyUpdateMask(t *testing.T) {
+	current := types.DefaultParams()
+
+	update := types.DefaultAIMDParams()
+	update.FeeDenom = "atom"
+	update.MinBaseGasPrice = math.LegacyNewDec(5)
+	update.SendTipToProposer = !current.SendTipToProposer
+
+	t.Run("updates only the fields in the mask", func(t *testing.T) {
+		merged, changes, err := current.ApplyUpdateMask(update, []string{"fee_denom", "min_base_gas_price"})
+		require.NoError(t, err)
+
+		expected := current
+		expected.FeeDenom = update.FeeDenom
+		expected.MinBaseGasPrice = update.MinBaseGasPrice
+		require.Equal(t, expected, merged)
+
+		require.Equal(t, []types.ParamChange{
+			{Field: "fee_denom", OldValue: current.FeeDenom, NewValue: "atom"},
+			{Field: "min_base_gas_price", OldValue: current.MinBaseGasPrice.String(), NewValue: update.MinBaseGasPrice.String()},
+		}, changes)
+	})
+
+	t.Run("does not modify the current params", func(t *testing.T) {
+		before := types.DefaultParams()
+		_, _, err := current.ApplyUpdateMask(update, []string{"fee_denom", "send_tip_to_proposer"})
+		require.NoError(t, err)
+		require.Equal(t, before, current)
+	})
+
+	t.Run("rejects an empty mask", func(t *testing.T) {
+		_, _, err := current.ApplyUpdateMask(update, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects an unknown field", func(t *testing.T) {
+		_, _, err := current.ApplyUpdateMask(update, []string{"FeeDenom"})
+		require.Error(t, err)
+	})
+
+	t.Run("rejects a duplicate field", func(t *testing.T) {
+		_, _, err := current.ApplyUpdateMask(update, []string{"fee_denom", "fee_denom"})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PricingModelEIP1559 is the name of the base EIP-1559 pricing model.
+	PricingModelEIP1559 = "eip1559"
+
+	// PricingModelAIMD is the name of the AIMD EIP-1559 pricing model.
+	PricingModelAIMD = "aimd"
+
+	// PricingModelExponential is the name of the EIP-4844 style exponential
+	// excess gas pricing model.
+	PricingModelExponential = "exponential"
+
+	// PricingModelPID is the name of the PID controller pricing model.
+	PricingModelPID = "pid"
+)
+
+// PricingModel is an interface that determines how the base gas price of the
+// fee market evolves over time. The keeper selects the model to use based on
+// Params.PricingModel, which allows chains to ship their own model without
+// modifying the keeper.
+type PricingModel interface {
+	// Name returns the name under which the pricing model is registered.
+	Name() string
+
+	// ValidateParams performs pricing model specific validation of the given
+	// parameters. This is executed in addition to Params.ValidateBasic.
+	ValidateParams(params Params) error
+
+	// UpdateOnBlockEnd updates the fee market state at the end of a block,
+	// given the block utilization recorded in the state's window.
+	UpdateOnBlockEnd(ctx sdk.Context, state *State, params Params) error
+
+	// CurrentPrice returns the base gas price implied by the given state.
+	CurrentPrice(state State, params Params) math.LegacyDec
+}
+
+// DefaultPricingModels returns the pricing models that are registered with the
+// keeper by default.
+func DefaultPricingModels() []PricingModel {
+	return []PricingModel{
+		EIP1559PricingModel{},
+		AIMDPricingModel{},
+		ExponentialPricingModel{},
+		PIDPricingModel{},
+	}
+}
+
+var _ PricingModel = EIP1559PricingModel{}
+
+// EIP1559PricingModel implements the base EIP-1559 fee market. The learning rate
+// is fixed, so the base gas price is only adjusted based on the utilization of
+// the current block.
+type EIP1559PricingModel struct{}
+
+// Name returns the name of the base EIP-1559 pricing model.
+func (EIP1559PricingModel) Name() string {
+	return PricingModelEIP1559
+}
+
+// ValidateParams ensures that the learning rate bounds are equal, since the base
+// EIP-1559 model does not adjust the learning rate.
+func (EIP1559PricingModel) ValidateParams(params Params) error {
+	if !params.MinLearningRate.Equal(params.MaxLearningRate) {
+		return fmt.Errorf("min and max learning rate must be equal for the %s pricing model", PricingModelEIP1559)
+	}
+
+	return nil
+}
+
+// UpdateOnBlockEnd updates the base gas price using the current learning rate.
+func (EIP1559PricingModel) UpdateOnBlockEnd(_ sdk.Context, state *State, params Params) error {
+	state.UpdateBaseGasPrice(params)
+	return nil
+}
+
+// CurrentPrice returns the base gas price stored in the state.
+func (EIP1559PricingModel) CurrentPrice(state State, _ Params) math.LegacyDec {
+	return state.BaseGasPrice
+}
+
+var _ PricingModel = AIMDPricingModel{}
+
+// AIMDPricingModel implements the AIMD EIP-1559 fee market. The learning rate is
+// first adjusted based on the average utilization of the window, after which the
+// base gas price is updated with the new learning rate.
+type AIMDPricingModel struct{}
+
+// Name returns the name of the AIMD EIP-1559 pricing model.
+func (AIMDPricingModel) Name() string {
+	return PricingModelAIMD
+}
+
+// ValidateParams is a no-op, as all AIMD parameters are validated by
+// Params.ValidateBasic.
+func (AIMDPricingModel) ValidateParams(_ Params) error {
+	return nil
+}
+
+// UpdateOnBlockEnd updates the learning rate and then the base gas price.
+func (AIMDPricingModel) UpdateOnBlockEnd(_ sdk.Context, state *State, params Params) error {
+	state.UpdateLearningRate(params)
+	state.UpdateBaseGasPrice(params)
+	return nil
+}
+
+// CurrentPrice returns the base gas price stored in the state.
+func (AIMDPricingModel) CurrentPrice(state State, _ Params) math.LegacyDec {
+	return state.BaseGasPrice
+}
+
+var _ PricingModel = ExponentialPricingModel{}
+
+// ExponentialPricingModel implements an EIP-4844 style fee market. The state
+// tracks the gas consumed above the target block utilization, and the base gas
+// price is MinBaseGasPrice * e^(ExcessGas / ExcessGasUpdateFraction). The price
+// only depends on the accumulated excess gas, not on the path taken to get there,
+// which makes it easy to predict. The learning rate is not used.
+type ExponentialPricingModel struct{}
+
+// Name returns the name of the exponential pricing model.
+func (ExponentialPricingModel) Name() string {
+	return PricingModelExponential
+}
+
+// ValidateParams ensures that the update fraction is set and that the maximum
+// base gas price of the model, MinBaseGasPrice * e^MaxExcessGasExponent, stays
+// below the saturation limit.
+func (ExponentialPricingModel) ValidateParams(params Params) error {
+	if params.ExcessGasUpdateFraction == 0 || params.ExcessGasUpdateFraction > MaxExcessGasUpdateFraction {
+		return fmt.Errorf(
+			"excess gas update fraction must be between (0, %d] for the %s pricing model",
+			MaxExcessGasUpdateFraction,
+			PricingModelExponential,
+		)
+	}
+
+	maxExponent := FakeExponential(math.LegacyOneDec(), math.NewIntFromUint64(MaxExcessGasExponent), math.OneInt())
+	if IsSaturated(SaturatingMul(params.MinBaseGasPrice, maxExponent)) {
+		return fmt.Errorf("min base gas price is too large for the %s pricing model", PricingModelExponential)
+	}
+
+	return nil
+}
+
+// UpdateOnBlockEnd accumulates the excess gas of the current block and updates
+// the base gas price accordingly.
+func (ExponentialPricingModel) UpdateOnBlockEnd(_ sdk.Context, state *State, params Params) error {
+	state.UpdateExcessGas(params)
+	state.BaseGasPrice = state.GetExponentialBaseGasPrice(params)
+	return nil
+}
+
+// CurrentPrice returns the base gas price stored in the state.
+func (ExponentialPricingModel) CurrentPrice(state State, _ Params) math.LegacyDec {
+	return state.BaseGasPrice
+}
+
+var _ PricingModel = PIDPricingModel{}
+
+// PIDPricingModel adjusts the base gas price with a PID controller on the
+// utilization error across the window. The gains are configured with the
+// PidProportionalGain, PidIntegralGain and PidDerivativeGain params, and the
+// integral term is bounded by PidIntegralLimit. The learning rate is not used.
+type PIDPricingModel struct{}
+
+// Name returns the name of the PID pricing model.
+func (PIDPricingModel) Name() string {
+	return PricingModelPID
+}
+
+// ValidateParams ensures that all PID params are set and that the controller has
+// at least one positive gain.
+func (PIDPricingModel) ValidateParams(params Params) error {
+	if params.PidProportionalGain.IsNil() || params.PidIntegralGain.IsNil() ||
+		params.PidDerivativeGain.IsNil() || params.PidIntegralLimit.IsNil() {
+		return fmt.Errorf("pid gains and integral limit must be set for the %s pricing model", PricingModelPID)
+	}
+
+	if params.PidProportionalGain.IsZero() && params.PidIntegralGain.IsZero() && params.PidDerivativeGain.IsZero() {
+		return fmt.Errorf("at least one pid gain must be positive for the %s pricing model", PricingModelPID)
+	}
+
+	return nil
+}
+
+// UpdateOnBlockEnd updates the base gas price using the PID controller.
+func (PIDPricingModel) UpdateOnBlockEnd(_ sdk.Context, state *State, params Params) error {
+	state.UpdatePIDBaseGasPrice(params)
+	return nil
+}
+
+// CurrentPrice returns the base gas price stored in the state.
+func (PIDPricingModel) CurrentPrice(state State, _ Params) math.LegacyDec {
+	return state.BaseGasPrice
+}
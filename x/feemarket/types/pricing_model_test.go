@@ -0,0 +1,178 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestEIP1559PricingModel(t *testing.T) {
+	model := types.EIP1559PricingModel{}
+
+	t.Run("validates default params", func(t *testing.T) {
+		require.NoError(t, model.ValidateParams(types.DefaultParams()))
+	})
+
+	t.Run("rejects differing learning rate bounds", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxLearningRate = math.LegacyMustNewDecFromStr("0.5")
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("full block only updates the base gas price", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.Alpha = math.LegacyMustNewDecFromStr("0.1")
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &state, params))
+
+		expectedBaseGasPrice := params.MinBaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.125"))
+		require.Equal(t, expectedBaseGasPrice, model.CurrentPrice(state, params))
+		require.Equal(t, params.MinLearningRate, state.LearningRate)
+	})
+}
+
+func TestAIMDPricingModel(t *testing.T) {
+	model := types.AIMDPricingModel{}
+
+	t.Run("validates default params", func(t *testing.T) {
+		require.NoError(t, model.ValidateParams(types.DefaultAIMDParams()))
+	})
+
+	t.Run("empty block updates the learning rate and base gas price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = state.BaseGasPrice.Mul(math.LegacyNewDec(2))
+		params := types.DefaultAIMDParams()
+
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &state, params))
+
+		expectedLR := params.MinLearningRate.Add(params.Alpha)
+		require.Equal(t, expectedLR, state.LearningRate)
+
+		expectedBaseGasPrice := params.MinBaseGasPrice.Mul(math.LegacyNewDec(2)).Mul(math.LegacyOneDec().Sub(expectedLR))
+		require.Equal(t, expectedBaseGasPrice, model.CurrentPrice(state, params))
+	})
+}
+
+func TestExponentialPricingModel(t *testing.T) {
+	model := types.ExponentialPricingModel{}
+
+	t.Run("validates default params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		require.NoError(t, model.ValidateParams(params))
+	})
+
+	t.Run("rejects zero update fraction", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.ExcessGasUpdateFraction = 0
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("rejects update fraction above the maximum", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.ExcessGasUpdateFraction = types.MaxExcessGasUpdateFraction + 1
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("does not overflow at the maximum update fraction", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.ExcessGasUpdateFraction = types.MaxExcessGasUpdateFraction
+		params.MinBaseGasPrice = math.LegacyNewDec(10).Power(22)
+		require.NoError(t, model.ValidateParams(params))
+
+		state.ExcessGas = params.ExcessGasUpdateFraction * types.MaxExcessGasExponent
+		require.NotPanics(t, func() {
+			require.False(t, types.IsSaturated(state.GetExponentialBaseGasPrice(params)))
+		})
+	})
+
+	t.Run("rejects min base gas price that overflows", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MinBaseGasPrice = math.LegacyNewDec(10).Power(50)
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("full block increases the base gas price exponentially", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &state, params))
+
+		require.Equal(t, params.TargetBlockUtilization(), state.ExcessGas)
+
+		// A full block increases the base gas price by ~12.5% with the default params.
+		price := model.CurrentPrice(state, params)
+		require.InEpsilon(t, 1.125, price.MustFloat64(), 1e-6)
+		require.Equal(t, params.MinLearningRate, state.LearningRate)
+	})
+
+	t.Run("price only depends on the excess gas", func(t *testing.T) {
+		params := types.DefaultParams()
+		target := params.TargetBlockUtilization()
+
+		// One block that is full and one that is at target.
+		first := types.DefaultState()
+		require.NoError(t, first.Update(params.MaxBlockUtilization, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &first, params))
+		first.IncrementHeight()
+		require.NoError(t, first.Update(target, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &first, params))
+
+		// One block that is at target and one that is full.
+		second := types.DefaultState()
+		require.NoError(t, second.Update(target, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &second, params))
+		second.IncrementHeight()
+		require.NoError(t, second.Update(params.MaxBlockUtilization, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &second, params))
+
+		require.Equal(t, first.ExcessGas, second.ExcessGas)
+		require.Equal(t, model.CurrentPrice(first, params), model.CurrentPrice(second, params))
+	})
+}
+
+func TestPIDPricingModel(t *testing.T) {
+	model := types.PIDPricingModel{}
+
+	t.Run("validates default params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelPID
+		require.NoError(t, model.ValidateParams(params))
+	})
+
+	t.Run("rejects nil gains", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.PidIntegralGain = math.LegacyDec{}
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("rejects all zero gains", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.PidProportionalGain = math.LegacyZeroDec()
+		params.PidIntegralGain = math.LegacyZeroDec()
+		params.PidDerivativeGain = math.LegacyZeroDec()
+		require.Error(t, model.ValidateParams(params))
+	})
+
+	t.Run("full block updates the base gas price", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		require.NoError(t, model.UpdateOnBlockEnd(sdk.Context{}, &state, params))
+
+		// The error is 1 for the proportional and integral terms. The window only
+		// contains a single block, so the derivative term is zero.
+		factor := math.LegacyOneDec().Add(params.PidProportionalGain).Add(params.PidIntegralGain)
+		require.Equal(t, params.MinBaseGasPrice.Mul(factor), model.CurrentPrice(state, params))
+		require.Equal(t, params.MinLearningRate, state.LearningRate)
+	})
+}
@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -154,6 +156,13 @@ var xxx_messageInfo_StateRequest proto.InternalMessageInfo
 // StateResponse is the response type for the Query/State RPC method.
 type StateResponse struct {
 	State State `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// ParamsRamp is the parameter ramp in progress, if any.
+	ParamsRamp *ParamsRamp `protobuf:"bytes,2,opt,name=params_ramp,json=paramsRamp,proto3" json:"params_ramp,omitempty"`
+	// RampProgress is the fraction of the parameter ramp that has been
+	// applied, between 0 and 1. It is zero if no ramp is in progress.
+	RampProgress cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ramp_progress,json=rampProgress,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ramp_progress"`
+	// FeeFreeze is the freeze of the base gas price in effect, if any.
+	FeeFreeze *FeeFreeze `protobuf:"bytes,4,opt,name=fee_freeze,json=feeFreeze,proto3" json:"fee_freeze,omitempty"`
 }
 
 func (m *StateResponse) Reset()         { *m = StateResponse{} }
@@ -196,10 +205,27 @@ func (m *StateResponse) GetState() State {
 	return State{}
 }
 
+func (m *StateResponse) GetParamsRamp() *ParamsRamp {
+	if m != nil {
+		return m.ParamsRamp
+	}
+	return nil
+}
+
+func (m *StateResponse) GetFeeFreeze() *FeeFreeze {
+	if m != nil {
+		return m.FeeFreeze
+	}
+	return nil
+}
+
 // GasPriceRequest is the request type for the Query/GasPrice RPC method.
 type GasPriceRequest struct {
 	// denom we are querying gas price in
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// msg_type_url is an optional message type URL. If set, the gas price is
+	// scaled by the fee multiplier of the message type.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *GasPriceRequest) Reset()         { *m = GasPriceRequest{} }
@@ -242,6 +268,13 @@ func (m *GasPriceRequest) GetDenom() string {
 	return ""
 }
 
+func (m *GasPriceRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // GasPriceResponse is the response type for the Query/GasPrice RPC method.
 // Returns a gas price in specified denom.
 type GasPriceResponse struct {
@@ -371,6 +404,433 @@ func (m *GasPricesResponse) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
+// FeeMultipliersRequest is the request type for the Query/FeeMultipliers RPC
+// method.
+type FeeMultipliersRequest struct {
+}
+
+func (m *FeeMultipliersRequest) Reset()         { *m = FeeMultipliersRequest{} }
+func (m *FeeMultipliersRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeMultipliersRequest) ProtoMessage()    {}
+func (*FeeMultipliersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{8}
+}
+func (m *FeeMultipliersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMultipliersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMultipliersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMultipliersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMultipliersRequest.Merge(m, src)
+}
+func (m *FeeMultipliersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMultipliersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMultipliersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMultipliersRequest proto.InternalMessageInfo
+
+// FeeMultipliersResponse is the response type for the Query/FeeMultipliers RPC
+// method.
+type FeeMultipliersResponse struct {
+	Multipliers []MsgFeeMultiplier `protobuf:"bytes,1,rep,name=multipliers,proto3" json:"multipliers"`
+}
+
+func (m *FeeMultipliersResponse) Reset()         { *m = FeeMultipliersResponse{} }
+func (m *FeeMultipliersResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeMultipliersResponse) ProtoMessage()    {}
+func (*FeeMultipliersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{9}
+}
+func (m *FeeMultipliersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMultipliersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMultipliersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMultipliersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMultipliersResponse.Merge(m, src)
+}
+func (m *FeeMultipliersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMultipliersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMultipliersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMultipliersResponse proto.InternalMessageInfo
+
+func (m *FeeMultipliersResponse) GetMultipliers() []MsgFeeMultiplier {
+	if m != nil {
+		return m.Multipliers
+	}
+	return nil
+}
+
+// ScheduledParamsRequest is the request type for the Query/ScheduledParams RPC
+// method.
+type ScheduledParamsRequest struct {
+}
+
+func (m *ScheduledParamsRequest) Reset()         { *m = ScheduledParamsRequest{} }
+func (m *ScheduledParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsRequest) ProtoMessage()    {}
+func (*ScheduledParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{10}
+}
+func (m *ScheduledParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsRequest.Merge(m, src)
+}
+func (m *ScheduledParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsRequest proto.InternalMessageInfo
+
+// ScheduledParamsResponse is the response type for the Query/ScheduledParams
+// RPC method.
+type ScheduledParamsResponse struct {
+	ScheduledParams []ScheduledParams `protobuf:"bytes,1,rep,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params"`
+}
+
+func (m *ScheduledParamsResponse) Reset()         { *m = ScheduledParamsResponse{} }
+func (m *ScheduledParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsResponse) ProtoMessage()    {}
+func (*ScheduledParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{11}
+}
+func (m *ScheduledParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsResponse.Merge(m, src)
+}
+func (m *ScheduledParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsResponse proto.InternalMessageInfo
+
+func (m *ScheduledParamsResponse) GetScheduledParams() []ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return nil
+}
+
+// BurnedFeesRequest is the request type for the Query/BurnedFees RPC method.
+type BurnedFeesRequest struct {
+}
+
+func (m *BurnedFeesRequest) Reset()         { *m = BurnedFeesRequest{} }
+func (m *BurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*BurnedFeesRequest) ProtoMessage()    {}
+func (*BurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{12}
+}
+func (m *BurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedFeesRequest.Merge(m, src)
+}
+func (m *BurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedFeesRequest proto.InternalMessageInfo
+
+// BurnedFeesResponse is the response type for the Query/BurnedFees RPC method.
+type BurnedFeesResponse struct {
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+}
+
+func (m *BurnedFeesResponse) Reset()         { *m = BurnedFeesResponse{} }
+func (m *BurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*BurnedFeesResponse) ProtoMessage()    {}
+func (*BurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{13}
+}
+func (m *BurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedFeesResponse.Merge(m, src)
+}
+func (m *BurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedFeesResponse proto.InternalMessageInfo
+
+func (m *BurnedFeesResponse) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+// DenomConversionsRequest is the request type for the Query/DenomConversions
+// RPC method.
+type DenomConversionsRequest struct {
+}
+
+func (m *DenomConversionsRequest) Reset()         { *m = DenomConversionsRequest{} }
+func (m *DenomConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomConversionsRequest) ProtoMessage()    {}
+func (*DenomConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{14}
+}
+func (m *DenomConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConversionsRequest.Merge(m, src)
+}
+func (m *DenomConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConversionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConversionsRequest proto.InternalMessageInfo
+
+// DenomConversionsResponse is the response type for the Query/DenomConversions
+// RPC method.
+type DenomConversionsResponse struct {
+	DenomConversions []DenomConversion `protobuf:"bytes,1,rep,name=denom_conversions,json=denomConversions,proto3" json:"denom_conversions"`
+}
+
+func (m *DenomConversionsResponse) Reset()         { *m = DenomConversionsResponse{} }
+func (m *DenomConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomConversionsResponse) ProtoMessage()    {}
+func (*DenomConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{15}
+}
+func (m *DenomConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConversionsResponse.Merge(m, src)
+}
+func (m *DenomConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConversionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConversionsResponse proto.InternalMessageInfo
+
+func (m *DenomConversionsResponse) GetDenomConversions() []DenomConversion {
+	if m != nil {
+		return m.DenomConversions
+	}
+	return nil
+}
+
+// DenomConversionRequest is the request type for the Query/DenomConversion RPC
+// method.
+type DenomConversionRequest struct {
+	// denom we are querying the conversion rate of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DenomConversionRequest) Reset()         { *m = DenomConversionRequest{} }
+func (m *DenomConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomConversionRequest) ProtoMessage()    {}
+func (*DenomConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{16}
+}
+func (m *DenomConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConversionRequest.Merge(m, src)
+}
+func (m *DenomConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConversionRequest proto.InternalMessageInfo
+
+func (m *DenomConversionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DenomConversionResponse is the response type for the Query/DenomConversion
+// RPC method.
+type DenomConversionResponse struct {
+	DenomConversion DenomConversion `protobuf:"bytes,1,opt,name=denom_conversion,json=denomConversion,proto3" json:"denom_conversion"`
+}
+
+func (m *DenomConversionResponse) Reset()         { *m = DenomConversionResponse{} }
+func (m *DenomConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomConversionResponse) ProtoMessage()    {}
+func (*DenomConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{17}
+}
+func (m *DenomConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConversionResponse.Merge(m, src)
+}
+func (m *DenomConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConversionResponse proto.InternalMessageInfo
+
+func (m *DenomConversionResponse) GetDenomConversion() DenomConversion {
+	if m != nil {
+		return m.DenomConversion
+	}
+	return DenomConversion{}
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "feemarket.feemarket.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "feemarket.feemarket.v1.ParamsResponse")
@@ -380,6 +840,16 @@ func init() {
 	proto.RegisterType((*GasPriceResponse)(nil), "feemarket.feemarket.v1.GasPriceResponse")
 	proto.RegisterType((*GasPricesRequest)(nil), "feemarket.feemarket.v1.GasPricesRequest")
 	proto.RegisterType((*GasPricesResponse)(nil), "feemarket.feemarket.v1.GasPricesResponse")
+	proto.RegisterType((*FeeMultipliersRequest)(nil), "feemarket.feemarket.v1.FeeMultipliersRequest")
+	proto.RegisterType((*FeeMultipliersResponse)(nil), "feemarket.feemarket.v1.FeeMultipliersResponse")
+	proto.RegisterType((*ScheduledParamsRequest)(nil), "feemarket.feemarket.v1.ScheduledParamsRequest")
+	proto.RegisterType((*ScheduledParamsResponse)(nil), "feemarket.feemarket.v1.ScheduledParamsResponse")
+	proto.RegisterType((*BurnedFeesRequest)(nil), "feemarket.feemarket.v1.BurnedFeesRequest")
+	proto.RegisterType((*BurnedFeesResponse)(nil), "feemarket.feemarket.v1.BurnedFeesResponse")
+	proto.RegisterType((*DenomConversionsRequest)(nil), "feemarket.feemarket.v1.DenomConversionsRequest")
+	proto.RegisterType((*DenomConversionsResponse)(nil), "feemarket.feemarket.v1.DenomConversionsResponse")
+	proto.RegisterType((*DenomConversionRequest)(nil), "feemarket.feemarket.v1.DenomConversionRequest")
+	proto.RegisterType((*DenomConversionResponse)(nil), "feemarket.feemarket.v1.DenomConversionResponse")
 }
 
 func init() {
@@ -387,43 +857,73 @@ func init() {
 }
 
 var fileDescriptor_d683b3b0d8494138 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0x18, 0xad, 0xa8, 0x61, 0x1b, 0x33, 0x65, 0x9a, 0x42, 0x49, 0x47, 0xd8, 0xe8, 0x00,
-	0x2d, 0x56, 0xc7, 0x05, 0x24, 0xb8, 0x14, 0x24, 0x04, 0x07, 0xb4, 0x95, 0x1b, 0x17, 0xe4, 0x66,
-	0x26, 0x44, 0x25, 0x76, 0x1a, 0xbb, 0x15, 0x13, 0xe2, 0x32, 0x24, 0xce, 0x48, 0xfc, 0x09, 0xc4,
-	0x89, 0x9f, 0xb1, 0xe3, 0x24, 0x2e, 0x9c, 0x00, 0xb5, 0x48, 0xfc, 0x0c, 0x50, 0xec, 0x97, 0xb6,
-	0x4c, 0xcb, 0xc2, 0xa5, 0x7d, 0x7e, 0xfe, 0xde, 0xf7, 0x3d, 0xbf, 0xf7, 0xb5, 0xc8, 0x7d, 0xc1,
-	0x58, 0x44, 0x93, 0x1e, 0x53, 0x64, 0x1a, 0x0d, 0x5b, 0xa4, 0x3f, 0x60, 0xc9, 0x9e, 0x17, 0x27,
-	0x42, 0x09, 0xbc, 0x3c, 0xb9, 0xf1, 0xa6, 0xd1, 0xb0, 0x65, 0xd7, 0x02, 0x11, 0x08, 0x0d, 0x21,
-	0x69, 0x64, 0xd0, 0x76, 0x3d, 0x10, 0x22, 0x78, 0xc5, 0x08, 0x8d, 0x43, 0x42, 0x39, 0x17, 0x8a,
-	0xaa, 0x50, 0x70, 0x09, 0xb7, 0x8e, 0x2f, 0x64, 0x24, 0x24, 0xe9, 0x52, 0xc9, 0xc8, 0xb0, 0xd5,
-	0x65, 0x8a, 0xb6, 0x88, 0x2f, 0x42, 0x0e, 0xf7, 0x4b, 0x34, 0x0a, 0xb9, 0x20, 0xfa, 0x13, 0x52,
-	0x57, 0x73, 0x5a, 0x8c, 0x69, 0x42, 0xa3, 0x8c, 0x77, 0x2d, 0x07, 0x14, 0x30, 0xce, 0x64, 0x08,
-	0x28, 0x77, 0x11, 0xcd, 0x6f, 0xeb, 0xaa, 0x0e, 0xeb, 0x0f, 0x98, 0x54, 0xee, 0x13, 0xb4, 0x90,
-	0x25, 0x64, 0x2c, 0xb8, 0x64, 0xf8, 0x2e, 0xaa, 0x18, 0xe2, 0x15, 0x6b, 0xd5, 0xda, 0x38, 0xbb,
-	0xe5, 0x78, 0xc7, 0xbf, 0xde, 0x33, 0x75, 0xed, 0xd3, 0x07, 0xdf, 0x1b, 0xa5, 0x0e, 0xd4, 0xb8,
-	0x0b, 0xe8, 0xdc, 0x53, 0x45, 0x15, 0xcb, 0xf8, 0x1f, 0xa3, 0x79, 0x38, 0x03, 0xfd, 0x1d, 0x54,
-	0x96, 0x69, 0x02, 0xd8, 0x2f, 0xe7, 0xb1, 0xeb, 0x2a, 0x20, 0x37, 0x15, 0x6e, 0x13, 0x2d, 0x3e,
-	0xa4, 0x72, 0x3b, 0x09, 0xfd, 0x8c, 0x1e, 0xd7, 0x50, 0x79, 0x97, 0x71, 0x11, 0x69, 0xb6, 0x6a,
-	0xc7, 0x1c, 0xdc, 0x1d, 0x74, 0x7e, 0x0a, 0x04, 0xdd, 0x7b, 0xa8, 0x1c, 0xa7, 0x09, 0xd0, 0xad,
-	0x7b, 0x66, 0x0f, 0x5e, 0xba, 0x07, 0x0f, 0xf6, 0xe0, 0x3d, 0x60, 0xfe, 0x7d, 0x11, 0xf2, 0x76,
-	0x35, 0x95, 0xfd, 0xf4, 0xfb, 0xcb, 0x0d, 0xab, 0x63, 0xaa, 0x5c, 0x3c, 0xa5, 0x9c, 0xcc, 0xee,
-	0x9d, 0x85, 0x96, 0x66, 0x92, 0x20, 0xc4, 0x51, 0x45, 0x97, 0xa4, 0xf3, 0x9b, 0x2b, 0x54, 0xba,
-	0x9d, 0x2a, 0x7d, 0xfe, 0xd1, 0xb8, 0x19, 0x84, 0xea, 0xe5, 0xa0, 0xeb, 0xf9, 0x22, 0x22, 0xe0,
-	0x10, 0xf3, 0xb5, 0x29, 0x77, 0x7b, 0x44, 0xed, 0xc5, 0x4c, 0x66, 0x35, 0xd2, 0x34, 0x06, 0x2a,
-	0x5b, 0x7f, 0xe6, 0x50, 0x79, 0x27, 0x35, 0x2b, 0x1e, 0xa0, 0x8a, 0xd9, 0x09, 0x5e, 0x3f, 0x79,
-	0x67, 0xf0, 0x00, 0xfb, 0x5a, 0x11, 0xcc, 0x3c, 0xc9, 0xad, 0xef, 0x7f, 0xfd, 0xf5, 0xf1, 0xd4,
-	0x32, 0xae, 0x1d, 0xe7, 0x3f, 0xdc, 0x47, 0x65, 0xbd, 0x2c, 0xbc, 0x76, 0xe2, 0x2e, 0x33, 0xd1,
-	0xf5, 0x02, 0x14, 0x68, 0x5e, 0xd2, 0x9a, 0x17, 0xf1, 0x85, 0x7f, 0x35, 0xb5, 0x13, 0xf0, 0x7b,
-	0x0b, 0x9d, 0xc9, 0x26, 0x8f, 0x9b, 0x79, 0x84, 0x47, 0xcc, 0x62, 0x6f, 0x14, 0x03, 0x41, 0xbc,
-	0xa9, 0xc5, 0xaf, 0xe0, 0xc6, 0x91, 0xdf, 0x12, 0x95, 0xcf, 0xf5, 0xd4, 0xc9, 0x1b, 0x6d, 0xb4,
-	0xb7, 0x78, 0xdf, 0x42, 0xd5, 0x89, 0x05, 0x70, 0xa1, 0xc0, 0x64, 0xf2, 0xd7, 0xff, 0x03, 0x09,
-	0xbd, 0xac, 0xea, 0x5e, 0x6c, 0xbc, 0x92, 0xd3, 0x8b, 0x6c, 0x3f, 0x3a, 0x18, 0x39, 0xd6, 0xe1,
-	0xc8, 0xb1, 0x7e, 0x8e, 0x1c, 0xeb, 0xc3, 0xd8, 0x29, 0x1d, 0x8e, 0x9d, 0xd2, 0xb7, 0xb1, 0x53,
-	0x7a, 0x46, 0x66, 0x5c, 0x25, 0x7b, 0x61, 0xbc, 0x19, 0xb1, 0xe1, 0x0c, 0xcd, 0xeb, 0x99, 0x58,
-	0x5b, 0xac, 0x5b, 0xd1, 0x7f, 0x13, 0xb7, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x87, 0x38,
-	0x2d, 0x16, 0x05, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0xb4, 0x4d, 0x84, 0x4f, 0x1e, 0x4e, 0x6e, 0xd3, 0xc4, 0x71, 0x52, 0xdb, 0x19, 0x9a,
+	0xc6, 0x2d, 0xca, 0x0c, 0x2e, 0x42, 0x02, 0x09, 0x24, 0xe4, 0x44, 0x41, 0x95, 0x28, 0x4a, 0x5d,
+	0x40, 0xa8, 0x1b, 0x6b, 0x3c, 0x3e, 0x9e, 0x0c, 0xf1, 0x3c, 0x32, 0x77, 0x6c, 0x11, 0x10, 0x9b,
+	0x22, 0x60, 0x8b, 0x84, 0xc4, 0x82, 0x05, 0x1b, 0x36, 0xc0, 0x8a, 0x05, 0x3f, 0xa2, 0xcb, 0x0a,
+	0x36, 0x88, 0x45, 0x41, 0x09, 0x12, 0x7f, 0x03, 0xdd, 0x3b, 0x67, 0xfc, 0x18, 0x67, 0x6c, 0xb3,
+	0x69, 0xaf, 0xcf, 0xe3, 0x3b, 0xdf, 0x9c, 0x73, 0xee, 0x77, 0x03, 0x6a, 0x0b, 0xd1, 0x31, 0x82,
+	0x13, 0x0c, 0xf5, 0xfe, 0xa9, 0x5b, 0xd1, 0x4f, 0x3b, 0x18, 0x9c, 0x69, 0x7e, 0xe0, 0x85, 0x1e,
+	0x5b, 0xeb, 0x79, 0xb4, 0xfe, 0xa9, 0x5b, 0xc9, 0xaf, 0x5a, 0x9e, 0xe5, 0xc9, 0x10, 0x5d, 0x9c,
+	0xa2, 0xe8, 0xfc, 0x96, 0xe5, 0x79, 0x56, 0x1b, 0x75, 0xc3, 0xb7, 0x75, 0xc3, 0x75, 0xbd, 0xd0,
+	0x08, 0x6d, 0xcf, 0xe5, 0xe4, 0x2d, 0x98, 0x1e, 0x77, 0x3c, 0xae, 0x37, 0x0c, 0x8e, 0x7a, 0xb7,
+	0xd2, 0xc0, 0xd0, 0xa8, 0xe8, 0xa6, 0x67, 0xbb, 0xe4, 0x5f, 0x31, 0x1c, 0xdb, 0xf5, 0x74, 0xf9,
+	0x2f, 0x99, 0x36, 0xa2, 0x94, 0x7a, 0x54, 0x29, 0xfa, 0x41, 0xae, 0x17, 0x53, 0xd8, 0xfb, 0x46,
+	0x60, 0x38, 0x71, 0xd0, 0xad, 0x94, 0x20, 0x0b, 0x5d, 0xe4, 0x36, 0x45, 0xa9, 0x59, 0x58, 0x3c,
+	0x92, 0x59, 0x35, 0x3c, 0xed, 0x20, 0x0f, 0xd5, 0x77, 0x61, 0x29, 0x36, 0x70, 0xdf, 0x73, 0x39,
+	0xb2, 0x37, 0x60, 0x2e, 0x02, 0xce, 0x29, 0x25, 0xa5, 0x3c, 0x7f, 0xaf, 0xa0, 0x5d, 0xde, 0x18,
+	0x2d, 0xca, 0xab, 0x5e, 0x7b, 0xfa, 0xbc, 0x38, 0x53, 0xa3, 0x1c, 0x75, 0x09, 0x16, 0x1e, 0x85,
+	0x46, 0x88, 0x31, 0xfe, 0x4f, 0x57, 0x60, 0x91, 0x0c, 0x84, 0xff, 0x3a, 0xcc, 0x72, 0x61, 0x20,
+	0xf8, 0x9b, 0x69, 0xf0, 0x32, 0x8b, 0xd0, 0xa3, 0x0c, 0xb6, 0x0f, 0xf3, 0x51, 0x99, 0x7a, 0x60,
+	0x38, 0x7e, 0xee, 0x8a, 0x04, 0x50, 0xc7, 0xf3, 0xab, 0x19, 0x8e, 0x5f, 0x03, 0xbf, 0x77, 0x66,
+	0x1f, 0xc0, 0xa2, 0xc8, 0x16, 0x8d, 0xb6, 0x02, 0xe4, 0x3c, 0x77, 0xb5, 0xa4, 0x94, 0x33, 0xd5,
+	0x8a, 0x28, 0xf4, 0xe7, 0xf3, 0xe2, 0x66, 0xd4, 0x7a, 0xde, 0x3c, 0xd1, 0x6c, 0x4f, 0x77, 0x8c,
+	0xf0, 0x58, 0x7b, 0x07, 0x2d, 0xc3, 0x3c, 0x3b, 0x40, 0xf3, 0xb7, 0x5f, 0xf7, 0x80, 0x26, 0x73,
+	0x80, 0x66, 0x6d, 0x41, 0xe0, 0x1c, 0x11, 0x0c, 0x7b, 0x0b, 0xa0, 0x85, 0x58, 0x6f, 0x05, 0x88,
+	0x9f, 0x60, 0xee, 0x9a, 0xe4, 0xb6, 0x9d, 0xc6, 0xed, 0x10, 0xf1, 0x50, 0x06, 0xd6, 0x32, 0xad,
+	0xf8, 0xa8, 0xde, 0x87, 0xec, 0xdb, 0x06, 0x3f, 0x0a, 0x6c, 0x33, 0x6e, 0x1f, 0x5b, 0x85, 0xd9,
+	0x26, 0xba, 0x9e, 0x23, 0x9b, 0x95, 0xa9, 0x45, 0x3f, 0x58, 0x09, 0x16, 0x1c, 0x6e, 0xd5, 0xc3,
+	0x33, 0x1f, 0xeb, 0x9d, 0xa0, 0x2d, 0x1b, 0x91, 0xa9, 0x81, 0xc3, 0xad, 0xf7, 0xce, 0x7c, 0x7c,
+	0x3f, 0x68, 0xab, 0x0f, 0x61, 0xb9, 0x0f, 0x45, 0x8d, 0x7f, 0x13, 0x66, 0x7d, 0x61, 0xa0, 0xc6,
+	0x6f, 0x69, 0xf4, 0x29, 0x62, 0x49, 0x35, 0x5a, 0x52, 0xf1, 0x5d, 0xfb, 0x9e, 0xed, 0x56, 0x33,
+	0xa2, 0x1d, 0x3f, 0xfe, 0xfb, 0xcb, 0x5d, 0xa5, 0x16, 0x65, 0xa9, 0xac, 0x0f, 0xd9, 0xdb, 0x9e,
+	0xcf, 0x15, 0x58, 0x19, 0x30, 0x52, 0x21, 0x17, 0xe6, 0x64, 0x8a, 0xd8, 0xa0, 0xab, 0x13, 0x2b,
+	0xbd, 0x26, 0x2a, 0xfd, 0xfc, 0x57, 0xf1, 0x25, 0xcb, 0x0e, 0x8f, 0x3b, 0x0d, 0xcd, 0xf4, 0x1c,
+	0x5a, 0x7f, 0xfa, 0x6f, 0x8f, 0x37, 0x4f, 0x74, 0xf1, 0xad, 0x3c, 0xce, 0xe1, 0x11, 0x31, 0xaa,
+	0xa2, 0xae, 0xc3, 0x8d, 0x43, 0xc4, 0x07, 0x9d, 0x76, 0x68, 0xfb, 0x6d, 0x1b, 0x83, 0x1e, 0xbd,
+	0x8f, 0x60, 0x2d, 0xe9, 0x20, 0x8a, 0x47, 0x30, 0xef, 0xf4, 0xcd, 0xc4, 0xb3, 0x9c, 0x36, 0xad,
+	0x07, 0xdc, 0x1a, 0xc2, 0xa1, 0xad, 0x1c, 0x84, 0x50, 0x73, 0xb0, 0xf6, 0xc8, 0x3c, 0xc6, 0x66,
+	0xa7, 0x8d, 0xcd, 0xe1, 0x2b, 0xc6, 0x61, 0x7d, 0xc4, 0x43, 0x34, 0x3e, 0x84, 0x65, 0x1e, 0xbb,
+	0xea, 0xbd, 0x5b, 0x27, 0xb8, 0xec, 0xa6, 0x5e, 0x8b, 0x61, 0x28, 0xa2, 0x92, 0xe5, 0xc3, 0x66,
+	0xf5, 0x3a, 0xac, 0x54, 0x3b, 0x81, 0x8b, 0xcd, 0x43, 0xec, 0x8f, 0xeb, 0x2b, 0x05, 0xd8, 0xa0,
+	0x95, 0x58, 0x9c, 0xc2, 0x7c, 0x43, 0x5a, 0xeb, 0x2d, 0xec, 0x0d, 0x6d, 0xe3, 0xd2, 0xa1, 0xc9,
+	0x89, 0xbd, 0x4a, 0x13, 0x2b, 0x4f, 0x31, 0xb1, 0x81, 0x71, 0x41, 0xa3, 0x57, 0x5a, 0xdd, 0x80,
+	0xf5, 0x03, 0xb1, 0xca, 0xfb, 0x9e, 0xdb, 0xc5, 0x80, 0x0b, 0xe9, 0x8c, 0x49, 0x76, 0x21, 0x37,
+	0xea, 0x22, 0xa6, 0x8f, 0x61, 0x45, 0xde, 0x80, 0xba, 0xd9, 0x77, 0x4e, 0x6a, 0x58, 0x02, 0x8c,
+	0x1a, 0xb6, 0xdc, 0x4c, 0xd4, 0x50, 0x35, 0x58, 0x4b, 0x84, 0x8e, 0xbd, 0x84, 0x62, 0xac, 0x23,
+	0xf1, 0xfd, 0xb1, 0x26, 0x69, 0xd2, 0xa5, 0xfb, 0x9f, 0x2c, 0xb3, 0x09, 0x96, 0xf7, 0xbe, 0x00,
+	0x98, 0x7d, 0x28, 0x1e, 0x2d, 0xd6, 0x81, 0xb9, 0x68, 0xd4, 0x6c, 0x67, 0x82, 0x00, 0x46, 0x5f,
+	0x91, 0xbf, 0x3d, 0x29, 0x2c, 0x22, 0xaf, 0x6e, 0x3d, 0xf9, 0xfd, 0x9f, 0x6f, 0xae, 0xac, 0xb1,
+	0xd5, 0xcb, 0x1e, 0x1b, 0x76, 0x0a, 0xb3, 0x52, 0x98, 0xd9, 0xad, 0xb1, 0xba, 0x1d, 0x17, 0xdd,
+	0x99, 0x10, 0x45, 0x35, 0x37, 0x65, 0xcd, 0x1b, 0xec, 0xfa, 0x70, 0xcd, 0x48, 0xf5, 0xbf, 0x54,
+	0xe0, 0x85, 0x58, 0x64, 0x58, 0x6a, 0x03, 0x13, 0xca, 0x99, 0x2f, 0x4f, 0x0e, 0xa4, 0xe2, 0xbb,
+	0xb2, 0xf8, 0x36, 0x2b, 0x26, 0x1e, 0x4e, 0x43, 0x3c, 0xc6, 0xb6, 0x89, 0xfa, 0xa7, 0x72, 0x0a,
+	0x9f, 0xb1, 0x27, 0x0a, 0x64, 0xe2, 0x6c, 0xce, 0x26, 0x16, 0xe8, 0x75, 0xfe, 0xce, 0x14, 0x91,
+	0xc4, 0xa5, 0x24, 0xb9, 0xe4, 0x59, 0x2e, 0x85, 0x0b, 0x67, 0xdf, 0x2a, 0xb0, 0x34, 0x2c, 0x6a,
+	0x6c, 0x6f, 0xcc, 0x2b, 0x33, 0xaa, 0x8a, 0x79, 0x6d, 0xda, 0x70, 0xe2, 0xb4, 0x23, 0x39, 0x15,
+	0xd9, 0xcd, 0x61, 0x4e, 0xe2, 0xb1, 0x1b, 0x10, 0x40, 0xf6, 0x9d, 0x02, 0xd9, 0x84, 0x38, 0x31,
+	0x6d, 0x4a, 0x15, 0x8b, 0xa9, 0xe9, 0x53, 0xc7, 0x13, 0xb7, 0xdb, 0x92, 0x5b, 0x89, 0x15, 0x12,
+	0x8b, 0x93, 0x10, 0x55, 0xb1, 0x43, 0xd0, 0x57, 0x3e, 0x96, 0x3a, 0x91, 0x11, 0xcd, 0xcc, 0xdf,
+	0x9d, 0x26, 0x94, 0xd8, 0x6c, 0x4b, 0x36, 0x9b, 0x6c, 0x63, 0x98, 0xcd, 0x80, 0xb8, 0xb2, 0xef,
+	0x15, 0x58, 0x4e, 0xca, 0x1b, 0xd3, 0xa7, 0x54, 0x85, 0x1e, 0xa9, 0x97, 0xa7, 0x4f, 0x18, 0xbf,
+	0xe4, 0x23, 0x6a, 0xca, 0x7e, 0x50, 0x20, 0x9b, 0x40, 0x49, 0x1f, 0xe3, 0xe5, 0x82, 0x99, 0xd7,
+	0xa7, 0x8e, 0x27, 0x76, 0xba, 0x64, 0x77, 0x87, 0xed, 0x4e, 0x60, 0x17, 0x5f, 0xc5, 0xea, 0xfd,
+	0xa7, 0xe7, 0x05, 0xe5, 0xd9, 0x79, 0x41, 0xf9, 0xfb, 0xbc, 0xa0, 0x7c, 0x7d, 0x51, 0x98, 0x79,
+	0x76, 0x51, 0x98, 0xf9, 0xe3, 0xa2, 0x30, 0xf3, 0x58, 0x1f, 0x78, 0x94, 0xf8, 0x89, 0xed, 0xef,
+	0x39, 0xd8, 0x1d, 0x40, 0xfd, 0x78, 0xe0, 0x2c, 0x5f, 0xa8, 0xc6, 0x9c, 0xfc, 0xcb, 0xf8, 0x95,
+	0xff, 0x06, 0x00, 0xc3, 0xee, 0x1b, 0x5f, 0x24, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -448,6 +948,17 @@ type QueryClient interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// FeeMultipliers returns the active per message type fee multipliers.
+	FeeMultipliers(ctx context.Context, in *FeeMultipliersRequest, opts ...grpc.CallOption) (*FeeMultipliersResponse, error)
+	// ScheduledParams returns the pending parameter changes, ordered by height.
+	ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error)
+	// BurnedFees returns the total amount of fees burned by the module.
+	BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error)
+	// DenomConversions returns the denoms accepted for fees in addition to the
+	// fee denom along with their conversion rates.
+	DenomConversions(ctx context.Context, in *DenomConversionsRequest, opts ...grpc.CallOption) (*DenomConversionsResponse, error)
+	// DenomConversion returns the conversion rate of a denom accepted for fees.
+	DenomConversion(ctx context.Context, in *DenomConversionRequest, opts ...grpc.CallOption) (*DenomConversionResponse, error)
 }
 
 type queryClient struct {
@@ -494,6 +1005,51 @@ func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) FeeMultipliers(ctx context.Context, in *FeeMultipliersRequest, opts ...grpc.CallOption) (*FeeMultipliersResponse, error) {
+	out := new(FeeMultipliersResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/FeeMultipliers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error) {
+	out := new(ScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/ScheduledParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error) {
+	out := new(BurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomConversions(ctx context.Context, in *DenomConversionsRequest, opts ...grpc.CallOption) (*DenomConversionsResponse, error) {
+	out := new(DenomConversionsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/DenomConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomConversion(ctx context.Context, in *DenomConversionRequest, opts ...grpc.CallOption) (*DenomConversionResponse, error) {
+	out := new(DenomConversionResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/DenomConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the current feemarket module parameters.
@@ -506,6 +1062,17 @@ type QueryServer interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// FeeMultipliers returns the active per message type fee multipliers.
+	FeeMultipliers(context.Context, *FeeMultipliersRequest) (*FeeMultipliersResponse, error)
+	// ScheduledParams returns the pending parameter changes, ordered by height.
+	ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error)
+	// BurnedFees returns the total amount of fees burned by the module.
+	BurnedFees(context.Context, *BurnedFeesRequest) (*BurnedFeesResponse, error)
+	// DenomConversions returns the denoms accepted for fees in addition to the
+	// fee denom along with their conversion rates.
+	DenomConversions(context.Context, *DenomConversionsRequest) (*DenomConversionsResponse, error)
+	// DenomConversion returns the conversion rate of a denom accepted for fees.
+	DenomConversion(context.Context, *DenomConversionRequest) (*DenomConversionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -524,6 +1091,21 @@ func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequ
 func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (*UnimplementedQueryServer) FeeMultipliers(ctx context.Context, req *FeeMultipliersRequest) (*FeeMultipliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMultipliers not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledParams(ctx context.Context, req *ScheduledParamsRequest) (*ScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParams not implemented")
+}
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *BurnedFeesRequest) (*BurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) DenomConversions(ctx context.Context, req *DenomConversionsRequest) (*DenomConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomConversions not implemented")
+}
+func (*UnimplementedQueryServer) DenomConversion(ctx context.Context, req *DenomConversionRequest) (*DenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomConversion not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -601,6 +1183,97 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeMultipliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeMultipliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeMultipliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/FeeMultipliers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeMultipliers(ctx, req.(*FeeMultipliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/ScheduledParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParams(ctx, req.(*ScheduledParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*BurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/DenomConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomConversions(ctx, req.(*DenomConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/DenomConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomConversion(ctx, req.(*DenomConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "feemarket.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -621,6 +1294,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "FeeMultipliers",
+			Handler:    _Query_FeeMultipliers_Handler,
+		},
+		{
+			MethodName: "ScheduledParams",
+			Handler:    _Query_ScheduledParams_Handler,
+		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "DenomConversions",
+			Handler:    _Query_DenomConversions_Handler,
+		},
+		{
+			MethodName: "DenomConversion",
+			Handler:    _Query_DenomConversion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/query.proto",
@@ -725,6 +1418,40 @@ func (m *StateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeFreeze != nil {
+		{
+			size, err := m.FeeFreeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.RampProgress.Size()
+		i -= size
+		if _, err := m.RampProgress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ParamsRamp != nil {
+		{
+			size, err := m.ParamsRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -758,6 +1485,13 @@ func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -861,112 +1595,1252 @@ func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeMultipliersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *FeeMultipliersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeMultipliersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeMultipliersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *FeeMultipliersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeMultipliersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Multipliers) > 0 {
+		for iNdEx := len(m.Multipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Multipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScheduledParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
+	if len(m.ScheduledParams) > 0 {
+		for iNdEx := len(m.ScheduledParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomConversions) > 0 {
+		for iNdEx := len(m.DenomConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DenomConversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ParamsRamp != nil {
+		l = m.ParamsRamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RampProgress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeFreeze != nil {
+		l = m.FeeFreeze.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *FeeMultipliersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FeeMultipliersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Multipliers) > 0 {
+		for _, e := range m.Multipliers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ScheduledParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledParams) > 0 {
+		for _, e := range m.ScheduledParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomConversions) > 0 {
+		for _, e := range m.DenomConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DenomConversion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParamsRamp == nil {
+				m.ParamsRamp = &ParamsRamp{}
+			}
+			if err := m.ParamsRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampProgress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RampProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeFreeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeFreeze == nil {
+				m.FeeFreeze = &FeeFreeze{}
+			}
+			if err := m.FeeFreeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeMultipliersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,10 +2863,10 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeMultipliersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeMultipliersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1016,7 +2890,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *FeeMultipliersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,15 +2913,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeMultipliersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeMultipliersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multipliers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1074,7 +2948,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Multipliers = append(m.Multipliers, MsgFeeMultiplier{})
+			if err := m.Multipliers[len(m.Multipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1099,7 +2974,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduledParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,10 +2997,10 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1149,7 +3024,7 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduledParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,15 +3047,15 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1207,7 +3082,8 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledParams = append(m.ScheduledParams, ScheduledParams{})
+			if err := m.ScheduledParams[len(m.ScheduledParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1232,7 +3108,7 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *BurnedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,17 +3131,67 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1275,23 +3201,25 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1314,7 +3242,7 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomConversionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,15 +3265,65 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomConversionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomConversionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomConversionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConversions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1372,7 +3350,8 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomConversions = append(m.DenomConversions, DenomConversion{})
+			if err := m.DenomConversions[len(m.DenomConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1397,7 +3376,7 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomConversionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,12 +3399,44 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomConversionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1447,7 +3458,7 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1470,15 +3481,15 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConversion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,8 +3516,7 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types.DecCoin{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DenomConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
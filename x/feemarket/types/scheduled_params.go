@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewScheduledParams returns a new parameter change scheduled at the given height.
+func NewScheduledParams(height int64, params Params, resetState bool) ScheduledParams {
+	return ScheduledParams{
+		Height:     height,
+		Params:     params,
+		ResetState: resetState,
+	}
+}
+
+// ValidateBasic performs basic validation on the scheduled parameter change.
+func (sp *ScheduledParams) ValidateBasic() error {
+	if sp.Height <= 0 {
+		return fmt.Errorf("scheduled height must be positive; got %d", sp.Height)
+	}
+
+	if err := sp.Params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid scheduled params at height %d: %w", sp.Height, err)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	fmt "fmt"
+	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 )
@@ -16,10 +18,12 @@ func NewState(
 	learningRate math.LegacyDec,
 ) State {
 	return State{
-		Window:       make([]uint64, windowSize),
-		BaseGasPrice: baseGasPrice,
-		Index:        0,
-		LearningRate: learningRate,
+		Window:        make([]uint64, windowSize),
+		BaseGasPrice:  baseGasPrice,
+		Index:         0,
+		LearningRate:  learningRate,
+		BaseBytePrice: math.LegacyZeroDec(),
+		ByteWindow:    make([]uint64, windowSize),
 	}
 }
 
@@ -35,28 +39,132 @@ func (s *State) Update(gas uint64, params Params) error {
 	return nil
 }
 
-// IncrementHeight increments the current height of the state.
+// UpdateBytes updates the tx bytes for the current height with the given
+// transaction size. This is a no-op if the byte dimension is disabled.
+func (s *State) UpdateBytes(bytes uint64, params Params) error {
+	if !params.ByteDimensionEnabled() {
+		return nil
+	}
+
+	s.ensureByteWindow()
+
+	update := s.ByteWindow[s.Index] + bytes
+	if update > params.MaxBlockBytes {
+		return fmt.Errorf("block bytes of %d cannot exceed max block bytes of %d", update, params.MaxBlockBytes)
+	}
+
+	s.ByteWindow[s.Index] = update
+	return nil
+}
+
+// IncrementHeight increments the current height of the state and resets the
+// per-block counters.
 func (s *State) IncrementHeight() {
 	s.Index = (s.Index + 1) % uint64(len(s.Window))
 	s.Window[s.Index] = 0
+
+	if len(s.ByteWindow) == len(s.Window) {
+		s.ByteWindow[s.Index] = 0
+	}
+
+	s.ExemptGas = 0
+}
+
+// ensureByteWindow allocates the byte window if it does not match the size of
+// the block utilization window, e.g. for states created before the byte dimension
+// was added.
+func (s *State) ensureByteWindow() {
+	if len(s.ByteWindow) != len(s.Window) {
+		s.ByteWindow = make([]uint64, len(s.Window))
+	}
+}
+
+// ApplyParams carries the state forward to the given params. If the window size
+// changed, the block utilization and byte windows are resampled to the new size,
+// with the current block kept at the end of the new window. The base gas price
+// and learning rate are clamped into the bounds of the new params. The exponential
+// pricing model derives the base gas price from the excess gas, so the excess gas
+// is clamped to the range whose base gas price is within the bounds instead.
+func (s *State) ApplyParams(params Params) {
+	if params.Window > 0 && uint64(len(s.Window)) != params.Window {
+		byteWindow := len(s.ByteWindow) == len(s.Window)
+
+		s.Window = resampleWindow(s.Window, s.Index, params.Window)
+		if byteWindow {
+			s.ByteWindow = resampleWindow(s.ByteWindow, s.Index, params.Window)
+		} else {
+			s.ByteWindow = make([]uint64, params.Window)
+		}
+
+		s.Index = params.Window - 1
+	}
+
+	switch {
+	case params.PricingModel == PricingModelExponential && params.ExcessGasUpdateFraction > 0 &&
+		!params.MinBaseGasPrice.IsNil() && params.MinBaseGasPrice.IsPositive():
+		s.ExcessGas = boundExcessGas(math.NewIntFromUint64(s.ExcessGas), params)
+		if isSet(params.MaxBaseGasPrice) {
+			s.ExcessGas = min(s.ExcessGas, getMaxExcessGas(params.MaxBaseGasPrice, params))
+		}
+
+		s.BaseGasPrice = s.GetExponentialBaseGasPrice(params)
+	default:
+		if !params.MinBaseGasPrice.IsNil() && s.BaseGasPrice.LT(params.MinBaseGasPrice) {
+			s.BaseGasPrice = params.MinBaseGasPrice
+		}
+
+		if isSet(params.MaxBaseGasPrice) && s.BaseGasPrice.GT(params.MaxBaseGasPrice) {
+			s.BaseGasPrice = params.MaxBaseGasPrice
+		}
+	}
+
+	if !params.MinLearningRate.IsNil() && s.LearningRate.LT(params.MinLearningRate) {
+		s.LearningRate = params.MinLearningRate
+	}
+
+	if !params.MaxLearningRate.IsNil() && s.LearningRate.GT(params.MaxLearningRate) {
+		s.LearningRate = params.MaxLearningRate
+	}
+}
+
+// resampleWindow resamples the given ring buffer, whose current entry is at the
+// given index, to a window of the given size in chronological order. Each entry of
+// the new window takes the value of the nearest entry of the old window, such that
+// the oldest and the current entries are preserved.
+func resampleWindow(window []uint64, index, size uint64) []uint64 {
+	n := uint64(len(window))
+	resampled := make([]uint64, size)
+	if n == 0 {
+		return resampled
+	}
+
+	// chronological returns the i-th oldest entry of the old window.
+	chronological := func(i uint64) uint64 {
+		return window[(index+1+i)%n]
+	}
+
+	if size == 1 {
+		resampled[0] = chronological(n - 1)
+		return resampled
+	}
+
+	for j := uint64(0); j < size; j++ {
+		resampled[j] = chronological(j * (n - 1) / (size - 1))
+	}
+
+	return resampled
 }
 
 // UpdateBaseGasPrice updates the learning rate and base gas price based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. The base gas price is
-// update using the new learning rate and the delta adjustment. Please
-// see the EIP-1559 specification for more details.
+// update using the new learning rate and the delta adjustment. The block
+// utilization that drives the adjustment is determined by the utilization
+// mode in the params. The arithmetic saturates at MaxDecValue instead of
+// overflowing. Please see the EIP-1559 specification for more details.
 func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
-	// Panic catch in case there is an overflow
-	defer func() {
-		if rec := recover(); rec != nil {
-			s.BaseGasPrice = params.MinBaseGasPrice
-			gasPrice = s.BaseGasPrice
-		}
-	}()
-
 	// Calculate the new base gasPrice with the learning rate adjustment.
-	currentBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[s.Index]))
+	currentBlockSize := s.GetBaseGasPriceUtilization(params)
 	targetBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
 	utilization := (currentBlockSize.Sub(targetBlockSize)).Quo(targetBlockSize)
 
@@ -64,13 +172,13 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 	//
 	// This is equivalent to
 	// 1 + (learningRate * (currentBlockSize - targetBlockSize) / targetBlockSize)
-	learningRateAdjustment := math.LegacyOneDec().Add(s.LearningRate.Mul(utilization))
+	learningRateAdjustment := SaturatingAdd(math.LegacyOneDec(), SaturatingMul(s.LearningRate, utilization))
 
 	// Calculate the delta adjustment.
-	net := math.LegacyNewDecFromInt(s.GetNetUtilization(params)).Mul(params.Delta)
+	net := SaturatingMul(math.LegacyNewDecFromInt(s.GetNetUtilization(params)), params.Delta)
 
 	// Update the base gasPrice.
-	gasPrice = s.BaseGasPrice.Mul(learningRateAdjustment).Add(net)
+	gasPrice = SaturatingAdd(SaturatingMul(s.BaseGasPrice, learningRateAdjustment), net)
 
 	// Ensure the base gasPrice is greater than the minimum base gasPrice.
 	if gasPrice.LT(params.MinBaseGasPrice) {
@@ -81,6 +189,235 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 	return s.BaseGasPrice
 }
 
+// UpdatePIDBaseGasPrice updates the base gas price using a PID controller on the
+// utilization error, i.e. the relative difference between the block utilization
+// and the target block utilization. The proportional term uses the error of the
+// current block, the integral term the sum of the errors across the window and the
+// derivative term the change in error since the previous block in the window. The
+// integral term is bounded by PidIntegralLimit to prevent windup. The base gas
+// price is multiplied by one plus the sum of the weighted terms, saturating at
+// MaxDecValue.
+func (s *State) UpdatePIDBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
+	size := uint64(len(s.Window))
+	current := s.GetUtilizationError(s.Window[s.Index], params)
+	previous := s.GetUtilizationError(s.Window[(s.Index+size-1)%size], params)
+
+	integral := math.LegacyZeroDec()
+	for _, utilization := range s.Window {
+		integral = integral.Add(s.GetUtilizationError(utilization, params))
+	}
+
+	// Anti-windup: bound the integral term.
+	if integral.GT(params.PidIntegralLimit) {
+		integral = params.PidIntegralLimit
+	} else if integral.LT(params.PidIntegralLimit.Neg()) {
+		integral = params.PidIntegralLimit.Neg()
+	}
+
+	adjustment := SaturatingAdd(
+		SaturatingAdd(SaturatingMul(params.PidProportionalGain, current), SaturatingMul(params.PidIntegralGain, integral)),
+		SaturatingMul(params.PidDerivativeGain, current.Sub(previous)),
+	)
+
+	gasPrice = SaturatingMul(s.BaseGasPrice, SaturatingAdd(math.LegacyOneDec(), adjustment))
+
+	// Ensure the base gasPrice is greater than the minimum base gasPrice.
+	if gasPrice.LT(params.MinBaseGasPrice) {
+		gasPrice = params.MinBaseGasPrice
+	}
+
+	s.BaseGasPrice = gasPrice
+	return s.BaseGasPrice
+}
+
+// GetUtilizationError returns the relative difference between the given block
+// utilization and the target block utilization.
+func (s *State) GetUtilizationError(utilization uint64, params Params) math.LegacyDec {
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(utilization)).Sub(target).Quo(target)
+}
+
+// UpdateBaseBytePrice updates the base byte price using the EIP-1559 update rule
+// on the tx bytes of the current block and the byte learning rate, saturating at
+// MaxDecValue. This is a no-op if the byte dimension is disabled.
+func (s *State) UpdateBaseBytePrice(params Params) (bytePrice math.LegacyDec) {
+	if !params.ByteDimensionEnabled() {
+		return s.BaseBytePrice
+	}
+
+	s.ensureByteWindow()
+
+	current := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.ByteWindow[s.Index]))
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockBytes))
+	utilization := current.Sub(target).Quo(target)
+
+	adjustment := SaturatingAdd(math.LegacyOneDec(), SaturatingMul(params.ByteLearningRate, utilization))
+	bytePrice = SaturatingMul(s.GetBaseBytePrice(params), adjustment)
+
+	// Ensure the base bytePrice is greater than the minimum base bytePrice.
+	if bytePrice.LT(params.MinBaseBytePrice) {
+		bytePrice = params.MinBaseBytePrice
+	}
+
+	s.BaseBytePrice = bytePrice
+	return s.BaseBytePrice
+}
+
+// GetSaturatedValues returns the event attribute keys of the base prices and the
+// learning rate that are at the saturation limit.
+func (s *State) GetSaturatedValues(params Params) []string {
+	var saturated []string
+	if IsSaturated(s.BaseGasPrice) {
+		saturated = append(saturated, AttributeKeyBaseGasPrice)
+	}
+
+	if IsSaturated(s.LearningRate) {
+		saturated = append(saturated, AttributeKeyLearningRate)
+	}
+
+	if IsSaturated(s.GetBaseBytePrice(params)) {
+		saturated = append(saturated, AttributeKeyBaseBytePrice)
+	}
+
+	return saturated
+}
+
+// GetBaseBytePrice returns the base byte price, which is at least the min base
+// byte price. This is zero if the byte dimension is disabled.
+func (s *State) GetBaseBytePrice(params Params) math.LegacyDec {
+	if !params.ByteDimensionEnabled() {
+		return math.LegacyZeroDec()
+	}
+
+	if s.BaseBytePrice.IsNil() || s.BaseBytePrice.LT(params.MinBaseBytePrice) {
+		return params.MinBaseBytePrice
+	}
+
+	return s.BaseBytePrice
+}
+
+// GetElapsedTime returns the time elapsed between the last block time stored in
+// the state and the given block time. False is returned if the last block time
+// is unknown or the block time did not advance.
+func (s *State) GetElapsedTime(blockTime time.Time) (time.Duration, bool) {
+	if s.LastBlockTime.IsZero() || !blockTime.After(s.LastBlockTime) {
+		return 0, false
+	}
+
+	return blockTime.Sub(s.LastBlockTime), true
+}
+
+// ScaleBaseGasPriceAdjustment scales the change from the previous base gas price
+// to the current base gas price by the elapsed time relative to the target block
+// time, i.e. a block that took twice as long as the target doubles the adjustment.
+// The scale is bounded by MaxBlockTimeScale. The exponential pricing model derives
+// the base gas price from the excess gas, so the change in excess gas is scaled
+// instead. This is a no-op if the target block time is not set.
+func (s *State) ScaleBaseGasPriceAdjustment(previous State, elapsed time.Duration, params Params) math.LegacyDec {
+	if params.TargetBlockTime <= 0 {
+		return s.BaseGasPrice
+	}
+
+	scale := math.LegacyNewDec(elapsed.Nanoseconds()).QuoInt64(params.TargetBlockTime.Nanoseconds())
+	if scale.GT(MaxBlockTimeScale) {
+		scale = MaxBlockTimeScale
+	}
+
+	if params.PricingModel == PricingModelExponential {
+		previousExcessGas := math.LegacyNewDecFromInt(math.NewIntFromUint64(previous.ExcessGas))
+		delta := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.ExcessGas)).Sub(previousExcessGas)
+
+		s.ExcessGas = boundExcessGas(previousExcessGas.Add(delta.Mul(scale)).TruncateInt(), params)
+		s.BaseGasPrice = s.GetExponentialBaseGasPrice(params)
+		return s.BaseGasPrice
+	}
+
+	gasPrice := SaturatingAdd(previous.BaseGasPrice, SaturatingMul(s.BaseGasPrice.Sub(previous.BaseGasPrice), scale))
+
+	// Ensure the base gasPrice is greater than the minimum base gasPrice.
+	if gasPrice.LT(params.MinBaseGasPrice) {
+		gasPrice = params.MinBaseGasPrice
+	}
+
+	s.BaseGasPrice = gasPrice
+	return s.BaseGasPrice
+}
+
+// ClampBaseGasPrice bounds the base gas price by the maximum per-block increase and
+// decrease relative to the previous state and by the max and min base gas price,
+// see Params.ClampBaseGasPrice. The exponential pricing model derives the base gas
+// price from the excess gas, so the excess gas is bounded to the range that maps
+// into the bounds instead. The returned string identifies the bound that was
+// applied, if any.
+func (s *State) ClampBaseGasPrice(previous State, params Params) string {
+	gasPrice, bound := params.ClampBaseGasPrice(previous.BaseGasPrice, s.BaseGasPrice)
+	if params.PricingModel != PricingModelExponential || gasPrice.Equal(s.BaseGasPrice) {
+		s.BaseGasPrice = gasPrice
+		return bound
+	}
+
+	if gasPrice.LT(s.BaseGasPrice) {
+		s.ExcessGas = getMaxExcessGas(gasPrice, params)
+	} else {
+		s.ExcessGas = getMinExcessGas(gasPrice, params)
+
+		// The smallest excess gas that reaches the lower bound may exceed the max
+		// base gas price, which takes precedence.
+		if isSet(params.MaxBaseGasPrice) {
+			s.ExcessGas = min(s.ExcessGas, getMaxExcessGas(params.MaxBaseGasPrice, params))
+		}
+	}
+
+	s.BaseGasPrice = s.GetExponentialBaseGasPrice(params)
+	return bound
+}
+
+// DecayBaseGasPrice decays the base gas price toward the min base gas price after
+// downtime. The difference between the base gas price and the min base gas price
+// is halved for every downtime threshold that elapsed. The exponential pricing
+// model derives the base gas price from the excess gas, so the excess gas is
+// halved instead. This is a no-op if the downtime threshold is not set.
+func (s *State) DecayBaseGasPrice(elapsed time.Duration, params Params) math.LegacyDec {
+	if params.DowntimeThreshold <= 0 {
+		return s.BaseGasPrice
+	}
+
+	halvings := int64(elapsed / params.DowntimeThreshold)
+
+	if params.PricingModel == PricingModelExponential {
+		if halvings == 0 || s.ExcessGas == 0 {
+			return s.BaseGasPrice
+		}
+
+		if halvings >= 64 {
+			s.ExcessGas = 0
+		} else {
+			s.ExcessGas >>= halvings
+		}
+
+		s.BaseGasPrice = s.GetExponentialBaseGasPrice(params)
+		return s.BaseGasPrice
+	}
+
+	if s.BaseGasPrice.LTE(params.MinBaseGasPrice) {
+		return s.BaseGasPrice
+	}
+
+	switch {
+	case halvings == 0:
+		return s.BaseGasPrice
+	case halvings >= 63:
+		// 1 << 63 overflows an int64, so the base gas price is reset to the minimum
+		// after 63 halvings
+		s.BaseGasPrice = params.MinBaseGasPrice
+	default:
+		excess := s.BaseGasPrice.Sub(params.MinBaseGasPrice)
+		s.BaseGasPrice = params.MinBaseGasPrice.Add(excess.QuoInt64(1 << halvings))
+	}
+
+	return s.BaseGasPrice
+}
+
 // UpdateLearningRate updates the learning rate based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. There are
@@ -93,28 +430,27 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 //     case, the learning rate is decreased by the beta parameter. This occurs
 //     when blocks are relatively close to the target block utilization.
 //
-// For more details, please see the EIP-1559 specification.
+// The arithmetic saturates at MaxDecValue instead of overflowing. For more
+// details, please see the EIP-1559 specification.
 func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
-	// Panic catch in case there is an overflow
-	defer func() {
-		if rec := recover(); rec != nil {
-			s.LearningRate = params.MinLearningRate
-			lr = s.LearningRate
-		}
-	}()
-
 	// Calculate the average utilization of the block window.
 	avg := s.GetAverageUtilization(params)
 
+	// Scale the gamma thresholds on either side of the target utilization
+	// ratio. With a ratio of 0.5, the thresholds are gamma and 1 - gamma.
+	ratio := params.GetTargetUtilizationRatio()
+	lower := params.Gamma.Mul(ratio.MulInt64(2))
+	upper := math.LegacyOneDec().Sub(params.Gamma.Mul(math.LegacyOneDec().Sub(ratio).MulInt64(2)))
+
 	// Determine if the average utilization is above or below the target
 	// threshold and adjust the learning rate accordingly.
-	if avg.LTE(params.Gamma) || avg.GTE(math.LegacyOneDec().Sub(params.Gamma)) {
-		lr = params.Alpha.Add(s.LearningRate)
+	if avg.LTE(lower) || avg.GTE(upper) {
+		lr = SaturatingAdd(params.Alpha, s.LearningRate)
 		if lr.GT(params.MaxLearningRate) {
 			lr = params.MaxLearningRate
 		}
 	} else {
-		lr = s.LearningRate.Mul(params.Beta)
+		lr = SaturatingMul(s.LearningRate, params.Beta)
 		if lr.LT(params.MinLearningRate) {
 			lr = params.MinLearningRate
 		}
@@ -125,6 +461,128 @@ func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 	return s.LearningRate
 }
 
+// UpdateExcessGas adds the gas consumed above the target block utilization in
+// the current block to the accumulated excess gas. Blocks below the target
+// reduce the excess gas, which cannot drop below zero. The excess gas is capped
+// at MaxExcessGasExponent * ExcessGasUpdateFraction.
+func (s *State) UpdateExcessGas(params Params) uint64 {
+	excess := math.NewIntFromUint64(s.ExcessGas).Add(math.NewIntFromUint64(s.Window[s.Index]))
+	target := math.NewIntFromUint64(params.TargetBlockUtilization())
+
+	s.ExcessGas = boundExcessGas(excess.Sub(target), params)
+	return s.ExcessGas
+}
+
+// boundExcessGas bounds the given excess gas to
+// [0, MaxExcessGasExponent * ExcessGasUpdateFraction].
+func boundExcessGas(excess math.Int, params Params) uint64 {
+	if excess.IsNegative() {
+		return 0
+	}
+
+	limit := math.NewIntFromUint64(params.ExcessGasUpdateFraction).Mul(math.NewIntFromUint64(MaxExcessGasExponent))
+	if excess.GT(limit) {
+		excess = limit
+	}
+
+	// The limit may exceed the range of a uint64 for very large update fractions.
+	if !excess.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return excess.Uint64()
+}
+
+// GetExponentialBaseGasPrice returns the base gas price implied by the excess gas,
+// i.e. MinBaseGasPrice * e^(ExcessGas / ExcessGasUpdateFraction).
+func (s *State) GetExponentialBaseGasPrice(params Params) math.LegacyDec {
+	return FakeExponential(
+		params.MinBaseGasPrice,
+		math.NewIntFromUint64(s.ExcessGas),
+		math.NewIntFromUint64(params.ExcessGasUpdateFraction),
+	)
+}
+
+// getMaxExcessGas returns the largest excess gas for which the base gas price of the
+// exponential pricing model does not exceed the given price.
+func getMaxExcessGas(gasPrice math.LegacyDec, params Params) uint64 {
+	excess := searchExcessGas(params, func(price math.LegacyDec) bool {
+		return price.GT(gasPrice)
+	})
+
+	if excess == 0 {
+		return 0
+	}
+
+	return excess - 1
+}
+
+// getMinExcessGas returns the smallest excess gas for which the base gas price of
+// the exponential pricing model is at least the given price, or the max excess gas
+// if the price cannot be reached.
+func getMinExcessGas(gasPrice math.LegacyDec, params Params) uint64 {
+	excess := searchExcessGas(params, func(price math.LegacyDec) bool {
+		return price.GTE(gasPrice)
+	})
+
+	return min(excess, maxExcessGas(params))
+}
+
+// searchExcessGas binary searches the smallest excess gas for which the condition
+// on the base gas price of the exponential pricing model holds. The condition must
+// be monotonic in the excess gas. One more than the max excess gas is returned if
+// the condition never holds.
+func searchExcessGas(params Params, condition func(math.LegacyDec) bool) uint64 {
+	if params.ExcessGasUpdateFraction == 0 {
+		return 0
+	}
+
+	fraction := math.NewIntFromUint64(params.ExcessGasUpdateFraction)
+	n := sort.Search(int(maxExcessGas(params))+1, func(i int) bool {
+		return condition(FakeExponential(params.MinBaseGasPrice, math.NewInt(int64(i)), fraction))
+	})
+
+	return uint64(n)
+}
+
+// maxExcessGas returns the max excess gas, i.e. MaxExcessGasExponent *
+// ExcessGasUpdateFraction, bounded to the range that can be searched.
+func maxExcessGas(params Params) uint64 {
+	return min(boundExcessGas(math.NewIntFromUint64(^uint64(0)), params), 1<<62)
+}
+
+// GetBaseGasPriceUtilization returns the block utilization, in units of gas, that
+// is used to adjust the base gas price. Depending on the utilization mode, this is
+// either the utilization of the current block or a (weighted) average of the
+// utilization of all blocks in the window.
+func (s *State) GetBaseGasPriceUtilization(params Params) math.LegacyDec {
+	switch params.UtilizationMode {
+	case UtilizationMode_UTILIZATION_MODE_WINDOW_AVERAGE:
+		total := math.ZeroInt()
+		for _, utilization := range s.Window {
+			total = total.Add(math.NewIntFromUint64(utilization))
+		}
+
+		return math.LegacyNewDecFromInt(total).QuoInt64(int64(len(s.Window)))
+	case UtilizationMode_UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE:
+		// The current block is weighted by the window size and every preceding
+		// block by one less than its successor, i.e. the oldest block has a weight
+		// of one.
+		size := uint64(len(s.Window))
+		total := math.ZeroInt()
+		for i := uint64(0); i < size; i++ {
+			utilization := s.Window[(s.Index+size-i)%size]
+			weight := size - i
+			total = total.Add(math.NewIntFromUint64(utilization).Mul(math.NewIntFromUint64(weight)))
+		}
+
+		weights := math.NewIntFromUint64(size * (size + 1) / 2)
+		return math.LegacyNewDecFromInt(total).QuoInt(weights)
+	default:
+		return math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[s.Index]))
+	}
+}
+
 // GetNetUtilization returns the net utilization of the block window.
 func (s *State) GetNetUtilization(params Params) math.Int {
 	net := math.NewInt(0)
@@ -168,5 +626,18 @@ func (s *State) ValidateBasic() error {
 		return fmt.Errorf("learning rate must be positive")
 	}
 
+	if !s.BaseBytePrice.IsNil() && s.BaseBytePrice.IsNegative() {
+		return fmt.Errorf("base byte price cannot be negative")
+	}
+
+	if s.BaseGasPrice.GT(MaxDecValue) || s.LearningRate.GT(MaxDecValue) ||
+		(!s.BaseBytePrice.IsNil() && s.BaseBytePrice.GT(MaxDecValue)) {
+		return fmt.Errorf("base prices and learning rate cannot exceed %s", MaxDecValue)
+	}
+
+	if len(s.ByteWindow) != 0 && len(s.ByteWindow) != len(s.Window) {
+		return fmt.Errorf("byte window must be empty or the same size as the block utilization window")
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -119,6 +120,14 @@ func TestState_Update(t *testing.T) {
 		require.Equal(t, uint64(200), state.Window[1])
 		require.Equal(t, uint64(300), state.Window[2])
 	})
+
+	t.Run("resets exempt gas on a new block", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExemptGas = 100
+
+		state.IncrementHeight()
+		require.Equal(t, uint64(0), state.ExemptGas)
+	})
 }
 
 func TestState_UpdateBaseGasPrice(t *testing.T) {
@@ -389,6 +398,73 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 	})
 }
 
+func TestState_UpdateBaseGasPriceSaturation(t *testing.T) {
+	t.Run("base gas price saturates instead of resetting", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		state.BaseGasPrice = types.MaxDecValue.Sub(math.LegacyOneDec())
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+
+		require.NotPanics(t, func() {
+			state.UpdateBaseGasPrice(params)
+		})
+		require.Equal(t, types.MaxDecValue, state.BaseGasPrice)
+		require.Equal(t, []string{types.AttributeKeyBaseGasPrice}, state.GetSaturatedValues(params))
+	})
+
+	t.Run("large delta saturates the net adjustment", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.Delta = types.MaxDecValue
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		state.UpdateBaseGasPrice(params)
+		require.Equal(t, types.MaxDecValue, state.BaseGasPrice)
+
+		// A saturated negative adjustment floors at the min base gas price.
+		state = types.DefaultState()
+		state.UpdateBaseGasPrice(params)
+		require.Equal(t, params.MinBaseGasPrice, state.BaseGasPrice)
+		require.Empty(t, state.GetSaturatedValues(params))
+	})
+
+	t.Run("learning rate saturates", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.Alpha = types.MaxDecValue
+		params.MaxLearningRate = types.MaxDecValue
+
+		state.UpdateLearningRate(params)
+		require.Equal(t, types.MaxDecValue, state.LearningRate)
+		require.Equal(t, []string{types.AttributeKeyLearningRate}, state.GetSaturatedValues(params))
+	})
+
+	t.Run("pid base gas price saturates", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PidProportionalGain = types.MaxDecValue
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		state.UpdatePIDBaseGasPrice(params)
+		require.Equal(t, types.MaxDecValue, state.BaseGasPrice)
+	})
+
+	t.Run("base byte price saturates", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxBlockBytes = 1000
+		params.TargetBlockBytes = 500
+		params.MinBaseBytePrice = math.LegacyOneDec()
+		params.ByteLearningRate = types.MaxDecValue
+
+		require.NoError(t, state.UpdateBytes(params.MaxBlockBytes, params))
+		state.UpdateBaseBytePrice(params)
+		require.Equal(t, types.MaxDecValue, state.BaseBytePrice)
+		require.Equal(t, []string{types.AttributeKeyBaseBytePrice}, state.GetSaturatedValues(params))
+	})
+}
+
 func TestState_UpdateLearningRate(t *testing.T) {
 	t.Run("empty block with default eip-1559", func(t *testing.T) {
 		state := types.DefaultState()
@@ -537,6 +613,123 @@ func TestState_UpdateLearningRate(t *testing.T) {
 	})
 }
 
+func TestState_GetBaseGasPriceUtilization(t *testing.T) {
+	state := types.DefaultAIMDState()
+	state.Window = []uint64{100, 200, 300, 400}
+	state.Index = 1
+
+	t.Run("defaults to the current block", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+
+		utilization := state.GetBaseGasPriceUtilization(params)
+		require.Equal(t, math.LegacyNewDec(200), utilization)
+	})
+
+	t.Run("current block", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.UtilizationMode = types.UtilizationMode_UTILIZATION_MODE_CURRENT_BLOCK
+
+		utilization := state.GetBaseGasPriceUtilization(params)
+		require.Equal(t, math.LegacyNewDec(200), utilization)
+	})
+
+	t.Run("window average", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.UtilizationMode = types.UtilizationMode_UTILIZATION_MODE_WINDOW_AVERAGE
+
+		utilization := state.GetBaseGasPriceUtilization(params)
+		require.Equal(t, math.LegacyNewDec(250), utilization)
+	})
+
+	t.Run("window weighted average", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.UtilizationMode = types.UtilizationMode_UTILIZATION_MODE_WINDOW_WEIGHTED_AVERAGE
+
+		// (4 * 200 + 3 * 100 + 2 * 400 + 1 * 300) / 10
+		utilization := state.GetBaseGasPriceUtilization(params)
+		require.Equal(t, math.LegacyNewDec(220), utilization)
+	})
+
+	t.Run("window average dampens a single full block", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.UtilizationMode = types.UtilizationMode_UTILIZATION_MODE_WINDOW_AVERAGE
+
+		state := types.DefaultAIMDState()
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.125")
+		for i := range state.Window {
+			state.Window[i] = params.TargetBlockUtilization()
+		}
+		state.Window[state.Index] = params.MaxBlockUtilization
+
+		// The average utilization is 1/8th above target, so the base gas price
+		// increases by 1/8th of the learning rate instead of the full learning rate.
+		newBaseGasPrice := state.UpdateBaseGasPrice(params)
+		expected := params.MinBaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.015625"))
+		require.Equal(t, expected, newBaseGasPrice)
+	})
+}
+
+func TestState_TargetUtilizationRatio(t *testing.T) {
+	params := types.DefaultAIMDParams()
+	params.TargetUtilizationRatio = math.LegacyMustNewDecFromStr("0.3")
+
+	t.Run("target block does not change the base gas price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = state.BaseGasPrice.Mul(math.LegacyNewDec(2))
+		state.Window[state.Index] = params.TargetBlockUtilization()
+
+		require.Equal(t, uint64(9_000_000), params.TargetBlockUtilization())
+		newBaseGasPrice := state.UpdateBaseGasPrice(params)
+		require.Equal(t, state.BaseGasPrice, newBaseGasPrice)
+	})
+
+	t.Run("half full block increases the base gas price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.Window[state.Index] = params.MaxBlockUtilization / 2
+
+		newBaseGasPrice := state.UpdateBaseGasPrice(params)
+		require.True(t, newBaseGasPrice.GT(params.MinBaseGasPrice))
+	})
+
+	t.Run("net utilization is relative to the target", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		for i := range state.Window {
+			state.Window[i] = params.TargetBlockUtilization()
+		}
+
+		require.True(t, state.GetNetUtilization(params).IsZero())
+	})
+
+	t.Run("gamma thresholds are scaled around the target", func(t *testing.T) {
+		// With a ratio of 0.3 and gamma of 0.25, the learning rate is increased
+		// when the average utilization is at most 0.15 or at least 0.65.
+		state := types.DefaultAIMDState()
+		for i := range state.Window {
+			state.Window[i] = params.MaxBlockUtilization * 15 / 100
+		}
+
+		state.UpdateLearningRate(params)
+		require.Equal(t, params.MinLearningRate.Add(params.Alpha), state.LearningRate)
+
+		state = types.DefaultAIMDState()
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.1")
+		for i := range state.Window {
+			state.Window[i] = params.MaxBlockUtilization * 60 / 100
+		}
+
+		state.UpdateLearningRate(params)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.1").Mul(params.Beta), state.LearningRate)
+
+		for i := range state.Window {
+			state.Window[i] = params.MaxBlockUtilization * 65 / 100
+		}
+
+		lr := state.LearningRate
+		state.UpdateLearningRate(params)
+		require.Equal(t, lr.Add(params.Alpha), state.LearningRate)
+	})
+}
+
 func TestState_GetNetUtilization(t *testing.T) {
 	t.Run("empty block with default eip-1559", func(t *testing.T) {
 		state := types.DefaultState()
@@ -868,6 +1061,35 @@ func TestState_ValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "invalid negative base byte price",
+			state: types.State{
+				Window:        make([]uint64, 1),
+				BaseGasPrice:  math.LegacyMustNewDecFromStr("1"),
+				LearningRate:  math.LegacyMustNewDecFromStr("0.5"),
+				BaseBytePrice: math.LegacyMustNewDecFromStr("-1"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid base gas price above the saturation limit",
+			state: types.State{
+				Window:       make([]uint64, 1),
+				BaseGasPrice: types.MaxDecValue.Add(math.LegacyOneDec()),
+				LearningRate: math.LegacyMustNewDecFromStr("0.5"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid byte window size",
+			state: types.State{
+				Window:       make([]uint64, 1),
+				BaseGasPrice: math.LegacyMustNewDecFromStr("1"),
+				LearningRate: math.LegacyMustNewDecFromStr("0.5"),
+				ByteWindow:   make([]uint64, 2),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -881,3 +1103,556 @@ func TestState_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestState_UpdateExcessGas(t *testing.T) {
+	t.Run("empty block with no excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		require.Equal(t, uint64(0), state.UpdateExcessGas(params))
+		require.True(t, params.MinBaseGasPrice.Equal(state.GetExponentialBaseGasPrice(params)))
+	})
+
+	t.Run("target block keeps the excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExcessGas = 100
+		params := types.DefaultParams()
+
+		require.NoError(t, state.Update(params.TargetBlockUtilization(), params))
+		require.Equal(t, uint64(100), state.UpdateExcessGas(params))
+	})
+
+	t.Run("full block adds the excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExcessGas = 100
+		params := types.DefaultParams()
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		expected := 100 + params.MaxBlockUtilization - params.TargetBlockUtilization()
+		require.Equal(t, expected, state.UpdateExcessGas(params))
+	})
+
+	t.Run("empty block decreases the excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		state.ExcessGas = params.TargetBlockUtilization() + 100
+
+		require.Equal(t, uint64(100), state.UpdateExcessGas(params))
+	})
+
+	t.Run("excess gas is capped", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.ExcessGasUpdateFraction = 1
+		state.ExcessGas = types.MaxExcessGasExponent
+
+		require.NoError(t, state.Update(params.MaxBlockUtilization, params))
+		require.Equal(t, types.MaxExcessGasExponent, state.UpdateExcessGas(params))
+	})
+}
+
+func TestState_UpdatePIDBaseGasPrice(t *testing.T) {
+	params := types.DefaultAIMDParams()
+	params.PidProportionalGain = math.LegacyMustNewDecFromStr("0.1")
+	params.PidIntegralGain = math.LegacyMustNewDecFromStr("0.01")
+	params.PidDerivativeGain = math.LegacyMustNewDecFromStr("0.05")
+	params.PidIntegralLimit = math.LegacyMustNewDecFromStr("2")
+
+	target := params.TargetBlockUtilization()
+
+	t.Run("target blocks do not change the base gas price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		for i := range state.Window {
+			state.Window[i] = target
+		}
+
+		require.Equal(t, params.MinBaseGasPrice.MulInt64(2), state.UpdatePIDBaseGasPrice(params))
+	})
+
+	t.Run("proportional, integral and derivative terms", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		for i := range state.Window {
+			state.Window[i] = target
+		}
+
+		// The previous block is at target and the current block is full.
+		state.Index = 1
+		state.Window[1] = params.MaxBlockUtilization
+
+		// P = 1, I = 1, D = 1 - 0.
+		factor := math.LegacyMustNewDecFromStr("1.16")
+		require.Equal(t, params.MinBaseGasPrice.MulInt64(2).Mul(factor), state.UpdatePIDBaseGasPrice(params))
+	})
+
+	t.Run("integral term is bounded", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		for i := range state.Window {
+			state.Window[i] = params.MaxBlockUtilization
+		}
+
+		// P = 1, I = min(8, 2), D = 0.
+		factor := math.LegacyMustNewDecFromStr("1.12")
+		require.Equal(t, params.MinBaseGasPrice.MulInt64(2).Mul(factor), state.UpdatePIDBaseGasPrice(params))
+	})
+
+	t.Run("empty blocks decrease the base gas price to the minimum", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+
+		// P = -1, I = max(-8, -2), D = 0.
+		factor := math.LegacyMustNewDecFromStr("0.88")
+		require.Equal(t, params.MinBaseGasPrice.MulInt64(2).Mul(factor), state.UpdatePIDBaseGasPrice(params))
+
+		state.BaseGasPrice = params.MinBaseGasPrice
+		require.Equal(t, params.MinBaseGasPrice, state.UpdatePIDBaseGasPrice(params))
+	})
+}
+
+func TestState_GetElapsedTime(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+
+	t.Run("unknown last block time", func(t *testing.T) {
+		state := types.DefaultState()
+
+		_, ok := state.GetElapsedTime(now)
+		require.False(t, ok)
+	})
+
+	t.Run("block time did not advance", func(t *testing.T) {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+
+		_, ok := state.GetElapsedTime(now)
+		require.False(t, ok)
+	})
+
+	t.Run("block time advanced", func(t *testing.T) {
+		state := types.DefaultState()
+		state.LastBlockTime = now
+
+		elapsed, ok := state.GetElapsedTime(now.Add(5 * time.Second))
+		require.True(t, ok)
+		require.Equal(t, 5*time.Second, elapsed)
+	})
+}
+
+func TestState_ScaleBaseGasPriceAdjustment(t *testing.T) {
+	previous := types.State{BaseGasPrice: math.LegacyNewDec(100)}
+
+	t.Run("target block time not set", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(110)
+		params := types.DefaultParams()
+
+		require.Equal(t, math.LegacyNewDec(110), state.ScaleBaseGasPriceAdjustment(previous, time.Minute, params))
+	})
+
+	t.Run("long block scales the increase", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(110)
+		params := types.DefaultParams()
+		params.TargetBlockTime = 2 * time.Second
+
+		require.Equal(t, math.LegacyNewDec(120), state.ScaleBaseGasPriceAdjustment(previous, 4*time.Second, params))
+	})
+
+	t.Run("short block scales the decrease", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(90)
+		params := types.DefaultParams()
+		params.TargetBlockTime = 2 * time.Second
+
+		require.Equal(t, math.LegacyNewDec(95), state.ScaleBaseGasPriceAdjustment(previous, time.Second, params))
+	})
+
+	t.Run("scale is bounded", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(101)
+		params := types.DefaultParams()
+		params.TargetBlockTime = time.Second
+
+		require.Equal(t, math.LegacyNewDec(110), state.ScaleBaseGasPriceAdjustment(previous, time.Hour, params))
+	})
+
+	t.Run("scaled base gas price is at least the min base gas price", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(50)
+		params := types.DefaultParams()
+		params.TargetBlockTime = time.Second
+
+		require.Equal(t, params.MinBaseGasPrice, state.ScaleBaseGasPriceAdjustment(previous, time.Hour, params))
+	})
+
+	t.Run("exponential model scales the excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		state.ExcessGas = 1_100_000
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.TargetBlockTime = 2 * time.Second
+
+		price := state.ScaleBaseGasPriceAdjustment(types.State{ExcessGas: 1_000_000}, 4*time.Second, params)
+		require.Equal(t, uint64(1_200_000), state.ExcessGas)
+		require.Equal(t, state.GetExponentialBaseGasPrice(params), price)
+		require.Equal(t, price, state.BaseGasPrice)
+	})
+
+	t.Run("exponential model bounds the scaled excess gas at zero", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.TargetBlockTime = time.Second
+
+		price := state.ScaleBaseGasPriceAdjustment(types.State{ExcessGas: 1_000_000}, time.Hour, params)
+		require.Equal(t, uint64(0), state.ExcessGas)
+		require.Equal(t, params.MinBaseGasPrice, price)
+	})
+}
+
+func TestState_ClampBaseGasPrice(t *testing.T) {
+	t.Run("base gas price is clamped", func(t *testing.T) {
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyNewDec(20)
+		params := types.DefaultParams()
+		params.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.5")
+
+		bound := state.ClampBaseGasPrice(types.State{BaseGasPrice: math.LegacyNewDec(10)}, params)
+		require.Equal(t, types.BaseGasPriceBoundMaxIncrease, bound)
+		require.Equal(t, math.LegacyNewDec(15), state.BaseGasPrice)
+	})
+
+	params := types.DefaultParams()
+	params.PricingModel = types.PricingModelExponential
+	params.ExcessGasUpdateFraction = 1000
+
+	// exponentialState returns a state with the given excess gas and the matching
+	// base gas price.
+	exponentialState := func(excessGas uint64) types.State {
+		state := types.DefaultState()
+		state.ExcessGas = excessGas
+		state.BaseGasPrice = state.GetExponentialBaseGasPrice(params)
+		return state
+	}
+
+	testCases := []struct {
+		name          string
+		params        func(types.Params) types.Params
+		previous      types.State
+		excessGas     uint64
+		expectedGas   uint64
+		expectedBound string
+	}{
+		{
+			name:          "exponential model within bounds",
+			params:        func(p types.Params) types.Params { return p },
+			previous:      exponentialState(0),
+			excessGas:     1000,
+			expectedGas:   1000,
+			expectedBound: types.BaseGasPriceBoundNone,
+		},
+		{
+			name: "exponential model max increase",
+			params: func(p types.Params) types.Params {
+				p.MaxBaseGasPriceIncrease = math.LegacyMustNewDecFromStr("0.5")
+				return p
+			},
+			previous:  exponentialState(0),
+			excessGas: 1000,
+			// e^(405/1000) < 1.5 < e^(406/1000)
+			expectedGas:   405,
+			expectedBound: types.BaseGasPriceBoundMaxIncrease,
+		},
+		{
+			name: "exponential model max decrease",
+			params: func(p types.Params) types.Params {
+				p.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("0.5")
+				return p
+			},
+			previous:  exponentialState(1000),
+			excessGas: 0,
+			// e^(306/1000) < e / 2 < e^(307/1000)
+			expectedGas:   307,
+			expectedBound: types.BaseGasPriceBoundMaxDecrease,
+		},
+		{
+			name: "exponential model max base gas price",
+			params: func(p types.Params) types.Params {
+				p.MaxBaseGasPrice = math.LegacyNewDec(2)
+				return p
+			},
+			previous:  exponentialState(0),
+			excessGas: 1000,
+			// e^(693/1000) < 2 < e^(694/1000)
+			expectedGas:   693,
+			expectedBound: types.BaseGasPriceBoundMaxBaseGasPrice,
+		},
+		{
+			name: "exponential model max base gas price takes precedence over max decrease",
+			params: func(p types.Params) types.Params {
+				p.MaxBaseGasPrice = math.LegacyNewDec(2)
+				p.MaxBaseGasPriceDecrease = math.LegacyMustNewDecFromStr("0.5")
+				return p
+			},
+			previous:  exponentialState(5000),
+			excessGas: 0,
+			// e^(693/1000) < 2 < e^(694/1000)
+			expectedGas:   693,
+			expectedBound: types.BaseGasPriceBoundMaxBaseGasPrice,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := tc.params(params)
+			state := exponentialState(tc.excessGas)
+
+			require.Equal(t, tc.expectedBound, state.ClampBaseGasPrice(tc.previous, p))
+			require.Equal(t, tc.expectedGas, state.ExcessGas)
+			require.Equal(t, state.GetExponentialBaseGasPrice(p), state.BaseGasPrice)
+		})
+	}
+}
+
+func TestState_DecayBaseGasPrice(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyNewDec(10)
+	params.DowntimeThreshold = time.Minute
+
+	testCases := []struct {
+		name     string
+		params   types.Params
+		elapsed  time.Duration
+		expected math.LegacyDec
+	}{
+		{
+			name:     "downtime threshold not set",
+			params:   types.DefaultParams(),
+			elapsed:  time.Hour,
+			expected: math.LegacyNewDec(90),
+		},
+		{
+			name:     "below downtime threshold",
+			params:   params,
+			elapsed:  59 * time.Second,
+			expected: math.LegacyNewDec(90),
+		},
+		{
+			name:     "single downtime threshold",
+			params:   params,
+			elapsed:  time.Minute,
+			expected: math.LegacyNewDec(50),
+		},
+		{
+			name:     "multiple downtime thresholds",
+			params:   params,
+			elapsed:  3*time.Minute + 30*time.Second,
+			expected: math.LegacyNewDec(20),
+		},
+		{
+			name:     "63 downtime thresholds reset to the min base gas price",
+			params:   params,
+			elapsed:  63 * time.Minute,
+			expected: math.LegacyNewDec(10),
+		},
+		{
+			name:     "long downtime resets to the min base gas price",
+			params:   params,
+			elapsed:  24 * time.Hour,
+			expected: math.LegacyNewDec(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := types.DefaultState()
+			state.BaseGasPrice = math.LegacyNewDec(90)
+
+			require.Equal(t, tc.expected, state.DecayBaseGasPrice(tc.elapsed, tc.params))
+		})
+	}
+
+	t.Run("exponential model halves the excess gas", func(t *testing.T) {
+		exponentialParams := params
+		exponentialParams.PricingModel = types.PricingModelExponential
+
+		state := types.DefaultState()
+		state.ExcessGas = 800_000
+
+		price := state.DecayBaseGasPrice(3*time.Minute+30*time.Second, exponentialParams)
+		require.Equal(t, uint64(100_000), state.ExcessGas)
+		require.Equal(t, state.GetExponentialBaseGasPrice(exponentialParams), price)
+		require.Equal(t, price, state.BaseGasPrice)
+	})
+
+	t.Run("exponential model resets the excess gas after long downtime", func(t *testing.T) {
+		exponentialParams := params
+		exponentialParams.PricingModel = types.PricingModelExponential
+
+		state := types.DefaultState()
+		state.ExcessGas = 800_000
+
+		require.Equal(t, exponentialParams.MinBaseGasPrice, state.DecayBaseGasPrice(24*time.Hour, exponentialParams))
+		require.Equal(t, uint64(0), state.ExcessGas)
+	})
+}
+
+func TestState_ByteDimension(t *testing.T) {
+	params := types.DefaultAIMDParams()
+	params.MaxBlockBytes = 1000
+	params.TargetBlockBytes = 500
+	params.MinBaseBytePrice = math.LegacyOneDec()
+
+	t.Run("disabled byte dimension is a no-op", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		require.NoError(t, state.UpdateBytes(100_000, params))
+		require.Equal(t, make([]uint64, len(state.Window)), state.ByteWindow)
+		require.True(t, state.UpdateBaseBytePrice(params).IsZero())
+		require.True(t, state.GetBaseBytePrice(params).IsZero())
+	})
+
+	t.Run("can add bytes up to the max block bytes", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+
+		require.NoError(t, state.UpdateBytes(600, params))
+		require.NoError(t, state.UpdateBytes(400, params))
+		require.Equal(t, uint64(1000), state.ByteWindow[state.Index])
+		require.Error(t, state.UpdateBytes(1, params))
+	})
+
+	t.Run("allocates the byte window for legacy states", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.ByteWindow = nil
+
+		require.NoError(t, state.UpdateBytes(100, params))
+		require.Len(t, state.ByteWindow, len(state.Window))
+		require.Equal(t, uint64(100), state.ByteWindow[state.Index])
+	})
+
+	t.Run("increment height resets the byte window", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.ByteWindow[1] = 100
+
+		state.IncrementHeight()
+		require.Equal(t, uint64(0), state.ByteWindow[1])
+	})
+
+	t.Run("base byte price defaults to the min base byte price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		require.Equal(t, params.MinBaseBytePrice, state.GetBaseBytePrice(params))
+	})
+
+	t.Run("full block increases the base byte price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseBytePrice = math.LegacyNewDec(2)
+
+		require.NoError(t, state.UpdateBytes(params.MaxBlockBytes, params))
+		require.Equal(t, math.LegacyMustNewDecFromStr("2.25"), state.UpdateBaseBytePrice(params))
+	})
+
+	t.Run("empty block decreases the base byte price to the minimum", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.BaseBytePrice = math.LegacyNewDec(2)
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("1.75"), state.UpdateBaseBytePrice(params))
+
+		state.BaseBytePrice = params.MinBaseBytePrice
+		require.Equal(t, params.MinBaseBytePrice, state.UpdateBaseBytePrice(params))
+	})
+}
+
+func TestState_ApplyParams(t *testing.T) {
+	t.Run("unchanged window is preserved", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		state.Window[2] = 100
+		state.Index = 2
+
+		state.ApplyParams(params)
+		require.Equal(t, uint64(100), state.Window[2])
+		require.Equal(t, uint64(2), state.Index)
+	})
+
+	t.Run("window is upsampled in chronological order", func(t *testing.T) {
+		state := types.NewState(3, math.LegacyOneDec(), math.LegacyOneDec())
+		params := types.DefaultParams()
+		params.Window = 5
+
+		// The ring buffer holds 1, 2, 3 from oldest to current.
+		state.Window = []uint64{3, 1, 2}
+		state.ByteWindow = []uint64{30, 10, 20}
+		state.Index = 0
+
+		state.ApplyParams(params)
+		require.Equal(t, []uint64{1, 1, 2, 2, 3}, state.Window)
+		require.Equal(t, []uint64{10, 10, 20, 20, 30}, state.ByteWindow)
+		require.Equal(t, uint64(4), state.Index)
+	})
+
+	t.Run("window is downsampled keeping the current block", func(t *testing.T) {
+		state := types.NewState(5, math.LegacyOneDec(), math.LegacyOneDec())
+		params := types.DefaultParams()
+		params.Window = 1
+
+		state.Window = []uint64{1, 2, 3, 4, 5}
+		state.Index = 2
+
+		state.ApplyParams(params)
+		require.Equal(t, []uint64{3}, state.Window)
+		require.Equal(t, []uint64{0}, state.ByteWindow)
+		require.Equal(t, uint64(0), state.Index)
+	})
+
+	t.Run("base gas price and learning rate are clamped", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.MinBaseGasPrice = math.LegacyNewDec(2)
+		params.MinLearningRate = math.LegacyMustNewDecFromStr("0.1")
+
+		state.BaseGasPrice = math.LegacyOneDec()
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.05")
+		state.ApplyParams(params)
+		require.Equal(t, params.MinBaseGasPrice, state.BaseGasPrice)
+		require.Equal(t, params.MinLearningRate, state.LearningRate)
+
+		params.MaxBaseGasPrice = math.LegacyNewDec(3)
+		state.BaseGasPrice = math.LegacyNewDec(4)
+		state.LearningRate = math.LegacyNewDec(1)
+		state.ApplyParams(params)
+		require.Equal(t, params.MaxBaseGasPrice, state.BaseGasPrice)
+		require.Equal(t, params.MaxLearningRate, state.LearningRate)
+	})
+
+	t.Run("exponential model clamps the excess gas", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.ExcessGasUpdateFraction = 1000
+		params.MaxBaseGasPrice = math.LegacyNewDec(2)
+
+		state.ExcessGas = 5000
+		state.BaseGasPrice = state.GetExponentialBaseGasPrice(params)
+		state.ApplyParams(params)
+
+		// e^(693/1000) < 2 < e^(694/1000)
+		require.Equal(t, uint64(693), state.ExcessGas)
+		require.Equal(t, state.GetExponentialBaseGasPrice(params), state.BaseGasPrice)
+		require.True(t, state.BaseGasPrice.LTE(params.MaxBaseGasPrice))
+	})
+
+	t.Run("exponential model bounds the excess gas by the new update fraction", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.PricingModel = types.PricingModelExponential
+		params.ExcessGasUpdateFraction = 1
+
+		state.ExcessGas = 5000
+		state.ApplyParams(params)
+
+		require.Equal(t, types.MaxExcessGasExponent, state.ExcessGas)
+		require.Equal(t, state.GetExponentialBaseGasPrice(params), state.BaseGasPrice)
+	})
+}
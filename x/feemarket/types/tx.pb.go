@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -38,6 +39,10 @@ type MsgParams struct {
 	// Authority defines the authority that is updating the feemarket module
 	// parameters.
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ResetState reinitializes the fee market state from the new parameters. By
+	// default, the current state is carried forward and adjusted to the new
+	// parameters.
+	ResetState bool `protobuf:"varint,3,opt,name=reset_state,json=resetState,proto3" json:"reset_state,omitempty"`
 }
 
 func (m *MsgParams) Reset()         { *m = MsgParams{} }
@@ -87,6 +92,13 @@ func (m *MsgParams) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgParams) GetResetState() bool {
+	if m != nil {
+		return m.ResetState
+	}
+	return false
+}
+
 // MsgParamsResponse defines the Msg/Params response type.
 type MsgParamsResponse struct {
 }
@@ -124,224 +136,3660 @@ func (m *MsgParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgParams)(nil), "feemarket.feemarket.v1.MsgParams")
-	proto.RegisterType((*MsgParamsResponse)(nil), "feemarket.feemarket.v1.MsgParamsResponse")
+// MsgUpdateParamsPartial defines the Msg/UpdateParamsPartial request type. Only
+// the parameters listed in the update mask are taken from the given params, all
+// other parameters are left unchanged.
+type MsgUpdateParamsPartial struct {
+	// Params contains the new values of the parameters in the update mask.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// UpdateMask lists the proto field names of the parameters to update, e.g.
+	// "fee_denom" or "send_tip_to_proposer", similar to the paths of a
+	// google.protobuf.FieldMask.
+	UpdateMask []string `protobuf:"bytes,2,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Authority defines the authority that is updating the feemarket module
+	// parameters.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func init() { proto.RegisterFile("feemarket/feemarket/v1/tx.proto", fileDescriptor_1bbf67a633e47917) }
-
-var fileDescriptor_1bbf67a633e47917 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4b, 0x4d, 0xcd,
-	0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x47, 0xb0, 0xca, 0x0c, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0, 0xc2, 0x7a, 0x08, 0x56, 0x99, 0xa1, 0x94, 0x32, 0x0e, 0x8d,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0xcd, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5,
-	0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12, 0x87, 0xf0, 0xf4, 0x73, 0x8b, 0xd3, 0x41, 0xda,
-	0x72, 0x8b, 0xd3, 0xa1, 0x12, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x0d, 0x20, 0x16, 0x44, 0x54,
-	0x69, 0x22, 0x23, 0x17, 0xa7, 0x6f, 0x71, 0x7a, 0x00, 0xd8, 0x74, 0x21, 0x1b, 0x2e, 0x36, 0x88,
-	0x3d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0xd8, 0x5d, 0xa9, 0x07, 0x51, 0xef,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d,
-	0xba, 0x22, 0x50, 0xf7, 0x39, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6,
-	0xa5, 0x07, 0x21, 0x94, 0x5a, 0xf1, 0x35, 0x3d, 0xdf, 0xa0, 0x85, 0xe0, 0x2b, 0x09, 0x73, 0x09,
-	0xc2, 0x9d, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xc2, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0x14, 0xc6, 0xc5, 0x06, 0x75, 0xab, 0x22, 0x2e, 0xb7, 0xc1, 0xf5, 0x4a, 0x69, 0x12,
-	0x54, 0x02, 0x33, 0x5e, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xcf, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xce, 0x2c, 0xd0, 0xcd, 0x4d, 0x2d, 0x43, 0x8a, 0xa1, 0x0a, 0x24,
-	0x76, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x80, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0xa3, 0x3e, 0x01, 0x0a, 0x02, 0x00, 0x00,
+func (m *MsgUpdateParamsPartial) Reset()         { *m = MsgUpdateParamsPartial{} }
+func (m *MsgUpdateParamsPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartial) ProtoMessage()    {}
+func (*MsgUpdateParamsPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{2}
+}
+func (m *MsgUpdateParamsPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartial.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartial.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateParamsPartial proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateParamsPartial) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Params defines a method for updating the feemarket module parameters.
-	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+func (m *MsgUpdateParamsPartial) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateParamsPartial) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgUpdateParamsPartialResponse defines the Msg/UpdateParamsPartial response
+// type.
+type MsgUpdateParamsPartialResponse struct {
 }
 
-func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
-	out := new(MsgParamsResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsPartialResponse) Reset()         { *m = MsgUpdateParamsPartialResponse{} }
+func (m *MsgUpdateParamsPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartialResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{3}
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Params defines a method for updating the feemarket module parameters.
-	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+var xxx_messageInfo_MsgUpdateParamsPartialResponse proto.InternalMessageInfo
+
+// MsgScheduleParams defines the Msg/ScheduleParams request type. It contains
+// the new parameters for the feemarket module and the block height at which
+// they take effect.
+type MsgScheduleParams struct {
+	// ScheduledParams contains the new parameters and the height at which they
+	// take effect. The height must be in the future and not already scheduled.
+	ScheduledParams ScheduledParams `protobuf:"bytes,1,opt,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params"`
+	// Authority defines the authority that is scheduling the feemarket module
+	// parameters.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgScheduleParams) Reset()         { *m = MsgScheduleParams{} }
+func (m *MsgScheduleParams) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParams) ProtoMessage()    {}
+func (*MsgScheduleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{4}
+}
+func (m *MsgScheduleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParams.Merge(m, src)
+}
+func (m *MsgScheduleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParams.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) Params(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_MsgScheduleParams proto.InternalMessageInfo
+
+func (m *MsgScheduleParams) GetScheduledParams() ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return ScheduledParams{}
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgScheduleParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgParams)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgScheduleParamsResponse defines the Msg/ScheduleParams response type.
+type MsgScheduleParamsResponse struct {
+}
+
+func (m *MsgScheduleParamsResponse) Reset()         { *m = MsgScheduleParamsResponse{} }
+func (m *MsgScheduleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsResponse) ProtoMessage()    {}
+func (*MsgScheduleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{5}
+}
+func (m *MsgScheduleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Params(ctx, in)
+}
+func (m *MsgScheduleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledParams defines the Msg/CancelScheduledParams request type.
+type MsgCancelScheduledParams struct {
+	// Height is the block height of the pending parameter change to cancel.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Authority defines the authority that is cancelling the pending parameter
+	// change.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCancelScheduledParams) Reset()         { *m = MsgCancelScheduledParams{} }
+func (m *MsgCancelScheduledParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledParams) ProtoMessage()    {}
+func (*MsgCancelScheduledParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{6}
+}
+func (m *MsgCancelScheduledParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Msg/Params",
+}
+func (m *MsgCancelScheduledParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledParams.Merge(m, src)
+}
+func (m *MsgCancelScheduledParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledParams proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Params(ctx, req.(*MsgParams))
+	return 0
+}
+
+func (m *MsgCancelScheduledParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "feemarket.feemarket.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Msg_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "feemarket/feemarket/v1/tx.proto",
+// MsgCancelScheduledParamsResponse defines the Msg/CancelScheduledParams
+// response type.
+type MsgCancelScheduledParamsResponse struct {
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledParamsResponse) Reset()         { *m = MsgCancelScheduledParamsResponse{} }
+func (m *MsgCancelScheduledParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledParamsResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{7}
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledParamsResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelScheduledParamsResponse proto.InternalMessageInfo
+
+// MsgRampParams defines the Msg/RampParams request type. It starts a ramp from
+// the current parameters to the target parameters.
+type MsgRampParams struct {
+	// TargetParams defines the parameters at the end of the ramp.
+	TargetParams Params `protobuf:"bytes,1,opt,name=target_params,json=targetParams,proto3" json:"target_params"`
+	// Blocks is the number of blocks over which the parameters are
+	// interpolated.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// Authority defines the authority that is ramping the feemarket module
+	// parameters.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRampParams) Reset()         { *m = MsgRampParams{} }
+func (m *MsgRampParams) String() string { return proto.CompactTextString(m) }
+func (*MsgRampParams) ProtoMessage()    {}
+func (*MsgRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{8}
+}
+func (m *MsgRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRampParams.Merge(m, src)
+}
+func (m *MsgRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRampParams.DiscardUnknown(m)
 }
 
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRampParams proto.InternalMessageInfo
+
+func (m *MsgRampParams) GetTargetParams() Params {
+	if m != nil {
+		return m.TargetParams
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRampParams) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *MsgRampParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRampParamsResponse defines the Msg/RampParams response type.
+type MsgRampParamsResponse struct {
+}
+
+func (m *MsgRampParamsResponse) Reset()         { *m = MsgRampParamsResponse{} }
+func (m *MsgRampParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRampParamsResponse) ProtoMessage()    {}
+func (*MsgRampParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{9}
+}
+func (m *MsgRampParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRampParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRampParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRampParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRampParamsResponse.Merge(m, src)
+}
+func (m *MsgRampParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRampParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRampParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRampParamsResponse proto.InternalMessageInfo
+
+// MsgFreezeBaseGasPrice defines the Msg/FreezeBaseGasPrice request type. The
+// guardian can freeze the base gas price for at most Params.MaxFreezeBlocks
+// blocks and only if no freeze is in effect. The authority can freeze the base
+// gas price for any number of blocks, replacing any freeze in effect.
+type MsgFreezeBaseGasPrice struct {
+	// BaseGasPrice is the base gas price while the freeze is in effect.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// Blocks is the number of blocks for which the base gas price is frozen.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// Signer is the guardian or the authority that is freezing the base gas
+	// price.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgFreezeBaseGasPrice) Reset()         { *m = MsgFreezeBaseGasPrice{} }
+func (m *MsgFreezeBaseGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeBaseGasPrice) ProtoMessage()    {}
+func (*MsgFreezeBaseGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{10}
+}
+func (m *MsgFreezeBaseGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeBaseGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeBaseGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeBaseGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeBaseGasPrice.Merge(m, src)
+}
+func (m *MsgFreezeBaseGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeBaseGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeBaseGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeBaseGasPrice proto.InternalMessageInfo
+
+func (m *MsgFreezeBaseGasPrice) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *MsgFreezeBaseGasPrice) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgFreezeBaseGasPriceResponse defines the Msg/FreezeBaseGasPrice response
+// type.
+type MsgFreezeBaseGasPriceResponse struct {
+}
+
+func (m *MsgFreezeBaseGasPriceResponse) Reset()         { *m = MsgFreezeBaseGasPriceResponse{} }
+func (m *MsgFreezeBaseGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeBaseGasPriceResponse) ProtoMessage()    {}
+func (*MsgFreezeBaseGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{11}
+}
+func (m *MsgFreezeBaseGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeBaseGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeBaseGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeBaseGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeBaseGasPriceResponse.Merge(m, src)
+}
+func (m *MsgFreezeBaseGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeBaseGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeBaseGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeBaseGasPriceResponse proto.InternalMessageInfo
+
+// MsgExtendFeeFreeze defines the Msg/ExtendFeeFreeze request type.
+type MsgExtendFeeFreeze struct {
+	// Blocks is the number of blocks by which the freeze is extended.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// Authority defines the authority that is extending the freeze.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgExtendFeeFreeze) Reset()         { *m = MsgExtendFeeFreeze{} }
+func (m *MsgExtendFeeFreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendFeeFreeze) ProtoMessage()    {}
+func (*MsgExtendFeeFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{12}
+}
+func (m *MsgExtendFeeFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendFeeFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendFeeFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendFeeFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendFeeFreeze.Merge(m, src)
+}
+func (m *MsgExtendFeeFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendFeeFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendFeeFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendFeeFreeze proto.InternalMessageInfo
+
+func (m *MsgExtendFeeFreeze) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *MsgExtendFeeFreeze) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgExtendFeeFreezeResponse defines the Msg/ExtendFeeFreeze response type.
+type MsgExtendFeeFreezeResponse struct {
+}
+
+func (m *MsgExtendFeeFreezeResponse) Reset()         { *m = MsgExtendFeeFreezeResponse{} }
+func (m *MsgExtendFeeFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendFeeFreezeResponse) ProtoMessage()    {}
+func (*MsgExtendFeeFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{13}
+}
+func (m *MsgExtendFeeFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendFeeFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendFeeFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendFeeFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendFeeFreezeResponse.Merge(m, src)
+}
+func (m *MsgExtendFeeFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendFeeFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendFeeFreezeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendFeeFreezeResponse proto.InternalMessageInfo
+
+// MsgLiftFeeFreeze defines the Msg/LiftFeeFreeze request type.
+type MsgLiftFeeFreeze struct {
+	// Authority defines the authority that is lifting the freeze.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgLiftFeeFreeze) Reset()         { *m = MsgLiftFeeFreeze{} }
+func (m *MsgLiftFeeFreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftFeeFreeze) ProtoMessage()    {}
+func (*MsgLiftFeeFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{14}
+}
+func (m *MsgLiftFeeFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftFeeFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftFeeFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiftFeeFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftFeeFreeze.Merge(m, src)
+}
+func (m *MsgLiftFeeFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftFeeFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftFeeFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiftFeeFreeze proto.InternalMessageInfo
+
+func (m *MsgLiftFeeFreeze) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgLiftFeeFreezeResponse defines the Msg/LiftFeeFreeze response type.
+type MsgLiftFeeFreezeResponse struct {
+}
+
+func (m *MsgLiftFeeFreezeResponse) Reset()         { *m = MsgLiftFeeFreezeResponse{} }
+func (m *MsgLiftFeeFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftFeeFreezeResponse) ProtoMessage()    {}
+func (*MsgLiftFeeFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{15}
+}
+func (m *MsgLiftFeeFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftFeeFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftFeeFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiftFeeFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftFeeFreezeResponse.Merge(m, src)
+}
+func (m *MsgLiftFeeFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftFeeFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftFeeFreezeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiftFeeFreezeResponse proto.InternalMessageInfo
+
+// MsgSetDenomConversion defines the Msg/SetDenomConversion request type.
+type MsgSetDenomConversion struct {
+	// DenomConversion is the denom accepted for fees and its conversion rate.
+	DenomConversion DenomConversion `protobuf:"bytes,1,opt,name=denom_conversion,json=denomConversion,proto3" json:"denom_conversion"`
+	// Authority defines the authority that is setting the conversion.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetDenomConversion) Reset()         { *m = MsgSetDenomConversion{} }
+func (m *MsgSetDenomConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomConversion) ProtoMessage()    {}
+func (*MsgSetDenomConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{16}
+}
+func (m *MsgSetDenomConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomConversion.Merge(m, src)
+}
+func (m *MsgSetDenomConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomConversion proto.InternalMessageInfo
+
+func (m *MsgSetDenomConversion) GetDenomConversion() DenomConversion {
+	if m != nil {
+		return m.DenomConversion
+	}
+	return DenomConversion{}
+}
+
+func (m *MsgSetDenomConversion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSetDenomConversionResponse defines the Msg/SetDenomConversion response
+// type.
+type MsgSetDenomConversionResponse struct {
+}
+
+func (m *MsgSetDenomConversionResponse) Reset()         { *m = MsgSetDenomConversionResponse{} }
+func (m *MsgSetDenomConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomConversionResponse) ProtoMessage()    {}
+func (*MsgSetDenomConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{17}
+}
+func (m *MsgSetDenomConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomConversionResponse.Merge(m, src)
+}
+func (m *MsgSetDenomConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomConversionResponse proto.InternalMessageInfo
+
+// MsgRemoveDenomConversion defines the Msg/RemoveDenomConversion request type.
+type MsgRemoveDenomConversion struct {
+	// Denom is the denom that is no longer accepted for fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Authority defines the authority that is removing the conversion.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRemoveDenomConversion) Reset()         { *m = MsgRemoveDenomConversion{} }
+func (m *MsgRemoveDenomConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomConversion) ProtoMessage()    {}
+func (*MsgRemoveDenomConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{18}
+}
+func (m *MsgRemoveDenomConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomConversion.Merge(m, src)
+}
+func (m *MsgRemoveDenomConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomConversion proto.InternalMessageInfo
+
+func (m *MsgRemoveDenomConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgRemoveDenomConversion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRemoveDenomConversionResponse defines the Msg/RemoveDenomConversion
+// response type.
+type MsgRemoveDenomConversionResponse struct {
+}
+
+func (m *MsgRemoveDenomConversionResponse) Reset()         { *m = MsgRemoveDenomConversionResponse{} }
+func (m *MsgRemoveDenomConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomConversionResponse) ProtoMessage()    {}
+func (*MsgRemoveDenomConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{19}
+}
+func (m *MsgRemoveDenomConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomConversionResponse.Merge(m, src)
+}
+func (m *MsgRemoveDenomConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomConversionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgParams)(nil), "feemarket.feemarket.v1.MsgParams")
+	proto.RegisterType((*MsgParamsResponse)(nil), "feemarket.feemarket.v1.MsgParamsResponse")
+	proto.RegisterType((*MsgUpdateParamsPartial)(nil), "feemarket.feemarket.v1.MsgUpdateParamsPartial")
+	proto.RegisterType((*MsgUpdateParamsPartialResponse)(nil), "feemarket.feemarket.v1.MsgUpdateParamsPartialResponse")
+	proto.RegisterType((*MsgScheduleParams)(nil), "feemarket.feemarket.v1.MsgScheduleParams")
+	proto.RegisterType((*MsgScheduleParamsResponse)(nil), "feemarket.feemarket.v1.MsgScheduleParamsResponse")
+	proto.RegisterType((*MsgCancelScheduledParams)(nil), "feemarket.feemarket.v1.MsgCancelScheduledParams")
+	proto.RegisterType((*MsgCancelScheduledParamsResponse)(nil), "feemarket.feemarket.v1.MsgCancelScheduledParamsResponse")
+	proto.RegisterType((*MsgRampParams)(nil), "feemarket.feemarket.v1.MsgRampParams")
+	proto.RegisterType((*MsgRampParamsResponse)(nil), "feemarket.feemarket.v1.MsgRampParamsResponse")
+	proto.RegisterType((*MsgFreezeBaseGasPrice)(nil), "feemarket.feemarket.v1.MsgFreezeBaseGasPrice")
+	proto.RegisterType((*MsgFreezeBaseGasPriceResponse)(nil), "feemarket.feemarket.v1.MsgFreezeBaseGasPriceResponse")
+	proto.RegisterType((*MsgExtendFeeFreeze)(nil), "feemarket.feemarket.v1.MsgExtendFeeFreeze")
+	proto.RegisterType((*MsgExtendFeeFreezeResponse)(nil), "feemarket.feemarket.v1.MsgExtendFeeFreezeResponse")
+	proto.RegisterType((*MsgLiftFeeFreeze)(nil), "feemarket.feemarket.v1.MsgLiftFeeFreeze")
+	proto.RegisterType((*MsgLiftFeeFreezeResponse)(nil), "feemarket.feemarket.v1.MsgLiftFeeFreezeResponse")
+	proto.RegisterType((*MsgSetDenomConversion)(nil), "feemarket.feemarket.v1.MsgSetDenomConversion")
+	proto.RegisterType((*MsgSetDenomConversionResponse)(nil), "feemarket.feemarket.v1.MsgSetDenomConversionResponse")
+	proto.RegisterType((*MsgRemoveDenomConversion)(nil), "feemarket.feemarket.v1.MsgRemoveDenomConversion")
+	proto.RegisterType((*MsgRemoveDenomConversionResponse)(nil), "feemarket.feemarket.v1.MsgRemoveDenomConversionResponse")
+}
+
+func init() { proto.RegisterFile("feemarket/feemarket/v1/tx.proto", fileDescriptor_1bbf67a633e47917) }
+
+var fileDescriptor_1bbf67a633e47917 = []byte{
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0xad, 0x85, 0x5f, 0x9a, 0xb4, 0x6c, 0xd3, 0xd4, 0xdd, 0x82, 0x6d, 0x0c, 0x08,
+	0x13, 0x29, 0x76, 0x12, 0xd4, 0xaa, 0xaa, 0xb8, 0xe0, 0x86, 0xa2, 0x4a, 0xb5, 0x14, 0x6d, 0xc4,
+	0x0f, 0xf5, 0x62, 0x8d, 0x77, 0x5f, 0xc7, 0x2b, 0x67, 0x77, 0xcd, 0xbe, 0x89, 0x95, 0x44, 0x42,
+	0x42, 0xf0, 0x0f, 0x70, 0xe7, 0x9f, 0xa8, 0x50, 0x39, 0xc0, 0x85, 0x23, 0x3d, 0x56, 0x3d, 0x21,
+	0x0e, 0x15, 0x4a, 0x0e, 0xfd, 0x37, 0x90, 0x77, 0xd6, 0x63, 0xaf, 0xbd, 0xde, 0xd8, 0x49, 0x6e,
+	0xf3, 0xde, 0x7c, 0xef, 0x7b, 0xdf, 0x37, 0x3b, 0x7e, 0x23, 0x43, 0xf1, 0x19, 0xa2, 0xcb, 0x82,
+	0x0e, 0x8a, 0xda, 0x70, 0xd5, 0xdb, 0xac, 0x89, 0x83, 0x6a, 0x37, 0xf0, 0x85, 0xaf, 0xaf, 0xaa,
+	0x74, 0x75, 0xb8, 0xea, 0x6d, 0x1a, 0x1f, 0x4e, 0x29, 0xec, 0xb2, 0x80, 0xb9, 0x24, 0x8b, 0x8d,
+	0x8f, 0xa6, 0x80, 0x38, 0x7a, 0x48, 0xce, 0x00, 0x75, 0xdb, 0xf2, 0xc9, 0xf5, 0xa9, 0x19, 0x46,
+	0x35, 0x19, 0x44, 0x5b, 0xb7, 0x64, 0x54, 0x73, 0x89, 0xf7, 0xeb, 0x5c, 0xe2, 0xd1, 0xc6, 0x0a,
+	0xf7, 0xb9, 0x2f, 0x0b, 0xfa, 0x2b, 0x99, 0x2d, 0xff, 0xa6, 0x41, 0xae, 0x41, 0x7c, 0x27, 0xd4,
+	0xa0, 0x7f, 0x0e, 0x59, 0xa9, 0x26, 0xaf, 0x95, 0xb4, 0xca, 0xe2, 0x56, 0xa1, 0x9a, 0xec, 0xa5,
+	0x2a, 0xf1, 0xf5, 0xcb, 0x2f, 0xdf, 0x14, 0x17, 0xcc, 0xa8, 0x46, 0xbf, 0x07, 0x39, 0xb6, 0x2f,
+	0xda, 0x7e, 0xe0, 0x88, 0xc3, 0xfc, 0xa5, 0x92, 0x56, 0xc9, 0xd5, 0xf3, 0xaf, 0x5f, 0xac, 0xaf,
+	0x44, 0xfa, 0xbe, 0xb0, 0xed, 0x00, 0x89, 0x76, 0x45, 0xe0, 0x78, 0xdc, 0x1c, 0x42, 0xf5, 0x22,
+	0x2c, 0x06, 0x48, 0x28, 0x9a, 0x24, 0x98, 0xc0, 0x7c, 0xa6, 0xa4, 0x55, 0xde, 0x31, 0x21, 0x4c,
+	0xed, 0xf6, 0x33, 0x0f, 0x96, 0x7f, 0x7a, 0xfb, 0x7c, 0x6d, 0x58, 0x50, 0xbe, 0x01, 0xef, 0x2a,
+	0xcd, 0x26, 0x52, 0xd7, 0xf7, 0x08, 0xcb, 0x7f, 0x69, 0xb0, 0xda, 0x20, 0xfe, 0x75, 0xd7, 0x66,
+	0x02, 0xe5, 0xde, 0x0e, 0x0b, 0x84, 0xc3, 0xf6, 0xce, 0x69, 0xab, 0x08, 0x8b, 0xfb, 0x21, 0x69,
+	0xd3, 0x65, 0xd4, 0xc9, 0x5f, 0x2a, 0x65, 0x2a, 0x39, 0x13, 0x64, 0xaa, 0xc1, 0xa8, 0x13, 0xf7,
+	0x9d, 0x99, 0xd9, 0xf7, 0x84, 0xad, 0x12, 0x14, 0x92, 0x0d, 0x28, 0x8f, 0xbf, 0x6b, 0xa1, 0xf3,
+	0x5d, 0xab, 0x8d, 0xf6, 0xfe, 0x5e, 0x04, 0xd2, 0xbf, 0x83, 0xeb, 0x14, 0x65, 0xec, 0x66, 0xcc,
+	0xe8, 0x27, 0xd3, 0x8c, 0x0e, 0x18, 0xec, 0x98, 0xe3, 0x6b, 0x14, 0x4f, 0x9f, 0xf5, 0x8b, 0x4e,
+	0x38, 0xbb, 0x03, 0xb7, 0x27, 0x64, 0x2b, 0x53, 0x47, 0x90, 0x6f, 0x10, 0x7f, 0xc8, 0x3c, 0x0b,
+	0xf7, 0xc6, 0x74, 0xe9, 0xab, 0x90, 0x6d, 0xa3, 0xc3, 0xdb, 0x22, 0x34, 0x94, 0x31, 0xa3, 0xe8,
+	0xc2, 0x84, 0x95, 0xa1, 0x34, 0xad, 0xb7, 0xd2, 0xf7, 0xa7, 0x06, 0x4b, 0x0d, 0xe2, 0x26, 0x73,
+	0xbb, 0x91, 0xaa, 0xc7, 0xb0, 0x24, 0x58, 0xc0, 0x51, 0x34, 0xcf, 0x70, 0xad, 0xae, 0xca, 0xd2,
+	0xa1, 0xc1, 0xd6, 0x9e, 0x6f, 0x75, 0x28, 0x74, 0x71, 0xd9, 0x8c, 0xa2, 0x0b, 0xbb, 0x53, 0xb7,
+	0xe0, 0x66, 0x4c, 0xbb, 0x72, 0xf5, 0xb7, 0x16, 0xee, 0x3c, 0x0a, 0x10, 0x8f, 0xb0, 0xce, 0x08,
+	0xbf, 0x62, 0xb4, 0x13, 0x38, 0x16, 0xea, 0xdf, 0xc2, 0x72, 0x8b, 0x11, 0x36, 0x39, 0xeb, 0x0f,
+	0x18, 0xc7, 0xc2, 0xd0, 0x5e, 0xae, 0xbe, 0xd9, 0x97, 0xff, 0xef, 0x9b, 0xe2, 0x1d, 0xa9, 0x81,
+	0xec, 0x4e, 0xd5, 0xf1, 0x6b, 0x2e, 0x13, 0xed, 0xea, 0x13, 0xe4, 0xcc, 0x3a, 0xdc, 0x46, 0xeb,
+	0xf5, 0x8b, 0x75, 0x88, 0x24, 0x6e, 0xa3, 0x65, 0x5e, 0x6d, 0x8d, 0x12, 0x4f, 0xf3, 0xba, 0x01,
+	0x59, 0x72, 0xb8, 0x87, 0xc1, 0xa9, 0x46, 0x23, 0xdc, 0x83, 0xc5, 0xbe, 0xcb, 0x28, 0x28, 0x17,
+	0xe1, 0xfd, 0x44, 0x23, 0xca, 0xaa, 0x00, 0xbd, 0x41, 0xfc, 0xcb, 0x03, 0x81, 0x9e, 0xfd, 0x08,
+	0x51, 0x22, 0x47, 0xd4, 0x68, 0xd3, 0x4f, 0xfe, 0x1c, 0x57, 0xeb, 0x3d, 0x30, 0x26, 0xbb, 0x2a,
+	0x4d, 0x4f, 0xe1, 0x7a, 0x83, 0xf8, 0x13, 0xe7, 0x99, 0x18, 0x2a, 0x8a, 0x75, 0xd6, 0xce, 0xde,
+	0xd9, 0x80, 0xfc, 0x38, 0xb7, 0xea, 0xfb, 0x87, 0xfc, 0xec, 0xbb, 0x28, 0xb6, 0xd1, 0xf3, 0xdd,
+	0x87, 0xbe, 0xd7, 0xc3, 0x80, 0x1c, 0xdf, 0xeb, 0x4f, 0x11, 0xbb, 0x9f, 0x6a, 0x5a, 0x2a, 0x77,
+	0xda, 0x14, 0x19, 0xa3, 0x18, 0x4c, 0x11, 0x7b, 0x8c, 0xf9, 0xa2, 0x4e, 0x54, 0x7e, 0xe8, 0x49,
+	0xe9, 0xca, 0xdc, 0x41, 0x68, 0xdc, 0x44, 0xd7, 0xef, 0xe1, 0xb8, 0xbd, 0x15, 0xb8, 0x12, 0xea,
+	0x92, 0x07, 0x6b, 0xca, 0xe0, 0x82, 0xe7, 0x48, 0x62, 0xe7, 0x81, 0xba, 0xad, 0x5f, 0x73, 0x90,
+	0x69, 0x10, 0xd7, 0xbf, 0x81, 0x6c, 0xf4, 0xe3, 0xff, 0x60, 0xda, 0xc1, 0xaa, 0xd7, 0xcd, 0xf8,
+	0xf4, 0x54, 0xc8, 0x80, 0x5f, 0xff, 0x01, 0x6e, 0x24, 0x3d, 0x7e, 0xd5, 0x14, 0x86, 0x04, 0xbc,
+	0x71, 0x6f, 0x3e, 0xbc, 0x6a, 0xef, 0xc1, 0xf2, 0xd8, 0xbb, 0x94, 0xa6, 0x3d, 0x0e, 0x35, 0x36,
+	0x67, 0x86, 0xaa, 0x7e, 0x3f, 0x6b, 0x70, 0x33, 0xf9, 0xd1, 0xd8, 0x48, 0x21, 0x4b, 0xac, 0x30,
+	0xee, 0xcf, 0x5b, 0xa1, 0x54, 0xb4, 0x00, 0x46, 0x1e, 0x86, 0x8f, 0x53, 0x78, 0x86, 0x30, 0x63,
+	0x7d, 0x26, 0x98, 0xea, 0x71, 0x04, 0x7a, 0xc2, 0x98, 0x4e, 0x23, 0x99, 0x84, 0x1b, 0x77, 0xe7,
+	0x82, 0xab, 0xde, 0xdf, 0xc3, 0xb5, 0xf1, 0xc1, 0xb9, 0x96, 0xc2, 0x34, 0x86, 0x35, 0xb6, 0x66,
+	0xc7, 0xaa, 0x96, 0x1d, 0x58, 0x8a, 0xcf, 0xc5, 0x4a, 0x0a, 0x49, 0x0c, 0x69, 0x6c, 0xcc, 0x8a,
+	0x1c, 0x3d, 0xdb, 0x84, 0x59, 0x98, 0x76, 0xb6, 0x93, 0x70, 0xe3, 0xee, 0x5c, 0xf0, 0xd8, 0x0d,
+	0x4e, 0x1e, 0x56, 0x69, 0x3e, 0x12, 0x2b, 0x8c, 0xfb, 0xf3, 0x56, 0x0c, 0x54, 0x18, 0x57, 0x7e,
+	0x7c, 0xfb, 0x7c, 0x4d, 0xab, 0x3f, 0x7e, 0x79, 0x5c, 0xd0, 0x5e, 0x1d, 0x17, 0xb4, 0xff, 0x8e,
+	0x0b, 0xda, 0x2f, 0x27, 0x85, 0x85, 0x57, 0x27, 0x85, 0x85, 0x7f, 0x4e, 0x0a, 0x0b, 0x4f, 0x6b,
+	0xdc, 0x11, 0xed, 0xfd, 0x56, 0xd5, 0xf2, 0xdd, 0x1a, 0x75, 0x9c, 0xee, 0xba, 0x8b, 0xbd, 0x91,
+	0x3f, 0x27, 0x07, 0x23, 0x6b, 0x71, 0xd8, 0x45, 0x6a, 0x65, 0xc3, 0xbf, 0x16, 0x9f, 0xfd, 0x3f,
+	0x00, 0xcb, 0x5e, 0x30, 0xcf, 0x2a, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Params defines a method for updating the feemarket module parameters.
+	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling new feemarket module
+	// parameters that take effect at a future block height.
+	ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a pending parameter
+	// change.
+	CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error)
+	// RampParams defines a method for gradually transitioning the feemarket
+	// module parameters to a target parameter set over a number of blocks.
+	RampParams(ctx context.Context, in *MsgRampParams, opts ...grpc.CallOption) (*MsgRampParamsResponse, error)
+	// FreezeBaseGasPrice defines a method for freezing the base gas price at a
+	// chosen value for a number of blocks. It can be executed by the guardian or
+	// the authority.
+	FreezeBaseGasPrice(ctx context.Context, in *MsgFreezeBaseGasPrice, opts ...grpc.CallOption) (*MsgFreezeBaseGasPriceResponse, error)
+	// ExtendFeeFreeze defines a method for extending the freeze of the base gas
+	// price in effect.
+	ExtendFeeFreeze(ctx context.Context, in *MsgExtendFeeFreeze, opts ...grpc.CallOption) (*MsgExtendFeeFreezeResponse, error)
+	// LiftFeeFreeze defines a method for lifting the freeze of the base gas
+	// price in effect.
+	LiftFeeFreeze(ctx context.Context, in *MsgLiftFeeFreeze, opts ...grpc.CallOption) (*MsgLiftFeeFreezeResponse, error)
+	// SetDenomConversion defines a method for accepting a denom for fees at a
+	// conversion rate, or for updating the rate of an accepted denom.
+	SetDenomConversion(ctx context.Context, in *MsgSetDenomConversion, opts ...grpc.CallOption) (*MsgSetDenomConversionResponse, error)
+	// RemoveDenomConversion defines a method for no longer accepting a denom
+	// for fees.
+	RemoveDenomConversion(ctx context.Context, in *MsgRemoveDenomConversion, opts ...grpc.CallOption) (*MsgRemoveDenomConversionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
+	out := new(MsgParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error) {
+	out := new(MsgUpdateParamsPartialResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/UpdateParamsPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error) {
+	out := new(MsgScheduleParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/ScheduleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error) {
+	out := new(MsgCancelScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/CancelScheduledParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RampParams(ctx context.Context, in *MsgRampParams, opts ...grpc.CallOption) (*MsgRampParamsResponse, error) {
+	out := new(MsgRampParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/RampParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeBaseGasPrice(ctx context.Context, in *MsgFreezeBaseGasPrice, opts ...grpc.CallOption) (*MsgFreezeBaseGasPriceResponse, error) {
+	out := new(MsgFreezeBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/FreezeBaseGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendFeeFreeze(ctx context.Context, in *MsgExtendFeeFreeze, opts ...grpc.CallOption) (*MsgExtendFeeFreezeResponse, error) {
+	out := new(MsgExtendFeeFreezeResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/ExtendFeeFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiftFeeFreeze(ctx context.Context, in *MsgLiftFeeFreeze, opts ...grpc.CallOption) (*MsgLiftFeeFreezeResponse, error) {
+	out := new(MsgLiftFeeFreezeResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/LiftFeeFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomConversion(ctx context.Context, in *MsgSetDenomConversion, opts ...grpc.CallOption) (*MsgSetDenomConversionResponse, error) {
+	out := new(MsgSetDenomConversionResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetDenomConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomConversion(ctx context.Context, in *MsgRemoveDenomConversion, opts ...grpc.CallOption) (*MsgRemoveDenomConversionResponse, error) {
+	out := new(MsgRemoveDenomConversionResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/RemoveDenomConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Params defines a method for updating the feemarket module parameters.
+	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling new feemarket module
+	// parameters that take effect at a future block height.
+	ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a pending parameter
+	// change.
+	CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error)
+	// RampParams defines a method for gradually transitioning the feemarket
+	// module parameters to a target parameter set over a number of blocks.
+	RampParams(context.Context, *MsgRampParams) (*MsgRampParamsResponse, error)
+	// FreezeBaseGasPrice defines a method for freezing the base gas price at a
+	// chosen value for a number of blocks. It can be executed by the guardian or
+	// the authority.
+	FreezeBaseGasPrice(context.Context, *MsgFreezeBaseGasPrice) (*MsgFreezeBaseGasPriceResponse, error)
+	// ExtendFeeFreeze defines a method for extending the freeze of the base gas
+	// price in effect.
+	ExtendFeeFreeze(context.Context, *MsgExtendFeeFreeze) (*MsgExtendFeeFreezeResponse, error)
+	// LiftFeeFreeze defines a method for lifting the freeze of the base gas
+	// price in effect.
+	LiftFeeFreeze(context.Context, *MsgLiftFeeFreeze) (*MsgLiftFeeFreezeResponse, error)
+	// SetDenomConversion defines a method for accepting a denom for fees at a
+	// conversion rate, or for updating the rate of an accepted denom.
+	SetDenomConversion(context.Context, *MsgSetDenomConversion) (*MsgSetDenomConversionResponse, error)
+	// RemoveDenomConversion defines a method for no longer accepting a denom
+	// for fees.
+	RemoveDenomConversion(context.Context, *MsgRemoveDenomConversion) (*MsgRemoveDenomConversionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Params(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParamsPartial(ctx context.Context, req *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsPartial not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleParams(ctx context.Context, req *MsgScheduleParams) (*MsgScheduleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParams not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledParams(ctx context.Context, req *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledParams not implemented")
+}
+func (*UnimplementedMsgServer) RampParams(ctx context.Context, req *MsgRampParams) (*MsgRampParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RampParams not implemented")
+}
+func (*UnimplementedMsgServer) FreezeBaseGasPrice(ctx context.Context, req *MsgFreezeBaseGasPrice) (*MsgFreezeBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeBaseGasPrice not implemented")
+}
+func (*UnimplementedMsgServer) ExtendFeeFreeze(ctx context.Context, req *MsgExtendFeeFreeze) (*MsgExtendFeeFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendFeeFreeze not implemented")
+}
+func (*UnimplementedMsgServer) LiftFeeFreeze(ctx context.Context, req *MsgLiftFeeFreeze) (*MsgLiftFeeFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftFeeFreeze not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomConversion(ctx context.Context, req *MsgSetDenomConversion) (*MsgSetDenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomConversion not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDenomConversion(ctx context.Context, req *MsgRemoveDenomConversion) (*MsgRemoveDenomConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomConversion not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Params(ctx, req.(*MsgParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParamsPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/UpdateParamsPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, req.(*MsgUpdateParamsPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/ScheduleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParams(ctx, req.(*MsgScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/CancelScheduledParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledParams(ctx, req.(*MsgCancelScheduledParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RampParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRampParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RampParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/RampParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RampParams(ctx, req.(*MsgRampParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeBaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeBaseGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeBaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/FreezeBaseGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeBaseGasPrice(ctx, req.(*MsgFreezeBaseGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendFeeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendFeeFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendFeeFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/ExtendFeeFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendFeeFreeze(ctx, req.(*MsgExtendFeeFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiftFeeFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftFeeFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftFeeFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/LiftFeeFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftFeeFreeze(ctx, req.(*MsgLiftFeeFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetDenomConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomConversion(ctx, req.(*MsgSetDenomConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/RemoveDenomConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomConversion(ctx, req.(*MsgRemoveDenomConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feemarket.feemarket.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Msg_Params_Handler,
+		},
+		{
+			MethodName: "UpdateParamsPartial",
+			Handler:    _Msg_UpdateParamsPartial_Handler,
+		},
+		{
+			MethodName: "ScheduleParams",
+			Handler:    _Msg_ScheduleParams_Handler,
+		},
+		{
+			MethodName: "CancelScheduledParams",
+			Handler:    _Msg_CancelScheduledParams_Handler,
+		},
+		{
+			MethodName: "RampParams",
+			Handler:    _Msg_RampParams_Handler,
+		},
+		{
+			MethodName: "FreezeBaseGasPrice",
+			Handler:    _Msg_FreezeBaseGasPrice_Handler,
+		},
+		{
+			MethodName: "ExtendFeeFreeze",
+			Handler:    _Msg_ExtendFeeFreeze_Handler,
+		},
+		{
+			MethodName: "LiftFeeFreeze",
+			Handler:    _Msg_LiftFeeFreeze_Handler,
+		},
+		{
+			MethodName: "SetDenomConversion",
+			Handler:    _Msg_SetDenomConversion_Handler,
+		},
+		{
+			MethodName: "RemoveDenomConversion",
+			Handler:    _Msg_RemoveDenomConversion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feemarket/feemarket/v1/tx.proto",
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetState {
+		i--
+		if m.ResetState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ScheduledParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TargetParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRampParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRampParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRampParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeBaseGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeBaseGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeBaseGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeBaseGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeBaseGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeBaseGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendFeeFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendFeeFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendFeeFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendFeeFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendFeeFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendFeeFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftFeeFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftFeeFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftFeeFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftFeeFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftFeeFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftFeeFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DenomConversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomConversionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveDenomConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ResetState {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScheduledParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovTx(uint64(m.Blocks))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRampParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeBaseGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovTx(uint64(m.Blocks))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeBaseGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendFeeFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovTx(uint64(m.Blocks))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExtendFeeFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiftFeeFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiftFeeFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DenomConversion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDenomConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDenomConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRampParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRampParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRampParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeBaseGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeBaseGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeBaseGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeBaseGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeBaseGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeBaseGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendFeeFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendFeeFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendFeeFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendFeeFreezeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendFeeFreezeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendFeeFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgLiftFeeFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiftFeeFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiftFeeFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgLiftFeeFreezeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiftFeeFreezeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiftFeeFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -364,15 +3812,15 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConversion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -399,7 +3847,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DenomConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -456,7 +3904,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -479,10 +3927,174 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDenomConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDenomConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDenomConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDenomConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDenomConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDenomConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// SplitUnusedGasFee splits the fee paid for unused gas into the amounts that are
+// refunded to the fee payer, charged as a fee and paid out as a tip according to
+// the unused gas policy. The penalty is rounded down and an unset penalty is
+// treated as zero.
+func (p *Params) SplitUnusedGasFee(unusedFee math.Int) (refund, penalty, tip math.Int) {
+	refund, penalty, tip = math.ZeroInt(), math.ZeroInt(), math.ZeroInt()
+
+	switch p.UnusedGasPolicy {
+	case UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND:
+		refund = unusedFee
+	case UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY:
+		if !p.UnusedGasPenalty.IsNil() {
+			penalty = p.UnusedGasPenalty.MulInt(unusedFee).TruncateInt()
+		}
+		refund = unusedFee.Sub(penalty)
+	default:
+		tip = unusedFee
+	}
+
+	return refund, penalty, tip
+}
+
+// RefundsUnusedGas returns true if the unused gas policy refunds some of the fee
+// paid for unused gas.
+func (p *Params) RefundsUnusedGas() bool {
+	return p.UnusedGasPolicy == UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND ||
+		p.UnusedGasPolicy == UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY
+}
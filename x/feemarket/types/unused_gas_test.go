@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestSplitUnusedGasFee(t *testing.T) {
+	unusedFee := math.NewInt(1000)
+
+	t.Run("unused gas fee is paid as tip by default", func(t *testing.T) {
+		params := types.DefaultParams()
+		require.False(t, params.RefundsUnusedGas())
+
+		refund, penalty, tip := params.SplitUnusedGasFee(unusedFee)
+		require.True(t, refund.IsZero())
+		require.True(t, penalty.IsZero())
+		require.Equal(t, unusedFee, tip)
+	})
+
+	t.Run("unused gas fee is refunded", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.UnusedGasPolicy = types.UnusedGasPolicy_UNUSED_GAS_POLICY_REFUND
+		require.True(t, params.RefundsUnusedGas())
+
+		refund, penalty, tip := params.SplitUnusedGasFee(unusedFee)
+		require.Equal(t, unusedFee, refund)
+		require.True(t, penalty.IsZero())
+		require.True(t, tip.IsZero())
+	})
+
+	t.Run("penalty is rounded down and the rest is refunded", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.UnusedGasPolicy = types.UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY
+		params.UnusedGasPenalty = math.LegacyMustNewDecFromStr("0.3333")
+		require.True(t, params.RefundsUnusedGas())
+
+		refund, penalty, tip := params.SplitUnusedGasFee(unusedFee)
+		require.Equal(t, math.NewInt(667), refund)
+		require.Equal(t, math.NewInt(333), penalty)
+		require.True(t, tip.IsZero())
+	})
+
+	t.Run("unset penalty is treated as zero", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.UnusedGasPolicy = types.UnusedGasPolicy_UNUSED_GAS_POLICY_PENALTY
+		params.UnusedGasPenalty = math.LegacyDec{}
+
+		refund, penalty, tip := params.SplitUnusedGasFee(unusedFee)
+		require.Equal(t, unusedFee, refund)
+		require.True(t, penalty.IsZero())
+		require.True(t, tip.IsZero())
+	})
+}